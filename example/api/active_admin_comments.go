@@ -20,7 +20,10 @@ func configActiveAdminCommentsRouter(router *httprouter.Router) {
 	router.POST("/activeadmincomments", AddActiveAdminComments)
 	router.GET("/activeadmincomments/:argID", GetActiveAdminComments)
 	router.PUT("/activeadmincomments/:argID", UpdateActiveAdminComments)
+	router.PATCH("/activeadmincomments/:argID", PatchActiveAdminComments)
+	router.POST("/activeadmincomments/:argID", staticSegment("bulk", BulkActiveAdminComments, nil))
 	router.DELETE("/activeadmincomments/:argID", DeleteActiveAdminComments)
+	router.GET("/activeadmincomments/:argID/history", GetRecordHistory("active_admin_comments", "argID"))
 }
 
 func configGinActiveAdminCommentsRouter(router gin.IRoutes) {
@@ -28,19 +31,40 @@ func configGinActiveAdminCommentsRouter(router gin.IRoutes) {
 	router.POST("/activeadmincomments", ConverHttprouterToGin(AddActiveAdminComments))
 	router.GET("/activeadmincomments/:argID", ConverHttprouterToGin(GetActiveAdminComments))
 	router.PUT("/activeadmincomments/:argID", ConverHttprouterToGin(UpdateActiveAdminComments))
+	router.PATCH("/activeadmincomments/:argID", ConverHttprouterToGin(PatchActiveAdminComments))
+	router.POST("/activeadmincomments/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkActiveAdminComments, nil)))
 	router.DELETE("/activeadmincomments/:argID", ConverHttprouterToGin(DeleteActiveAdminComments))
+	router.GET("/activeadmincomments/:argID/history", ConverHttprouterToGin(GetRecordHistory("active_admin_comments", "argID")))
 }
 
 // GetAllActiveAdminComments is a function to get a slice of record(s) from active_admin_comments table in the rocket_development database
 // @Summary Get list of ActiveAdminComments
 // @Tags ActiveAdminComments
-// @Description GetAllActiveAdminComments is a handler to get a slice of record(s) from active_admin_comments table in the rocket_development database
+// @Description GetAllActiveAdminComments is a handler to get a slice of record(s) from active_admin_comments table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   namespace query    string  false        "filter namespace=value or namespace[op]=value"
+// @Param   body     query    string  false        "filter body=value or body[op]=value"
+// @Param   resource_type query    string  false        "filter resource_type=value or resource_type[op]=value"
+// @Param   resource_id query    int     false        "filter resource_id=value or resource_id[op]=value"
+// @Param   author_type query    string  false        "filter author_type=value or author_type[op]=value"
+// @Param   author_id query    int     false        "filter author_id=value or author_id[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.ActiveAdminComments}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /activeadmincomments [get]
@@ -59,20 +83,40 @@ func GetAllActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "active_admin_comments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "active_admin_comments", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllActiveAdminComments(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "active_admin_comments", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "active_admin_comments", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllActiveAdminComments(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +128,13 @@ func GetAllActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.ActiveAdminComments
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /activeadmincomments/{argID} [get]
@@ -98,18 +148,41 @@ func GetActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httproute
 		return
 	}
 
+	fields, err := readFields(r, "active_admin_comments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "active_admin_comments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "active_admin_comments", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetActiveAdminComments(ctx, argID)
+	record, err := dao.GetActiveAdminComments(ctx, argID, recordFields("active_admin_comments", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "active_admin_comments", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddActiveAdminComments add to add a single record to active_admin_comments table in the rocket_development database
@@ -122,8 +195,12 @@ func GetActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httproute
 // @Success 200 {object} model.ActiveAdminComments
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activeadmincomments [post]
-// echo '{"id": 83,"namespace": "KuqghbLLPOvvJlraCcdgilifx","body": "ifNhmOwAACQpNsTQlKbjJJlmi","resource_type": "CotwxSaePnICBVTNWJFAbkQXH","resource_id": 17,"author_type": "ANSkUErAcKYogtwnSZvhiBtfO","author_id": 55,"created_at": "2206-08-21T04:09:58.084431087-04:00","updated_at": "2167-12-19T11:20:44.400834746-05:00"}' | http POST "http://localhost:8080/activeadmincomments" X-Api-User:user123
+// echo '{"id": 83,"namespace": "KuqghbLLPOvvJlraCcdgilifx","body": "ifNhmOwAACQpNsTQlKbjJJlmi","resource_type": "CotwxSaePnICBVTNWJFAbkQXH","resource_id": 17,"author_type": "ANSkUErAcKYogtwnSZvhiBtfO","author_id": 55}' | http POST "http://localhost:8080/activeadmincomments" X-Api-User:user123
 func AddActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	activeadmincomments := &model.ActiveAdminComments{}
@@ -134,13 +211,14 @@ func AddActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httproute
 	}
 
 	if err := activeadmincomments.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activeadmincomments.Prepare()
 
 	if err := activeadmincomments.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +239,24 @@ func AddActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httproute
 
 // UpdateActiveAdminComments Update a single record from active_admin_comments table in the rocket_development database
 // @Summary Update an record in table active_admin_comments
-// @Description Update a single record from active_admin_comments table in the rocket_development database
+// @Description Update a single record from active_admin_comments table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags ActiveAdminComments
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  ActiveAdminComments body model.ActiveAdminComments true "Update ActiveAdminComments record"
 // @Success 200 {object} model.ActiveAdminComments
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activeadmincomments/{argID} [put]
-// echo '{"id": 83,"namespace": "KuqghbLLPOvvJlraCcdgilifx","body": "ifNhmOwAACQpNsTQlKbjJJlmi","resource_type": "CotwxSaePnICBVTNWJFAbkQXH","resource_id": 17,"author_type": "ANSkUErAcKYogtwnSZvhiBtfO","author_id": 55,"created_at": "2206-08-21T04:09:58.084431087-04:00","updated_at": "2167-12-19T11:20:44.400834746-05:00"}' | http PUT "http://localhost:8080/activeadmincomments/1"  X-Api-User:user123
+// echo '{"id": 83,"namespace": "KuqghbLLPOvvJlraCcdgilifx","body": "ifNhmOwAACQpNsTQlKbjJJlmi","resource_type": "CotwxSaePnICBVTNWJFAbkQXH","resource_id": 17,"author_type": "ANSkUErAcKYogtwnSZvhiBtfO","author_id": 55}' | http PUT "http://localhost:8080/activeadmincomments/1"  X-Api-User:user123
 func UpdateActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +266,12 @@ func UpdateActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	activeadmincomments := &model.ActiveAdminComments{}
 	if err := readJSON(r, activeadmincomments); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +279,14 @@ func UpdateActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 	}
 
 	if err := activeadmincomments.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activeadmincomments.Prepare()
 
 	if err := activeadmincomments.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +306,77 @@ func UpdateActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 	writeJSON(ctx, w, activeadmincomments)
 }
 
+// PatchActiveAdminComments Patch a single record from active_admin_comments table in the rocket_development database
+// @Summary Patch an record in table active_admin_comments
+// @Description Patch a single record from active_admin_comments table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags ActiveAdminComments
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  ActiveAdminComments body model.ActiveAdminComments true "fields of ActiveAdminComments to change, null clears a field"
+// @Success 200 {object} model.ActiveAdminComments
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /activeadmincomments/{argID} [patch]
+// echo '{"namespace": null}' | http PATCH "http://localhost:8080/activeadmincomments/1"  X-Api-User:user123
+func PatchActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_admin_comments", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	activeadmincomments, _, err := dao.PatchActiveAdminComments(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, activeadmincomments)
+}
+
+// BulkActiveAdminComments runs create, update and delete operations on records of the active_admin_comments table in the rocket_development database
+// @Summary Bulk create, update and delete records of table active_admin_comments
+// @Description BulkActiveAdminComments runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags ActiveAdminComments
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on ActiveAdminComments records, the record of create and update is a ActiveAdminComments"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /activeadmincomments/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 83,"namespace": "KuqghbLLPOvvJlraCcdgilifx","body": "ifNhmOwAACQpNsTQlKbjJJlmi","resource_type": "CotwxSaePnICBVTNWJFAbkQXH","resource_id": 17,"author_type": "ANSkUErAcKYogtwnSZvhiBtfO","author_id": 55}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/activeadmincomments/bulk" X-Api-User:user123
+func BulkActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "active_admin_comments")
+}
+
 // DeleteActiveAdminComments Delete a single record from active_admin_comments table in the rocket_development database
 // @Summary Delete a record from active_admin_comments
 // @Description Delete a single record from active_admin_comments table in the rocket_development database
@@ -221,9 +384,18 @@ func UpdateActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.ActiveAdminComments
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activeadmincomments/{argID} [delete]
 // http DELETE "http://localhost:8080/activeadmincomments/1" X-Api-User:user123
 func DeleteActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +407,38 @@ func DeleteActiveAdminComments(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "active_admin_comments", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteActiveAdminComments(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_admin_comments", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "active_admin_comments", argID)
+		return
+	}
+
+	if _, err := dao.DeleteActiveAdminComments(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
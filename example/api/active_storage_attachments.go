@@ -20,7 +20,10 @@ func configActiveStorageAttachmentsRouter(router *httprouter.Router) {
 	router.POST("/activestorageattachments", AddActiveStorageAttachments)
 	router.GET("/activestorageattachments/:argID", GetActiveStorageAttachments)
 	router.PUT("/activestorageattachments/:argID", UpdateActiveStorageAttachments)
+	router.PATCH("/activestorageattachments/:argID", PatchActiveStorageAttachments)
+	router.POST("/activestorageattachments/:argID", staticSegment("bulk", BulkActiveStorageAttachments, nil))
 	router.DELETE("/activestorageattachments/:argID", DeleteActiveStorageAttachments)
+	router.GET("/activestorageattachments/:argID/history", GetRecordHistory("active_storage_attachments", "argID"))
 }
 
 func configGinActiveStorageAttachmentsRouter(router gin.IRoutes) {
@@ -28,19 +31,37 @@ func configGinActiveStorageAttachmentsRouter(router gin.IRoutes) {
 	router.POST("/activestorageattachments", ConverHttprouterToGin(AddActiveStorageAttachments))
 	router.GET("/activestorageattachments/:argID", ConverHttprouterToGin(GetActiveStorageAttachments))
 	router.PUT("/activestorageattachments/:argID", ConverHttprouterToGin(UpdateActiveStorageAttachments))
+	router.PATCH("/activestorageattachments/:argID", ConverHttprouterToGin(PatchActiveStorageAttachments))
+	router.POST("/activestorageattachments/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkActiveStorageAttachments, nil)))
 	router.DELETE("/activestorageattachments/:argID", ConverHttprouterToGin(DeleteActiveStorageAttachments))
+	router.GET("/activestorageattachments/:argID/history", ConverHttprouterToGin(GetRecordHistory("active_storage_attachments", "argID")))
 }
 
 // GetAllActiveStorageAttachments is a function to get a slice of record(s) from active_storage_attachments table in the rocket_development database
 // @Summary Get list of ActiveStorageAttachments
 // @Tags ActiveStorageAttachments
-// @Description GetAllActiveStorageAttachments is a handler to get a slice of record(s) from active_storage_attachments table in the rocket_development database
+// @Description GetAllActiveStorageAttachments is a handler to get a slice of record(s) from active_storage_attachments table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   include  query    string  false        "comma separated related records to embed: blob"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   name     query    string  false        "filter name=value or name[op]=value"
+// @Param   record_type query    string  false        "filter record_type=value or record_type[op]=value"
+// @Param   record_id query    int     false        "filter record_id=value or record_id[op]=value"
+// @Param   blob_id  query    int     false        "filter blob_id=value or blob_id[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.ActiveStorageAttachments}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /activestorageattachments [get]
@@ -59,20 +80,40 @@ func GetAllActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "active_storage_attachments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "active_storage_attachments", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllActiveStorageAttachments(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "active_storage_attachments", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "active_storage_attachments", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllActiveStorageAttachments(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +125,14 @@ func GetAllActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: blob"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.ActiveStorageAttachments
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /activestorageattachments/{argID} [get]
@@ -98,18 +146,41 @@ func GetActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps http
 		return
 	}
 
+	fields, err := readFields(r, "active_storage_attachments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "active_storage_attachments")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "active_storage_attachments", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetActiveStorageAttachments(ctx, argID)
+	record, err := dao.GetActiveStorageAttachments(ctx, argID, recordFields("active_storage_attachments", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "active_storage_attachments", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddActiveStorageAttachments add to add a single record to active_storage_attachments table in the rocket_development database
@@ -122,8 +193,12 @@ func GetActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps http
 // @Success 200 {object} model.ActiveStorageAttachments
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageattachments [post]
-// echo '{"id": 35,"name": "ohLYiHpphKyHZHCIsnLdnqnJC","record_type": "AkWEoKosxSvyMpuQWZkObDiSn","record_id": 3,"blob_id": 35,"created_at": "2309-03-16T11:22:12.141378952-04:00"}' | http POST "http://localhost:8080/activestorageattachments" X-Api-User:user123
+// echo '{"id": 35,"name": "ohLYiHpphKyHZHCIsnLdnqnJC","record_type": "AkWEoKosxSvyMpuQWZkObDiSn","record_id": 3,"blob_id": 35}' | http POST "http://localhost:8080/activestorageattachments" X-Api-User:user123
 func AddActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	activestorageattachments := &model.ActiveStorageAttachments{}
@@ -134,13 +209,14 @@ func AddActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps http
 	}
 
 	if err := activestorageattachments.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activestorageattachments.Prepare()
 
 	if err := activestorageattachments.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +237,24 @@ func AddActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps http
 
 // UpdateActiveStorageAttachments Update a single record from active_storage_attachments table in the rocket_development database
 // @Summary Update an record in table active_storage_attachments
-// @Description Update a single record from active_storage_attachments table in the rocket_development database
+// @Description Update a single record from active_storage_attachments table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags ActiveStorageAttachments
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  ActiveStorageAttachments body model.ActiveStorageAttachments true "Update ActiveStorageAttachments record"
 // @Success 200 {object} model.ActiveStorageAttachments
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageattachments/{argID} [put]
-// echo '{"id": 35,"name": "ohLYiHpphKyHZHCIsnLdnqnJC","record_type": "AkWEoKosxSvyMpuQWZkObDiSn","record_id": 3,"blob_id": 35,"created_at": "2309-03-16T11:22:12.141378952-04:00"}' | http PUT "http://localhost:8080/activestorageattachments/1"  X-Api-User:user123
+// echo '{"id": 35,"name": "ohLYiHpphKyHZHCIsnLdnqnJC","record_type": "AkWEoKosxSvyMpuQWZkObDiSn","record_id": 3,"blob_id": 35}' | http PUT "http://localhost:8080/activestorageattachments/1"  X-Api-User:user123
 func UpdateActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +264,12 @@ func UpdateActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	activestorageattachments := &model.ActiveStorageAttachments{}
 	if err := readJSON(r, activestorageattachments); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +277,14 @@ func UpdateActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 	}
 
 	if err := activestorageattachments.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activestorageattachments.Prepare()
 
 	if err := activestorageattachments.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +304,77 @@ func UpdateActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 	writeJSON(ctx, w, activestorageattachments)
 }
 
+// PatchActiveStorageAttachments Patch a single record from active_storage_attachments table in the rocket_development database
+// @Summary Patch an record in table active_storage_attachments
+// @Description Patch a single record from active_storage_attachments table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags ActiveStorageAttachments
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  ActiveStorageAttachments body model.ActiveStorageAttachments true "fields of ActiveStorageAttachments to change, null clears a field"
+// @Success 200 {object} model.ActiveStorageAttachments
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /activestorageattachments/{argID} [patch]
+// echo '{"name": "ohLYiHpphKyHZHCIsnLdnqnJC"}' | http PATCH "http://localhost:8080/activestorageattachments/1"  X-Api-User:user123
+func PatchActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_storage_attachments", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	activestorageattachments, _, err := dao.PatchActiveStorageAttachments(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, activestorageattachments)
+}
+
+// BulkActiveStorageAttachments runs create, update and delete operations on records of the active_storage_attachments table in the rocket_development database
+// @Summary Bulk create, update and delete records of table active_storage_attachments
+// @Description BulkActiveStorageAttachments runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags ActiveStorageAttachments
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on ActiveStorageAttachments records, the record of create and update is a ActiveStorageAttachments"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /activestorageattachments/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 35,"name": "ohLYiHpphKyHZHCIsnLdnqnJC","record_type": "AkWEoKosxSvyMpuQWZkObDiSn","record_id": 3,"blob_id": 35}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/activestorageattachments/bulk" X-Api-User:user123
+func BulkActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "active_storage_attachments")
+}
+
 // DeleteActiveStorageAttachments Delete a single record from active_storage_attachments table in the rocket_development database
 // @Summary Delete a record from active_storage_attachments
 // @Description Delete a single record from active_storage_attachments table in the rocket_development database
@@ -221,9 +382,18 @@ func UpdateActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.ActiveStorageAttachments
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageattachments/{argID} [delete]
 // http DELETE "http://localhost:8080/activestorageattachments/1" X-Api-User:user123
 func DeleteActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +405,38 @@ func DeleteActiveStorageAttachments(w http.ResponseWriter, r *http.Request, ps h
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "active_storage_attachments", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteActiveStorageAttachments(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_storage_attachments", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "active_storage_attachments", argID)
+		return
+	}
+
+	if _, err := dao.DeleteActiveStorageAttachments(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -20,7 +20,10 @@ func configActiveStorageBlobsRouter(router *httprouter.Router) {
 	router.POST("/activestorageblobs", AddActiveStorageBlobs)
 	router.GET("/activestorageblobs/:argID", GetActiveStorageBlobs)
 	router.PUT("/activestorageblobs/:argID", UpdateActiveStorageBlobs)
+	router.PATCH("/activestorageblobs/:argID", PatchActiveStorageBlobs)
+	router.POST("/activestorageblobs/:argID", staticSegment("bulk", BulkActiveStorageBlobs, nil))
 	router.DELETE("/activestorageblobs/:argID", DeleteActiveStorageBlobs)
+	router.GET("/activestorageblobs/:argID/history", GetRecordHistory("active_storage_blobs", "argID"))
 }
 
 func configGinActiveStorageBlobsRouter(router gin.IRoutes) {
@@ -28,19 +31,39 @@ func configGinActiveStorageBlobsRouter(router gin.IRoutes) {
 	router.POST("/activestorageblobs", ConverHttprouterToGin(AddActiveStorageBlobs))
 	router.GET("/activestorageblobs/:argID", ConverHttprouterToGin(GetActiveStorageBlobs))
 	router.PUT("/activestorageblobs/:argID", ConverHttprouterToGin(UpdateActiveStorageBlobs))
+	router.PATCH("/activestorageblobs/:argID", ConverHttprouterToGin(PatchActiveStorageBlobs))
+	router.POST("/activestorageblobs/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkActiveStorageBlobs, nil)))
 	router.DELETE("/activestorageblobs/:argID", ConverHttprouterToGin(DeleteActiveStorageBlobs))
+	router.GET("/activestorageblobs/:argID/history", ConverHttprouterToGin(GetRecordHistory("active_storage_blobs", "argID")))
 }
 
 // GetAllActiveStorageBlobs is a function to get a slice of record(s) from active_storage_blobs table in the rocket_development database
 // @Summary Get list of ActiveStorageBlobs
 // @Tags ActiveStorageBlobs
-// @Description GetAllActiveStorageBlobs is a handler to get a slice of record(s) from active_storage_blobs table in the rocket_development database
+// @Description GetAllActiveStorageBlobs is a handler to get a slice of record(s) from active_storage_blobs table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   include  query    string  false        "comma separated related records to embed: active_storage_attachments"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   key      query    string  false        "filter key=value or key[op]=value"
+// @Param   filename query    string  false        "filter filename=value or filename[op]=value"
+// @Param   content_type query    string  false        "filter content_type=value or content_type[op]=value"
+// @Param   metadata query    string  false        "filter metadata=value or metadata[op]=value"
+// @Param   byte_size query    int     false        "filter byte_size=value or byte_size[op]=value"
+// @Param   checksum query    string  false        "filter checksum=value or checksum[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.ActiveStorageBlobs}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /activestorageblobs [get]
@@ -59,20 +82,40 @@ func GetAllActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "active_storage_blobs")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "active_storage_blobs", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllActiveStorageBlobs(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "active_storage_blobs", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "active_storage_blobs", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllActiveStorageBlobs(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +127,14 @@ func GetAllActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: active_storage_attachments"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.ActiveStorageBlobs
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /activestorageblobs/{argID} [get]
@@ -98,18 +148,41 @@ func GetActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter
 		return
 	}
 
+	fields, err := readFields(r, "active_storage_blobs")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "active_storage_blobs")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "active_storage_blobs", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetActiveStorageBlobs(ctx, argID)
+	record, err := dao.GetActiveStorageBlobs(ctx, argID, recordFields("active_storage_blobs", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "active_storage_blobs", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddActiveStorageBlobs add to add a single record to active_storage_blobs table in the rocket_development database
@@ -122,8 +195,12 @@ func GetActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter
 // @Success 200 {object} model.ActiveStorageBlobs
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageblobs [post]
-// echo '{"id": 94,"key": "nYwThBYfiIdMXjdcZVFduLEoi","filename": "jybMAUIhMGhBUxrXaTwjvLnEC","content_type": "tDnbcjZXaywQOXvqgtEdpOBpY","metadata": "flWceGtWxKhTquaHMHtYJXsuo","byte_size": 41,"checksum": "PMSlMMyLXHXZliPKdWKvuiveJ","created_at": "2038-02-27T19:56:22.763420229-05:00"}' | http POST "http://localhost:8080/activestorageblobs" X-Api-User:user123
+// echo '{"id": 94,"key": "nYwThBYfiIdMXjdcZVFduLEoi","filename": "jybMAUIhMGhBUxrXaTwjvLnEC","content_type": "tDnbcjZXaywQOXvqgtEdpOBpY","metadata": "flWceGtWxKhTquaHMHtYJXsuo","byte_size": 41,"checksum": "PMSlMMyLXHXZliPKdWKvuiveJ"}' | http POST "http://localhost:8080/activestorageblobs" X-Api-User:user123
 func AddActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	activestorageblobs := &model.ActiveStorageBlobs{}
@@ -134,13 +211,14 @@ func AddActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter
 	}
 
 	if err := activestorageblobs.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activestorageblobs.Prepare()
 
 	if err := activestorageblobs.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +239,24 @@ func AddActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter
 
 // UpdateActiveStorageBlobs Update a single record from active_storage_blobs table in the rocket_development database
 // @Summary Update an record in table active_storage_blobs
-// @Description Update a single record from active_storage_blobs table in the rocket_development database
+// @Description Update a single record from active_storage_blobs table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags ActiveStorageBlobs
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  ActiveStorageBlobs body model.ActiveStorageBlobs true "Update ActiveStorageBlobs record"
 // @Success 200 {object} model.ActiveStorageBlobs
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageblobs/{argID} [put]
-// echo '{"id": 94,"key": "nYwThBYfiIdMXjdcZVFduLEoi","filename": "jybMAUIhMGhBUxrXaTwjvLnEC","content_type": "tDnbcjZXaywQOXvqgtEdpOBpY","metadata": "flWceGtWxKhTquaHMHtYJXsuo","byte_size": 41,"checksum": "PMSlMMyLXHXZliPKdWKvuiveJ","created_at": "2038-02-27T19:56:22.763420229-05:00"}' | http PUT "http://localhost:8080/activestorageblobs/1"  X-Api-User:user123
+// echo '{"id": 94,"key": "nYwThBYfiIdMXjdcZVFduLEoi","filename": "jybMAUIhMGhBUxrXaTwjvLnEC","content_type": "tDnbcjZXaywQOXvqgtEdpOBpY","metadata": "flWceGtWxKhTquaHMHtYJXsuo","byte_size": 41,"checksum": "PMSlMMyLXHXZliPKdWKvuiveJ"}' | http PUT "http://localhost:8080/activestorageblobs/1"  X-Api-User:user123
 func UpdateActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +266,12 @@ func UpdateActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	activestorageblobs := &model.ActiveStorageBlobs{}
 	if err := readJSON(r, activestorageblobs); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +279,14 @@ func UpdateActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 	}
 
 	if err := activestorageblobs.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	activestorageblobs.Prepare()
 
 	if err := activestorageblobs.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +306,77 @@ func UpdateActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 	writeJSON(ctx, w, activestorageblobs)
 }
 
+// PatchActiveStorageBlobs Patch a single record from active_storage_blobs table in the rocket_development database
+// @Summary Patch an record in table active_storage_blobs
+// @Description Patch a single record from active_storage_blobs table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags ActiveStorageBlobs
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  ActiveStorageBlobs body model.ActiveStorageBlobs true "fields of ActiveStorageBlobs to change, null clears a field"
+// @Success 200 {object} model.ActiveStorageBlobs
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /activestorageblobs/{argID} [patch]
+// echo '{"content_type": null}' | http PATCH "http://localhost:8080/activestorageblobs/1"  X-Api-User:user123
+func PatchActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_storage_blobs", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	activestorageblobs, _, err := dao.PatchActiveStorageBlobs(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, activestorageblobs)
+}
+
+// BulkActiveStorageBlobs runs create, update and delete operations on records of the active_storage_blobs table in the rocket_development database
+// @Summary Bulk create, update and delete records of table active_storage_blobs
+// @Description BulkActiveStorageBlobs runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags ActiveStorageBlobs
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on ActiveStorageBlobs records, the record of create and update is a ActiveStorageBlobs"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /activestorageblobs/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 94,"key": "nYwThBYfiIdMXjdcZVFduLEoi","filename": "jybMAUIhMGhBUxrXaTwjvLnEC","content_type": "tDnbcjZXaywQOXvqgtEdpOBpY","metadata": "flWceGtWxKhTquaHMHtYJXsuo","byte_size": 41,"checksum": "PMSlMMyLXHXZliPKdWKvuiveJ"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/activestorageblobs/bulk" X-Api-User:user123
+func BulkActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "active_storage_blobs")
+}
+
 // DeleteActiveStorageBlobs Delete a single record from active_storage_blobs table in the rocket_development database
 // @Summary Delete a record from active_storage_blobs
 // @Description Delete a single record from active_storage_blobs table in the rocket_development database
@@ -221,9 +384,18 @@ func UpdateActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.ActiveStorageBlobs
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /activestorageblobs/{argID} [delete]
 // http DELETE "http://localhost:8080/activestorageblobs/1" X-Api-User:user123
 func DeleteActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +407,38 @@ func DeleteActiveStorageBlobs(w http.ResponseWriter, r *http.Request, ps httprou
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "active_storage_blobs", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteActiveStorageBlobs(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "active_storage_blobs", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "active_storage_blobs", argID)
+		return
+	}
+
+	if _, err := dao.DeleteActiveStorageBlobs(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
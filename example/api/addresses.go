@@ -20,7 +20,10 @@ func configAddressesRouter(router *httprouter.Router) {
 	router.POST("/addresses", AddAddresses)
 	router.GET("/addresses/:argID", GetAddresses)
 	router.PUT("/addresses/:argID", UpdateAddresses)
+	router.PATCH("/addresses/:argID", PatchAddresses)
+	router.POST("/addresses/:argID", staticSegment("bulk", BulkAddresses, nil))
 	router.DELETE("/addresses/:argID", DeleteAddresses)
+	router.GET("/addresses/:argID/history", GetRecordHistory("addresses", "argID"))
 }
 
 func configGinAddressesRouter(router gin.IRoutes) {
@@ -28,19 +31,46 @@ func configGinAddressesRouter(router gin.IRoutes) {
 	router.POST("/addresses", ConverHttprouterToGin(AddAddresses))
 	router.GET("/addresses/:argID", ConverHttprouterToGin(GetAddresses))
 	router.PUT("/addresses/:argID", ConverHttprouterToGin(UpdateAddresses))
+	router.PATCH("/addresses/:argID", ConverHttprouterToGin(PatchAddresses))
+	router.POST("/addresses/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkAddresses, nil)))
 	router.DELETE("/addresses/:argID", ConverHttprouterToGin(DeleteAddresses))
+	router.GET("/addresses/:argID/history", ConverHttprouterToGin(GetRecordHistory("addresses", "argID")))
 }
 
 // GetAllAddresses is a function to get a slice of record(s) from addresses table in the rocket_development database
 // @Summary Get list of Addresses
 // @Tags Addresses
-// @Description GetAllAddresses is a handler to get a slice of record(s) from addresses table in the rocket_development database
+// @Description GetAllAddresses is a handler to get a slice of record(s) from addresses table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: buildings, customers"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   address_type query    string  false        "filter address_type=value or address_type[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   entity   query    string  false        "filter entity=value or entity[op]=value"
+// @Param   number_and_street query    string  false        "filter number_and_street=value or number_and_street[op]=value"
+// @Param   suite_or_apartment query    string  false        "filter suite_or_apartment=value or suite_or_apartment[op]=value"
+// @Param   city     query    string  false        "filter city=value or city[op]=value"
+// @Param   postal_code query    string  false        "filter postal_code=value or postal_code[op]=value"
+// @Param   country  query    string  false        "filter country=value or country[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   latitude query    number  false        "filter latitude=value or latitude[op]=value"
+// @Param   longitude query    number  false        "filter longitude=value or longitude[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Addresses}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /addresses [get]
@@ -59,20 +89,40 @@ func GetAllAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "addresses")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "addresses", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllAddresses(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "addresses", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "addresses", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllAddresses(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +134,14 @@ func GetAllAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: buildings, customers"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Addresses
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /addresses/{argID} [get]
@@ -98,18 +155,41 @@ func GetAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "addresses")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "addresses")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "addresses", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetAddresses(ctx, argID)
+	record, err := dao.GetAddresses(ctx, argID, recordFields("addresses", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "addresses", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddAddresses add to add a single record to addresses table in the rocket_development database
@@ -122,8 +202,12 @@ func GetAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Addresses
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /addresses [post]
-// echo '{"id": 9,"address_type": "AYfFKNXQDtOaVIjpLjBAxcnqv","status": "RJFNvWfBaaCBJPwVCcMRQMMQL","entity": "UuClKRrDtPnvuKUqNRkAneSFS","number_and_street": "dgpigDyvDvwoAgxlWCWGiaaxQ","suite_or_apartment": "WEacKmZoJXGxLdwNTCEfwnBpc","city": "DxgfTmKEDGrqmTblLQJJTnHrF","postal_code": "hTldCmuHaJKqqJQTSrgNXHsrd","country": "KGPkiCTFiPYGQpowEihdQKkXa","notes": "ECvhVtuTTDknvwPreZYudiYdo","created_at": "2059-10-06T03:16:43.406006363-04:00","updated_at": "2251-09-17T07:03:29.081725219-04:00","latitude": 0.50799745,"longitude": 0.15979338}' | http POST "http://localhost:8080/addresses" X-Api-User:user123
+// echo '{"id": 9,"address_type": "AYfFKNXQDtOaVIjpLjBAxcnqv","status": "RJFNvWfBaaCBJPwVCcMRQMMQL","entity": "UuClKRrDtPnvuKUqNRkAneSFS","number_and_street": "dgpigDyvDvwoAgxlWCWGiaaxQ","suite_or_apartment": "WEacKmZoJXGxLdwNTCEfwnBpc","city": "DxgfTmKEDGrqmTblLQJJTnHrF","postal_code": "hTldCmuHaJKqqJQTSrgNXHsrd","country": "KGPkiCTFiPYGQpowEihdQKkXa","notes": "ECvhVtuTTDknvwPreZYudiYdo","latitude": 0.50799745,"longitude": 0.15979338}' | http POST "http://localhost:8080/addresses" X-Api-User:user123
 func AddAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	addresses := &model.Addresses{}
@@ -134,13 +218,14 @@ func AddAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := addresses.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	addresses.Prepare()
 
 	if err := addresses.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +246,24 @@ func AddAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateAddresses Update a single record from addresses table in the rocket_development database
 // @Summary Update an record in table addresses
-// @Description Update a single record from addresses table in the rocket_development database
+// @Description Update a single record from addresses table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Addresses
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Addresses body model.Addresses true "Update Addresses record"
 // @Success 200 {object} model.Addresses
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /addresses/{argID} [put]
-// echo '{"id": 9,"address_type": "AYfFKNXQDtOaVIjpLjBAxcnqv","status": "RJFNvWfBaaCBJPwVCcMRQMMQL","entity": "UuClKRrDtPnvuKUqNRkAneSFS","number_and_street": "dgpigDyvDvwoAgxlWCWGiaaxQ","suite_or_apartment": "WEacKmZoJXGxLdwNTCEfwnBpc","city": "DxgfTmKEDGrqmTblLQJJTnHrF","postal_code": "hTldCmuHaJKqqJQTSrgNXHsrd","country": "KGPkiCTFiPYGQpowEihdQKkXa","notes": "ECvhVtuTTDknvwPreZYudiYdo","created_at": "2059-10-06T03:16:43.406006363-04:00","updated_at": "2251-09-17T07:03:29.081725219-04:00","latitude": 0.50799745,"longitude": 0.15979338}' | http PUT "http://localhost:8080/addresses/1"  X-Api-User:user123
+// echo '{"id": 9,"address_type": "AYfFKNXQDtOaVIjpLjBAxcnqv","status": "RJFNvWfBaaCBJPwVCcMRQMMQL","entity": "UuClKRrDtPnvuKUqNRkAneSFS","number_and_street": "dgpigDyvDvwoAgxlWCWGiaaxQ","suite_or_apartment": "WEacKmZoJXGxLdwNTCEfwnBpc","city": "DxgfTmKEDGrqmTblLQJJTnHrF","postal_code": "hTldCmuHaJKqqJQTSrgNXHsrd","country": "KGPkiCTFiPYGQpowEihdQKkXa","notes": "ECvhVtuTTDknvwPreZYudiYdo","latitude": 0.50799745,"longitude": 0.15979338}' | http PUT "http://localhost:8080/addresses/1"  X-Api-User:user123
 func UpdateAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +273,12 @@ func UpdateAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	addresses := &model.Addresses{}
 	if err := readJSON(r, addresses); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +286,14 @@ func UpdateAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 	}
 
 	if err := addresses.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	addresses.Prepare()
 
 	if err := addresses.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +313,77 @@ func UpdateAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 	writeJSON(ctx, w, addresses)
 }
 
+// PatchAddresses Patch a single record from addresses table in the rocket_development database
+// @Summary Patch an record in table addresses
+// @Description Patch a single record from addresses table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Addresses
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Addresses body model.Addresses true "fields of Addresses to change, null clears a field"
+// @Success 200 {object} model.Addresses
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /addresses/{argID} [patch]
+// echo '{"address_type": null}' | http PATCH "http://localhost:8080/addresses/1"  X-Api-User:user123
+func PatchAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "addresses", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	addresses, _, err := dao.PatchAddresses(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, addresses)
+}
+
+// BulkAddresses runs create, update and delete operations on records of the addresses table in the rocket_development database
+// @Summary Bulk create, update and delete records of table addresses
+// @Description BulkAddresses runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Addresses
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Addresses records, the record of create and update is a Addresses"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /addresses/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 9,"address_type": "AYfFKNXQDtOaVIjpLjBAxcnqv","status": "RJFNvWfBaaCBJPwVCcMRQMMQL","entity": "UuClKRrDtPnvuKUqNRkAneSFS","number_and_street": "dgpigDyvDvwoAgxlWCWGiaaxQ","suite_or_apartment": "WEacKmZoJXGxLdwNTCEfwnBpc","city": "DxgfTmKEDGrqmTblLQJJTnHrF","postal_code": "hTldCmuHaJKqqJQTSrgNXHsrd","country": "KGPkiCTFiPYGQpowEihdQKkXa","notes": "ECvhVtuTTDknvwPreZYudiYdo","latitude": 0.50799745,"longitude": 0.15979338}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/addresses/bulk" X-Api-User:user123
+func BulkAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "addresses")
+}
+
 // DeleteAddresses Delete a single record from addresses table in the rocket_development database
 // @Summary Delete a record from addresses
 // @Description Delete a single record from addresses table in the rocket_development database
@@ -221,9 +391,18 @@ func UpdateAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Addresses
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /addresses/{argID} [delete]
 // http DELETE "http://localhost:8080/addresses/1" X-Api-User:user123
 func DeleteAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +414,38 @@ func DeleteAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "addresses", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteAddresses(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "addresses", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "addresses", argID)
+		return
+	}
+
+	if _, err := dao.DeleteAddresses(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -20,7 +20,10 @@ func configAdminUsersRouter(router *httprouter.Router) {
 	router.POST("/adminusers", AddAdminUsers)
 	router.GET("/adminusers/:argID", GetAdminUsers)
 	router.PUT("/adminusers/:argID", UpdateAdminUsers)
+	router.PATCH("/adminusers/:argID", PatchAdminUsers)
+	router.POST("/adminusers/:argID", staticSegment("bulk", BulkAdminUsers, nil))
 	router.DELETE("/adminusers/:argID", DeleteAdminUsers)
+	router.GET("/adminusers/:argID/history", GetRecordHistory("admin_users", "argID"))
 }
 
 func configGinAdminUsersRouter(router gin.IRoutes) {
@@ -28,19 +31,39 @@ func configGinAdminUsersRouter(router gin.IRoutes) {
 	router.POST("/adminusers", ConverHttprouterToGin(AddAdminUsers))
 	router.GET("/adminusers/:argID", ConverHttprouterToGin(GetAdminUsers))
 	router.PUT("/adminusers/:argID", ConverHttprouterToGin(UpdateAdminUsers))
+	router.PATCH("/adminusers/:argID", ConverHttprouterToGin(PatchAdminUsers))
+	router.POST("/adminusers/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkAdminUsers, nil)))
 	router.DELETE("/adminusers/:argID", ConverHttprouterToGin(DeleteAdminUsers))
+	router.GET("/adminusers/:argID/history", ConverHttprouterToGin(GetRecordHistory("admin_users", "argID")))
 }
 
 // GetAllAdminUsers is a function to get a slice of record(s) from admin_users table in the rocket_development database
 // @Summary Get list of AdminUsers
 // @Tags AdminUsers
-// @Description GetAllAdminUsers is a handler to get a slice of record(s) from admin_users table in the rocket_development database
+// @Description GetAllAdminUsers is a handler to get a slice of record(s) from admin_users table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   email    query    string  false        "filter email=value or email[op]=value"
+// @Param   encrypted_password query    string  false        "filter encrypted_password=value or encrypted_password[op]=value"
+// @Param   reset_password_token query    string  false        "filter reset_password_token=value or reset_password_token[op]=value"
+// @Param   reset_password_sent_at query    string  false        "filter reset_password_sent_at=value or reset_password_sent_at[op]=value"
+// @Param   remember_created_at query    string  false        "filter remember_created_at=value or remember_created_at[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.AdminUsers}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /adminusers [get]
@@ -59,20 +82,40 @@ func GetAllAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "admin_users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "admin_users", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllAdminUsers(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "admin_users", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "admin_users", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllAdminUsers(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +127,13 @@ func GetAllAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.AdminUsers
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /adminusers/{argID} [get]
@@ -98,18 +147,41 @@ func GetAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "admin_users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "admin_users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "admin_users", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetAdminUsers(ctx, argID)
+	record, err := dao.GetAdminUsers(ctx, argID, recordFields("admin_users", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "admin_users", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddAdminUsers add to add a single record to admin_users table in the rocket_development database
@@ -122,8 +194,12 @@ func GetAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.AdminUsers
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /adminusers [post]
-// echo '{"id": 89,"email": "FfaEYJMOQWuRgXrqxxOlDZxvS","encrypted_password": "qBCiOEZoKtjuoJIUUGbjXsqAe","reset_password_token": "OlVBmYWyCWNAgcBlLFLrELBUV","reset_password_sent_at": "2215-02-05T14:01:44.963705025-05:00","remember_created_at": "2032-06-11T22:59:21.617071347-04:00","created_at": "2309-12-19T09:35:53.937234183-05:00","updated_at": "2287-07-31T15:03:08.954379317-04:00"}' | http POST "http://localhost:8080/adminusers" X-Api-User:user123
+// echo '{"id": 89,"email": "FfaEYJMOQWuRgXrqxxOlDZxvS","encrypted_password": "qBCiOEZoKtjuoJIUUGbjXsqAe","reset_password_token": "OlVBmYWyCWNAgcBlLFLrELBUV","reset_password_sent_at": "2215-02-05T14:01:44.963705025-05:00","remember_created_at": "2032-06-11T22:59:21.617071347-04:00"}' | http POST "http://localhost:8080/adminusers" X-Api-User:user123
 func AddAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	adminusers := &model.AdminUsers{}
@@ -134,13 +210,14 @@ func AddAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := adminusers.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	adminusers.Prepare()
 
 	if err := adminusers.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +238,24 @@ func AddAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateAdminUsers Update a single record from admin_users table in the rocket_development database
 // @Summary Update an record in table admin_users
-// @Description Update a single record from admin_users table in the rocket_development database
+// @Description Update a single record from admin_users table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags AdminUsers
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  AdminUsers body model.AdminUsers true "Update AdminUsers record"
 // @Success 200 {object} model.AdminUsers
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /adminusers/{argID} [put]
-// echo '{"id": 89,"email": "FfaEYJMOQWuRgXrqxxOlDZxvS","encrypted_password": "qBCiOEZoKtjuoJIUUGbjXsqAe","reset_password_token": "OlVBmYWyCWNAgcBlLFLrELBUV","reset_password_sent_at": "2215-02-05T14:01:44.963705025-05:00","remember_created_at": "2032-06-11T22:59:21.617071347-04:00","created_at": "2309-12-19T09:35:53.937234183-05:00","updated_at": "2287-07-31T15:03:08.954379317-04:00"}' | http PUT "http://localhost:8080/adminusers/1"  X-Api-User:user123
+// echo '{"id": 89,"email": "FfaEYJMOQWuRgXrqxxOlDZxvS","encrypted_password": "qBCiOEZoKtjuoJIUUGbjXsqAe","reset_password_token": "OlVBmYWyCWNAgcBlLFLrELBUV","reset_password_sent_at": "2215-02-05T14:01:44.963705025-05:00","remember_created_at": "2032-06-11T22:59:21.617071347-04:00"}' | http PUT "http://localhost:8080/adminusers/1"  X-Api-User:user123
 func UpdateAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +265,12 @@ func UpdateAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	adminusers := &model.AdminUsers{}
 	if err := readJSON(r, adminusers); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +278,14 @@ func UpdateAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := adminusers.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	adminusers.Prepare()
 
 	if err := adminusers.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +305,77 @@ func UpdateAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	writeJSON(ctx, w, adminusers)
 }
 
+// PatchAdminUsers Patch a single record from admin_users table in the rocket_development database
+// @Summary Patch an record in table admin_users
+// @Description Patch a single record from admin_users table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags AdminUsers
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  AdminUsers body model.AdminUsers true "fields of AdminUsers to change, null clears a field"
+// @Success 200 {object} model.AdminUsers
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /adminusers/{argID} [patch]
+// echo '{"reset_password_token": null}' | http PATCH "http://localhost:8080/adminusers/1"  X-Api-User:user123
+func PatchAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "admin_users", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	adminusers, _, err := dao.PatchAdminUsers(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, adminusers)
+}
+
+// BulkAdminUsers runs create, update and delete operations on records of the admin_users table in the rocket_development database
+// @Summary Bulk create, update and delete records of table admin_users
+// @Description BulkAdminUsers runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags AdminUsers
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on AdminUsers records, the record of create and update is a AdminUsers"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /adminusers/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 89,"email": "FfaEYJMOQWuRgXrqxxOlDZxvS","encrypted_password": "qBCiOEZoKtjuoJIUUGbjXsqAe","reset_password_token": "OlVBmYWyCWNAgcBlLFLrELBUV","reset_password_sent_at": "2215-02-05T14:01:44.963705025-05:00","remember_created_at": "2032-06-11T22:59:21.617071347-04:00"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/adminusers/bulk" X-Api-User:user123
+func BulkAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "admin_users")
+}
+
 // DeleteAdminUsers Delete a single record from admin_users table in the rocket_development database
 // @Summary Delete a record from admin_users
 // @Description Delete a single record from admin_users table in the rocket_development database
@@ -221,9 +383,18 @@ func UpdateAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.AdminUsers
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /adminusers/{argID} [delete]
 // http DELETE "http://localhost:8080/adminusers/1" X-Api-User:user123
 func DeleteAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +406,38 @@ func DeleteAdminUsers(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "admin_users", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteAdminUsers(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "admin_users", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "admin_users", argID)
+		return
+	}
+
+	if _, err := dao.DeleteAdminUsers(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
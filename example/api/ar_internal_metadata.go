@@ -20,7 +20,10 @@ func configArInternalMetadata_Router(router *httprouter.Router) {
 	router.POST("/arinternalmetadata_", AddArInternalMetadata_)
 	router.GET("/arinternalmetadata_/:argKey", GetArInternalMetadata_)
 	router.PUT("/arinternalmetadata_/:argKey", UpdateArInternalMetadata_)
+	router.PATCH("/arinternalmetadata_/:argKey", PatchArInternalMetadata_)
+	router.POST("/arinternalmetadata_/:argKey", staticSegment("bulk", BulkArInternalMetadata_, nil))
 	router.DELETE("/arinternalmetadata_/:argKey", DeleteArInternalMetadata_)
+	router.GET("/arinternalmetadata_/:argKey/history", GetRecordHistory("ar_internal_metadata", "argKey"))
 }
 
 func configGinArInternalMetadata_Router(router gin.IRoutes) {
@@ -28,19 +31,34 @@ func configGinArInternalMetadata_Router(router gin.IRoutes) {
 	router.POST("/arinternalmetadata_", ConverHttprouterToGin(AddArInternalMetadata_))
 	router.GET("/arinternalmetadata_/:argKey", ConverHttprouterToGin(GetArInternalMetadata_))
 	router.PUT("/arinternalmetadata_/:argKey", ConverHttprouterToGin(UpdateArInternalMetadata_))
+	router.PATCH("/arinternalmetadata_/:argKey", ConverHttprouterToGin(PatchArInternalMetadata_))
+	router.POST("/arinternalmetadata_/:argKey", ConverHttprouterToGin(staticSegment("bulk", BulkArInternalMetadata_, nil)))
 	router.DELETE("/arinternalmetadata_/:argKey", ConverHttprouterToGin(DeleteArInternalMetadata_))
+	router.GET("/arinternalmetadata_/:argKey/history", ConverHttprouterToGin(GetRecordHistory("ar_internal_metadata", "argKey")))
 }
 
 // GetAllArInternalMetadata_ is a function to get a slice of record(s) from ar_internal_metadata table in the rocket_development database
 // @Summary Get list of ArInternalMetadata_
 // @Tags ArInternalMetadata_
-// @Description GetAllArInternalMetadata_ is a handler to get a slice of record(s) from ar_internal_metadata table in the rocket_development database
+// @Description GetAllArInternalMetadata_ is a handler to get a slice of record(s) from ar_internal_metadata table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   key      query    string  false        "filter key=value or key[op]=value"
+// @Param   value    query    string  false        "filter value=value or value[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.ArInternalMetadata_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /arinternalmetadata_ [get]
@@ -59,20 +77,40 @@ func GetAllArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "ar_internal_metadata")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "ar_internal_metadata", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllArInternalMetadata_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "ar_internal_metadata", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "ar_internal_metadata", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllArInternalMetadata_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +122,13 @@ func GetAllArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 // @Accept  json
 // @Produce  json
 // @Param  argKey path string true "key"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.ArInternalMetadata_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /arinternalmetadata_/{argKey} [get]
@@ -98,18 +142,41 @@ func GetArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httproute
 		return
 	}
 
+	fields, err := readFields(r, "ar_internal_metadata")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "ar_internal_metadata")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "ar_internal_metadata", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetArInternalMetadata_(ctx, argKey)
+	record, err := dao.GetArInternalMetadata_(ctx, argKey, recordFields("ar_internal_metadata", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "ar_internal_metadata", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddArInternalMetadata_ add to add a single record to ar_internal_metadata table in the rocket_development database
@@ -122,8 +189,12 @@ func GetArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httproute
 // @Success 200 {object} model.ArInternalMetadata_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /arinternalmetadata_ [post]
-// echo '{"key": "XCFwLBcKuUmVkNvlmGPGxhHUs","value": "KGltpyOKDqFkXNAUBdynjmjbW","created_at": "2260-10-02T06:18:38.009009919-04:00","updated_at": "2035-04-08T00:31:51.454328451-04:00"}' | http POST "http://localhost:8080/arinternalmetadata_" X-Api-User:user123
+// echo '{"key": "XCFwLBcKuUmVkNvlmGPGxhHUs","value": "KGltpyOKDqFkXNAUBdynjmjbW"}' | http POST "http://localhost:8080/arinternalmetadata_" X-Api-User:user123
 func AddArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	arinternalmetadata_ := &model.ArInternalMetadata_{}
@@ -134,13 +205,14 @@ func AddArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httproute
 	}
 
 	if err := arinternalmetadata_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	arinternalmetadata_.Prepare()
 
 	if err := arinternalmetadata_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +233,24 @@ func AddArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httproute
 
 // UpdateArInternalMetadata_ Update a single record from ar_internal_metadata table in the rocket_development database
 // @Summary Update an record in table ar_internal_metadata
-// @Description Update a single record from ar_internal_metadata table in the rocket_development database
+// @Description Update a single record from ar_internal_metadata table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags ArInternalMetadata_
 // @Accept  json
 // @Produce  json
 // @Param  argKey path string true "key"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  ArInternalMetadata_ body model.ArInternalMetadata_ true "Update ArInternalMetadata_ record"
 // @Success 200 {object} model.ArInternalMetadata_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /arinternalmetadata_/{argKey} [put]
-// echo '{"key": "XCFwLBcKuUmVkNvlmGPGxhHUs","value": "KGltpyOKDqFkXNAUBdynjmjbW","created_at": "2260-10-02T06:18:38.009009919-04:00","updated_at": "2035-04-08T00:31:51.454328451-04:00"}' | http PUT "http://localhost:8080/arinternalmetadata_/hello world"  X-Api-User:user123
+// echo '{"key": "XCFwLBcKuUmVkNvlmGPGxhHUs","value": "KGltpyOKDqFkXNAUBdynjmjbW"}' | http PUT "http://localhost:8080/arinternalmetadata_/hello world"  X-Api-User:user123
 func UpdateArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +260,12 @@ func UpdateArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	arinternalmetadata_ := &model.ArInternalMetadata_{}
 	if err := readJSON(r, arinternalmetadata_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +273,14 @@ func UpdateArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 	}
 
 	if err := arinternalmetadata_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	arinternalmetadata_.Prepare()
 
 	if err := arinternalmetadata_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +300,77 @@ func UpdateArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 	writeJSON(ctx, w, arinternalmetadata_)
 }
 
+// PatchArInternalMetadata_ Patch a single record from ar_internal_metadata table in the rocket_development database
+// @Summary Patch an record in table ar_internal_metadata
+// @Description Patch a single record from ar_internal_metadata table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags ArInternalMetadata_
+// @Accept  json
+// @Produce  json
+// @Param  argKey path string true "key"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  ArInternalMetadata_ body model.ArInternalMetadata_ true "fields of ArInternalMetadata_ to change, null clears a field"
+// @Success 200 {object} model.ArInternalMetadata_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /arinternalmetadata_/{argKey} [patch]
+// echo '{"value": null}' | http PATCH "http://localhost:8080/arinternalmetadata_/hello world"  X-Api-User:user123
+func PatchArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argKey, err := parseString(ps, "argKey")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "ar_internal_metadata", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	arinternalmetadata_, _, err := dao.PatchArInternalMetadata_(ctx, argKey, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, arinternalmetadata_)
+}
+
+// BulkArInternalMetadata_ runs create, update and delete operations on records of the ar_internal_metadata table in the rocket_development database
+// @Summary Bulk create, update and delete records of table ar_internal_metadata
+// @Description BulkArInternalMetadata_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags ArInternalMetadata_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on ArInternalMetadata_ records, the record of create and update is a ArInternalMetadata_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /arinternalmetadata_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"key": "XCFwLBcKuUmVkNvlmGPGxhHUs","value": "KGltpyOKDqFkXNAUBdynjmjbW"}},{"op": "delete","id": "hello world"}]}' | http POST "http://localhost:8080/arinternalmetadata_/bulk" X-Api-User:user123
+func BulkArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "ar_internal_metadata")
+}
+
 // DeleteArInternalMetadata_ Delete a single record from ar_internal_metadata table in the rocket_development database
 // @Summary Delete a record from ar_internal_metadata
 // @Description Delete a single record from ar_internal_metadata table in the rocket_development database
@@ -221,9 +378,18 @@ func UpdateArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 // @Accept  json
 // @Produce  json
 // @Param  argKey path string true "key"
-// @Success 204 {object} model.ArInternalMetadata_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /arinternalmetadata_/{argKey} [delete]
 // http DELETE "http://localhost:8080/arinternalmetadata_/hello world" X-Api-User:user123
 func DeleteArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +401,38 @@ func DeleteArInternalMetadata_(w http.ResponseWriter, r *http.Request, ps httpro
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "ar_internal_metadata", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteArInternalMetadata_(ctx, argKey)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "ar_internal_metadata", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "ar_internal_metadata", argKey)
+		return
+	}
+
+	if _, err := dao.DeleteArInternalMetadata_(ctx, argKey); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetAudit is a function to get the audit log of the changes made to records in the rocket_development database
+// @Summary Get the audit log
+// @Tags Audit
+// @Description GetAudit is a handler to get the audit log entries, oldest first. Every create, update, delete and restore of a record is recorded with the principal of the X-Api-User header, the X-Request-Id of the request and the fields changed.
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   table    query    string  false        "entries of the table (defaults to every table)"
+// @Param   id       query    string  false        "entries of the record of table with this primary key"
+// @Param   since    query    string  false        "entries made at or after a date or RFC 3339 time"
+// @Success 200 {object} api.PagedResults{data=[]dao.AuditEntry}
+// @Failure 400 {object} api.HTTPError
+// @Router /audit [get]
+// http "http://localhost:8080/audit?table=elevators&id=12&since=2021-06-01" X-Api-User:user123
+func GetAudit(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	filter, err := dao.NewAuditFilter(r.FormValue("table"), r.FormValue("id"), r.FormValue("since"))
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	table := "audit_logs"
+	if filter.Table != "" {
+		table = filter.Table
+	}
+
+	if err := ValidateRequest(ctx, r, table, model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	auditEntries(ctx, w, r, filter)
+}
+
+// GetRecordHistory is a function to get the audit log of a single record
+// @Summary Get the change history of a record
+// @Tags Audit
+// @Description GetRecordHistory is a handler to get the audit log entries of a single record of a table, oldest first, served at the history path of every table e.g. /elevators_/12/history
+// @Accept  json
+// @Produce  json
+// @Param   resource path     string  true         "resource path of the table, e.g. elevators_"
+// @Param   argID    path     string  true         "primary key of the record"
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   since    query    string  false        "entries made at or after a date or RFC 3339 time"
+// @Success 200 {object} api.PagedResults{data=[]dao.AuditEntry}
+// @Failure 400 {object} api.HTTPError
+// @Router /{resource}/{argID}/history [get]
+// http "http://localhost:8080/elevators_/12/history" X-Api-User:user123
+func GetRecordHistory(table string, param string) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ctx := initializeContext(r)
+
+		filter, err := dao.NewAuditFilter(table, ps.ByName(param), r.FormValue("since"))
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		if err := ValidateRequest(ctx, r, table, model.RetrieveOne); err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		auditEntries(ctx, w, r, filter)
+	}
+}
+
+// auditEntries writes the page of the audit entries matching filter requested by r
+func auditEntries(ctx context.Context, w http.ResponseWriter, r *http.Request, filter *dao.AuditFilter) {
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	entries, totalRows, err := dao.GetAuditEntries(ctx, filter, page, pagesize)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, &PagedResults{Page: page, PageSize: pagesize, Data: entries, TotalRecords: totalRows})
+}
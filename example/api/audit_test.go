@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"restapi-golang-gin-gen/dao"
+)
+
+func TestRequestPrincipal(t *testing.T) {
+	useTestTables(t, "elevators")
+	savedHeader, savedInitializer := PrincipalHeader, ContextInitializer
+	t.Cleanup(func() { PrincipalHeader, ContextInitializer = savedHeader, savedInitializer })
+
+	authenticated := func(r *http.Request) context.Context {
+		return dao.WithPrincipal(r.Context(), "carol")
+	}
+
+	tests := []struct {
+		name        string
+		header      string
+		initializer ContextInitializerFunc
+		principal   string
+	}{
+		{"header", "X-Api-User", nil, "bob"},
+		{"no header", "X-Api-User", nil, ""},
+		{"context initializer wins over the header", "X-Api-User", authenticated, "carol"},
+		{"header ignored", "", nil, ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			PrincipalHeader, ContextInitializer = tt.header, tt.initializer
+			headers := map[string]string{RequestIDHeader: tt.name}
+			if tt.name != "no header" {
+				headers["X-Api-User"] = "bob"
+			}
+
+			if w := request(t, http.MethodPost, "/elevators_", headers, `{"status": "Active"}`); w.Code != http.StatusOK {
+				t.Fatalf("POST = %d, want 200: %s", w.Code, w.Body.String())
+			}
+
+			entries, _, err := dao.GetAuditEntries(context.Background(), &dao.AuditFilter{Table: "elevators"}, 0, 20)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != i+1 {
+				t.Fatalf("%d audit entries, want %d", len(entries), i+1)
+			}
+			entry := entries[i]
+			if entry.Principal.String != tt.principal || entry.Principal.Valid != (tt.principal != "") {
+				t.Errorf("principal = %v, want %q", entry.Principal, tt.principal)
+			}
+			if entry.RequestID.String != tt.name {
+				t.Errorf("request id = %v, want %q", entry.RequestID, tt.name)
+			}
+		})
+	}
+}
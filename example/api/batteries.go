@@ -18,29 +18,61 @@ var (
 func configBatteries_Router(router *httprouter.Router) {
 	router.GET("/batteries_", GetAllBatteries_)
 	router.POST("/batteries_", AddBatteries_)
-	router.GET("/batteries_/:argID", GetBatteries_)
+	router.GET("/batteries_/:argID", staticSegment("trash", TrashBatteries_, GetBatteries_))
 	router.PUT("/batteries_/:argID", UpdateBatteries_)
+	router.PATCH("/batteries_/:argID", PatchBatteries_)
+	router.POST("/batteries_/:argID", staticSegment("bulk", BulkBatteries_, nil))
 	router.DELETE("/batteries_/:argID", DeleteBatteries_)
+	router.GET("/batteries_/:argID/history", GetRecordHistory("batteries", "argID"))
+	router.POST("/batteries_/:argID/restore", RestoreBatteries_)
 }
 
 func configGinBatteries_Router(router gin.IRoutes) {
 	router.GET("/batteries_", ConverHttprouterToGin(GetAllBatteries_))
 	router.POST("/batteries_", ConverHttprouterToGin(AddBatteries_))
-	router.GET("/batteries_/:argID", ConverHttprouterToGin(GetBatteries_))
+	router.GET("/batteries_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashBatteries_, GetBatteries_)))
 	router.PUT("/batteries_/:argID", ConverHttprouterToGin(UpdateBatteries_))
+	router.PATCH("/batteries_/:argID", ConverHttprouterToGin(PatchBatteries_))
+	router.POST("/batteries_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBatteries_, nil)))
 	router.DELETE("/batteries_/:argID", ConverHttprouterToGin(DeleteBatteries_))
+	router.GET("/batteries_/:argID/history", ConverHttprouterToGin(GetRecordHistory("batteries", "argID")))
+	router.POST("/batteries_/:argID/restore", ConverHttprouterToGin(RestoreBatteries_))
 }
 
 // GetAllBatteries_ is a function to get a slice of record(s) from batteries table in the rocket_development database
 // @Summary Get list of Batteries_
 // @Tags Batteries_
-// @Description GetAllBatteries_ is a handler to get a slice of record(s) from batteries table in the rocket_development database
+// @Description GetAllBatteries_ is a handler to get a slice of record(s) from batteries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: building, columns, employee, interventions"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   employee_id query    int     false        "filter employee_id=value or employee_id[op]=value"
+// @Param   building_id query    int     false        "filter building_id=value or building_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   commission_date query    string  false        "filter commission_date=value or commission_date[op]=value"
+// @Param   last_inspection_date query    string  false        "filter last_inspection_date=value or last_inspection_date[op]=value"
+// @Param   operations_cert query    string  false        "filter operations_cert=value or operations_cert[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Batteries_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /batteries_ [get]
@@ -59,20 +91,129 @@ func GetAllBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "batteries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "batteries", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBatteries_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "batteries", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "batteries", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBatteries_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashBatteries_ is a function to get a slice of the soft deleted record(s) from batteries table in the rocket_development database
+// @Summary Get list of deleted Batteries_
+// @Tags Batteries_
+// @Description TrashBatteries_ is a handler to get a slice of the soft deleted record(s) from batteries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: building, columns, employee, interventions"
+// @Param   employee_id query    int     false        "filter employee_id=value or employee_id[op]=value"
+// @Param   building_id query    int     false        "filter building_id=value or building_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   commission_date query    string  false        "filter commission_date=value or commission_date[op]=value"
+// @Param   last_inspection_date query    string  false        "filter last_inspection_date=value or last_inspection_date[op]=value"
+// @Param   operations_cert query    string  false        "filter operations_cert=value or operations_cert[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Batteries_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /batteries_/trash [get]
+// http "http://localhost:8080/batteries_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "batteries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "batteries", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "batteries", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "batteries", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBatteries_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +225,14 @@ func GetAllBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: building, columns, employee, interventions"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Batteries_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /batteries_/{argID} [get]
@@ -98,18 +246,41 @@ func GetBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "batteries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "batteries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "batteries", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBatteries_(ctx, argID)
+	record, err := dao.GetBatteries_(ctx, argID, recordFields("batteries", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "batteries", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBatteries_ add to add a single record to batteries table in the rocket_development database
@@ -122,8 +293,12 @@ func GetBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Batteries_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /batteries_ [post]
-// echo '{"employee_id": 41,"building_id": 46,"id": 86,"type": "mZnFXfqWngUGRonwMnJVsODNb","status": "yGmagtNAXxaHcmCZvYulDqVAm","commission_date": "2035-08-21T21:56:14.966533016-04:00","last_inspection_date": "2232-06-20T21:05:07.239068364-04:00","operations_cert": "BVHSmAKsUOrgNHQgDjxVoikRf","information": "EdONBaGRmQXBIVttuaTVwIDNK","notes": "PxhnRaCaBPUlWUAHahGErVNqc","created_at": "2303-11-26T14:23:31.212269427-05:00","updated_at": "2115-03-05T11:08:36.574922594-05:00"}' | http POST "http://localhost:8080/batteries_" X-Api-User:user123
+// echo '{"employee_id": 41,"building_id": 46,"id": 86,"type": "mZnFXfqWngUGRonwMnJVsODNb","status": "yGmagtNAXxaHcmCZvYulDqVAm","commission_date": "2035-08-21T21:56:14.966533016-04:00","last_inspection_date": "2232-06-20T21:05:07.239068364-04:00","operations_cert": "BVHSmAKsUOrgNHQgDjxVoikRf","information": "EdONBaGRmQXBIVttuaTVwIDNK","notes": "PxhnRaCaBPUlWUAHahGErVNqc"}' | http POST "http://localhost:8080/batteries_" X-Api-User:user123
 func AddBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	batteries_ := &model.Batteries_{}
@@ -134,13 +309,14 @@ func AddBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := batteries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	batteries_.Prepare()
 
 	if err := batteries_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +337,24 @@ func AddBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateBatteries_ Update a single record from batteries table in the rocket_development database
 // @Summary Update an record in table batteries
-// @Description Update a single record from batteries table in the rocket_development database
+// @Description Update a single record from batteries table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Batteries_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Batteries_ body model.Batteries_ true "Update Batteries_ record"
 // @Success 200 {object} model.Batteries_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /batteries_/{argID} [put]
-// echo '{"employee_id": 41,"building_id": 46,"id": 86,"type": "mZnFXfqWngUGRonwMnJVsODNb","status": "yGmagtNAXxaHcmCZvYulDqVAm","commission_date": "2035-08-21T21:56:14.966533016-04:00","last_inspection_date": "2232-06-20T21:05:07.239068364-04:00","operations_cert": "BVHSmAKsUOrgNHQgDjxVoikRf","information": "EdONBaGRmQXBIVttuaTVwIDNK","notes": "PxhnRaCaBPUlWUAHahGErVNqc","created_at": "2303-11-26T14:23:31.212269427-05:00","updated_at": "2115-03-05T11:08:36.574922594-05:00"}' | http PUT "http://localhost:8080/batteries_/1"  X-Api-User:user123
+// echo '{"employee_id": 41,"building_id": 46,"id": 86,"type": "mZnFXfqWngUGRonwMnJVsODNb","status": "yGmagtNAXxaHcmCZvYulDqVAm","commission_date": "2035-08-21T21:56:14.966533016-04:00","last_inspection_date": "2232-06-20T21:05:07.239068364-04:00","operations_cert": "BVHSmAKsUOrgNHQgDjxVoikRf","information": "EdONBaGRmQXBIVttuaTVwIDNK","notes": "PxhnRaCaBPUlWUAHahGErVNqc"}' | http PUT "http://localhost:8080/batteries_/1"  X-Api-User:user123
 func UpdateBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +364,12 @@ func UpdateBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	batteries_ := &model.Batteries_{}
 	if err := readJSON(r, batteries_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +377,14 @@ func UpdateBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := batteries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	batteries_.Prepare()
 
 	if err := batteries_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +404,77 @@ func UpdateBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	writeJSON(ctx, w, batteries_)
 }
 
+// PatchBatteries_ Patch a single record from batteries table in the rocket_development database
+// @Summary Patch an record in table batteries
+// @Description Patch a single record from batteries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Batteries_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Batteries_ body model.Batteries_ true "fields of Batteries_ to change, null clears a field"
+// @Success 200 {object} model.Batteries_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /batteries_/{argID} [patch]
+// echo '{"employee_id": null}' | http PATCH "http://localhost:8080/batteries_/1"  X-Api-User:user123
+func PatchBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "batteries", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	batteries_, _, err := dao.PatchBatteries_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, batteries_)
+}
+
+// BulkBatteries_ runs create, update and delete operations on records of the batteries table in the rocket_development database
+// @Summary Bulk create, update and delete records of table batteries
+// @Description BulkBatteries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Batteries_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Batteries_ records, the record of create and update is a Batteries_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /batteries_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"employee_id": 41,"building_id": 46,"id": 86,"type": "mZnFXfqWngUGRonwMnJVsODNb","status": "yGmagtNAXxaHcmCZvYulDqVAm","commission_date": "2035-08-21T21:56:14.966533016-04:00","last_inspection_date": "2232-06-20T21:05:07.239068364-04:00","operations_cert": "BVHSmAKsUOrgNHQgDjxVoikRf","information": "EdONBaGRmQXBIVttuaTVwIDNK","notes": "PxhnRaCaBPUlWUAHahGErVNqc"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/batteries_/bulk" X-Api-User:user123
+func BulkBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "batteries")
+}
+
 // DeleteBatteries_ Delete a single record from batteries table in the rocket_development database
 // @Summary Delete a record from batteries
 // @Description Delete a single record from batteries table in the rocket_development database
@@ -221,9 +482,18 @@ func UpdateBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Batteries_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /batteries_/{argID} [delete]
 // http DELETE "http://localhost:8080/batteries_/1" X-Api-User:user123
 func DeleteBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +505,85 @@ func DeleteBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "batteries", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBatteries_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "batteries", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBatteries_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreBatteries_ Restore a soft deleted record of the batteries table in the rocket_development database
+// @Summary Restore a deleted record of batteries
+// @Description Restore a soft deleted record of the batteries table in the rocket_development database, deleted_at is cleared
+// @Tags Batteries_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Batteries_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /batteries_/{argID}/restore [post]
+// http POST "http://localhost:8080/batteries_/1/restore" X-Api-User:user123
+func RestoreBatteries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "batteries", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreBatteries_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
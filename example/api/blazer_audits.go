@@ -20,7 +20,10 @@ func configBlazerAudits_Router(router *httprouter.Router) {
 	router.POST("/blazeraudits_", AddBlazerAudits_)
 	router.GET("/blazeraudits_/:argID", GetBlazerAudits_)
 	router.PUT("/blazeraudits_/:argID", UpdateBlazerAudits_)
+	router.PATCH("/blazeraudits_/:argID", PatchBlazerAudits_)
+	router.POST("/blazeraudits_/:argID", staticSegment("bulk", BulkBlazerAudits_, nil))
 	router.DELETE("/blazeraudits_/:argID", DeleteBlazerAudits_)
+	router.GET("/blazeraudits_/:argID/history", GetRecordHistory("blazer_audits", "argID"))
 }
 
 func configGinBlazerAudits_Router(router gin.IRoutes) {
@@ -28,19 +31,36 @@ func configGinBlazerAudits_Router(router gin.IRoutes) {
 	router.POST("/blazeraudits_", ConverHttprouterToGin(AddBlazerAudits_))
 	router.GET("/blazeraudits_/:argID", ConverHttprouterToGin(GetBlazerAudits_))
 	router.PUT("/blazeraudits_/:argID", ConverHttprouterToGin(UpdateBlazerAudits_))
+	router.PATCH("/blazeraudits_/:argID", ConverHttprouterToGin(PatchBlazerAudits_))
+	router.POST("/blazeraudits_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBlazerAudits_, nil)))
 	router.DELETE("/blazeraudits_/:argID", ConverHttprouterToGin(DeleteBlazerAudits_))
+	router.GET("/blazeraudits_/:argID/history", ConverHttprouterToGin(GetRecordHistory("blazer_audits", "argID")))
 }
 
 // GetAllBlazerAudits_ is a function to get a slice of record(s) from blazer_audits table in the rocket_development database
 // @Summary Get list of BlazerAudits_
 // @Tags BlazerAudits_
-// @Description GetAllBlazerAudits_ is a handler to get a slice of record(s) from blazer_audits table in the rocket_development database
+// @Description GetAllBlazerAudits_ is a handler to get a slice of record(s) from blazer_audits table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   user_id  query    int     false        "filter user_id=value or user_id[op]=value"
+// @Param   query_id query    int     false        "filter query_id=value or query_id[op]=value"
+// @Param   statement query    string  false        "filter statement=value or statement[op]=value"
+// @Param   data_source query    string  false        "filter data_source=value or data_source[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BlazerAudits_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /blazeraudits_ [get]
@@ -59,20 +79,40 @@ func GetAllBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "blazer_audits")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "blazer_audits", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBlazerAudits_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "blazer_audits", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "blazer_audits", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBlazerAudits_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +124,13 @@ func GetAllBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BlazerAudits_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /blazeraudits_/{argID} [get]
@@ -98,18 +144,41 @@ func GetBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	fields, err := readFields(r, "blazer_audits")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "blazer_audits")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "blazer_audits", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBlazerAudits_(ctx, argID)
+	record, err := dao.GetBlazerAudits_(ctx, argID, recordFields("blazer_audits", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "blazer_audits", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBlazerAudits_ add to add a single record to blazer_audits table in the rocket_development database
@@ -122,8 +191,12 @@ func GetBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Success 200 {object} model.BlazerAudits_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazeraudits_ [post]
-// echo '{"id": 96,"user_id": 76,"query_id": 47,"statement": "FeadqVcmKFJuGrZomvHXHeVWO","data_source": "MvmUyFXTlDwQOtsnFEAwGGkiW","created_at": "2249-05-10T02:56:46.439506764-04:00"}' | http POST "http://localhost:8080/blazeraudits_" X-Api-User:user123
+// echo '{"id": 96,"user_id": 76,"query_id": 47,"statement": "FeadqVcmKFJuGrZomvHXHeVWO","data_source": "MvmUyFXTlDwQOtsnFEAwGGkiW"}' | http POST "http://localhost:8080/blazeraudits_" X-Api-User:user123
 func AddBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	blazeraudits_ := &model.BlazerAudits_{}
@@ -134,13 +207,14 @@ func AddBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := blazeraudits_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazeraudits_.Prepare()
 
 	if err := blazeraudits_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +235,24 @@ func AddBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 
 // UpdateBlazerAudits_ Update a single record from blazer_audits table in the rocket_development database
 // @Summary Update an record in table blazer_audits
-// @Description Update a single record from blazer_audits table in the rocket_development database
+// @Description Update a single record from blazer_audits table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BlazerAudits_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BlazerAudits_ body model.BlazerAudits_ true "Update BlazerAudits_ record"
 // @Success 200 {object} model.BlazerAudits_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazeraudits_/{argID} [put]
-// echo '{"id": 96,"user_id": 76,"query_id": 47,"statement": "FeadqVcmKFJuGrZomvHXHeVWO","data_source": "MvmUyFXTlDwQOtsnFEAwGGkiW","created_at": "2249-05-10T02:56:46.439506764-04:00"}' | http PUT "http://localhost:8080/blazeraudits_/1"  X-Api-User:user123
+// echo '{"id": 96,"user_id": 76,"query_id": 47,"statement": "FeadqVcmKFJuGrZomvHXHeVWO","data_source": "MvmUyFXTlDwQOtsnFEAwGGkiW"}' | http PUT "http://localhost:8080/blazeraudits_/1"  X-Api-User:user123
 func UpdateBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +262,12 @@ func UpdateBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	blazeraudits_ := &model.BlazerAudits_{}
 	if err := readJSON(r, blazeraudits_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +275,14 @@ func UpdateBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	}
 
 	if err := blazeraudits_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazeraudits_.Prepare()
 
 	if err := blazeraudits_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +302,77 @@ func UpdateBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	writeJSON(ctx, w, blazeraudits_)
 }
 
+// PatchBlazerAudits_ Patch a single record from blazer_audits table in the rocket_development database
+// @Summary Patch an record in table blazer_audits
+// @Description Patch a single record from blazer_audits table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BlazerAudits_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BlazerAudits_ body model.BlazerAudits_ true "fields of BlazerAudits_ to change, null clears a field"
+// @Success 200 {object} model.BlazerAudits_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /blazeraudits_/{argID} [patch]
+// echo '{"user_id": null}' | http PATCH "http://localhost:8080/blazeraudits_/1"  X-Api-User:user123
+func PatchBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_audits", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	blazeraudits_, _, err := dao.PatchBlazerAudits_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, blazeraudits_)
+}
+
+// BulkBlazerAudits_ runs create, update and delete operations on records of the blazer_audits table in the rocket_development database
+// @Summary Bulk create, update and delete records of table blazer_audits
+// @Description BulkBlazerAudits_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BlazerAudits_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BlazerAudits_ records, the record of create and update is a BlazerAudits_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /blazeraudits_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 96,"user_id": 76,"query_id": 47,"statement": "FeadqVcmKFJuGrZomvHXHeVWO","data_source": "MvmUyFXTlDwQOtsnFEAwGGkiW"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/blazeraudits_/bulk" X-Api-User:user123
+func BulkBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "blazer_audits")
+}
+
 // DeleteBlazerAudits_ Delete a single record from blazer_audits table in the rocket_development database
 // @Summary Delete a record from blazer_audits
 // @Description Delete a single record from blazer_audits table in the rocket_development database
@@ -221,9 +380,18 @@ func UpdateBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BlazerAudits_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazeraudits_/{argID} [delete]
 // http DELETE "http://localhost:8080/blazeraudits_/1" X-Api-User:user123
 func DeleteBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +403,38 @@ func DeleteBlazerAudits_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "blazer_audits", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBlazerAudits_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_audits", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "blazer_audits", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBlazerAudits_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -20,7 +20,10 @@ func configBlazerChecks_Router(router *httprouter.Router) {
 	router.POST("/blazerchecks_", AddBlazerChecks_)
 	router.GET("/blazerchecks_/:argID", GetBlazerChecks_)
 	router.PUT("/blazerchecks_/:argID", UpdateBlazerChecks_)
+	router.PATCH("/blazerchecks_/:argID", PatchBlazerChecks_)
+	router.POST("/blazerchecks_/:argID", staticSegment("bulk", BulkBlazerChecks_, nil))
 	router.DELETE("/blazerchecks_/:argID", DeleteBlazerChecks_)
+	router.GET("/blazerchecks_/:argID/history", GetRecordHistory("blazer_checks", "argID"))
 }
 
 func configGinBlazerChecks_Router(router gin.IRoutes) {
@@ -28,19 +31,42 @@ func configGinBlazerChecks_Router(router gin.IRoutes) {
 	router.POST("/blazerchecks_", ConverHttprouterToGin(AddBlazerChecks_))
 	router.GET("/blazerchecks_/:argID", ConverHttprouterToGin(GetBlazerChecks_))
 	router.PUT("/blazerchecks_/:argID", ConverHttprouterToGin(UpdateBlazerChecks_))
+	router.PATCH("/blazerchecks_/:argID", ConverHttprouterToGin(PatchBlazerChecks_))
+	router.POST("/blazerchecks_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBlazerChecks_, nil)))
 	router.DELETE("/blazerchecks_/:argID", ConverHttprouterToGin(DeleteBlazerChecks_))
+	router.GET("/blazerchecks_/:argID/history", ConverHttprouterToGin(GetRecordHistory("blazer_checks", "argID")))
 }
 
 // GetAllBlazerChecks_ is a function to get a slice of record(s) from blazer_checks table in the rocket_development database
 // @Summary Get list of BlazerChecks_
 // @Tags BlazerChecks_
-// @Description GetAllBlazerChecks_ is a handler to get a slice of record(s) from blazer_checks table in the rocket_development database
+// @Description GetAllBlazerChecks_ is a handler to get a slice of record(s) from blazer_checks table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   creator_id query    int     false        "filter creator_id=value or creator_id[op]=value"
+// @Param   query_id query    int     false        "filter query_id=value or query_id[op]=value"
+// @Param   state    query    string  false        "filter state=value or state[op]=value"
+// @Param   schedule query    string  false        "filter schedule=value or schedule[op]=value"
+// @Param   emails   query    string  false        "filter emails=value or emails[op]=value"
+// @Param   slack_channels query    string  false        "filter slack_channels=value or slack_channels[op]=value"
+// @Param   check_type query    string  false        "filter check_type=value or check_type[op]=value"
+// @Param   message  query    string  false        "filter message=value or message[op]=value"
+// @Param   last_run_at query    string  false        "filter last_run_at=value or last_run_at[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BlazerChecks_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /blazerchecks_ [get]
@@ -59,20 +85,40 @@ func GetAllBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "blazer_checks")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "blazer_checks", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBlazerChecks_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "blazer_checks", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "blazer_checks", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBlazerChecks_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +130,13 @@ func GetAllBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BlazerChecks_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /blazerchecks_/{argID} [get]
@@ -98,18 +150,41 @@ func GetBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	fields, err := readFields(r, "blazer_checks")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "blazer_checks")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "blazer_checks", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBlazerChecks_(ctx, argID)
+	record, err := dao.GetBlazerChecks_(ctx, argID, recordFields("blazer_checks", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "blazer_checks", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBlazerChecks_ add to add a single record to blazer_checks table in the rocket_development database
@@ -122,8 +197,12 @@ func GetBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Success 200 {object} model.BlazerChecks_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerchecks_ [post]
-// echo '{"id": 9,"creator_id": 71,"query_id": 7,"state": "crRNNIGcevJZjpPLSccRDOdme","schedule": "iIwHlFKttyxPYrhmuoPIwqFXl","emails": "vJQkEcIyhTbXCkfugrbsjoCpX","slack_channels": "GJTsMGnSSkbrIaMDFAxgAldAK","check_type": "xIsdZpENAnmNRgWOYMZEumeYm","message": "nvnwCTPFpIgeVsdktmiSyiYTi","last_run_at": "2255-09-05T06:19:59.846729943-04:00","created_at": "2268-03-31T11:53:04.773590824-04:00","updated_at": "2042-12-05T07:00:47.204514626-05:00"}' | http POST "http://localhost:8080/blazerchecks_" X-Api-User:user123
+// echo '{"id": 9,"creator_id": 71,"query_id": 7,"state": "crRNNIGcevJZjpPLSccRDOdme","schedule": "iIwHlFKttyxPYrhmuoPIwqFXl","emails": "vJQkEcIyhTbXCkfugrbsjoCpX","slack_channels": "GJTsMGnSSkbrIaMDFAxgAldAK","check_type": "xIsdZpENAnmNRgWOYMZEumeYm","message": "nvnwCTPFpIgeVsdktmiSyiYTi","last_run_at": "2255-09-05T06:19:59.846729943-04:00"}' | http POST "http://localhost:8080/blazerchecks_" X-Api-User:user123
 func AddBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	blazerchecks_ := &model.BlazerChecks_{}
@@ -134,13 +213,14 @@ func AddBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := blazerchecks_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerchecks_.Prepare()
 
 	if err := blazerchecks_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +241,24 @@ func AddBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 
 // UpdateBlazerChecks_ Update a single record from blazer_checks table in the rocket_development database
 // @Summary Update an record in table blazer_checks
-// @Description Update a single record from blazer_checks table in the rocket_development database
+// @Description Update a single record from blazer_checks table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BlazerChecks_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BlazerChecks_ body model.BlazerChecks_ true "Update BlazerChecks_ record"
 // @Success 200 {object} model.BlazerChecks_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerchecks_/{argID} [put]
-// echo '{"id": 9,"creator_id": 71,"query_id": 7,"state": "crRNNIGcevJZjpPLSccRDOdme","schedule": "iIwHlFKttyxPYrhmuoPIwqFXl","emails": "vJQkEcIyhTbXCkfugrbsjoCpX","slack_channels": "GJTsMGnSSkbrIaMDFAxgAldAK","check_type": "xIsdZpENAnmNRgWOYMZEumeYm","message": "nvnwCTPFpIgeVsdktmiSyiYTi","last_run_at": "2255-09-05T06:19:59.846729943-04:00","created_at": "2268-03-31T11:53:04.773590824-04:00","updated_at": "2042-12-05T07:00:47.204514626-05:00"}' | http PUT "http://localhost:8080/blazerchecks_/1"  X-Api-User:user123
+// echo '{"id": 9,"creator_id": 71,"query_id": 7,"state": "crRNNIGcevJZjpPLSccRDOdme","schedule": "iIwHlFKttyxPYrhmuoPIwqFXl","emails": "vJQkEcIyhTbXCkfugrbsjoCpX","slack_channels": "GJTsMGnSSkbrIaMDFAxgAldAK","check_type": "xIsdZpENAnmNRgWOYMZEumeYm","message": "nvnwCTPFpIgeVsdktmiSyiYTi","last_run_at": "2255-09-05T06:19:59.846729943-04:00"}' | http PUT "http://localhost:8080/blazerchecks_/1"  X-Api-User:user123
 func UpdateBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +268,12 @@ func UpdateBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	blazerchecks_ := &model.BlazerChecks_{}
 	if err := readJSON(r, blazerchecks_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +281,14 @@ func UpdateBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	}
 
 	if err := blazerchecks_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerchecks_.Prepare()
 
 	if err := blazerchecks_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +308,77 @@ func UpdateBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	writeJSON(ctx, w, blazerchecks_)
 }
 
+// PatchBlazerChecks_ Patch a single record from blazer_checks table in the rocket_development database
+// @Summary Patch an record in table blazer_checks
+// @Description Patch a single record from blazer_checks table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BlazerChecks_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BlazerChecks_ body model.BlazerChecks_ true "fields of BlazerChecks_ to change, null clears a field"
+// @Success 200 {object} model.BlazerChecks_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /blazerchecks_/{argID} [patch]
+// echo '{"creator_id": null}' | http PATCH "http://localhost:8080/blazerchecks_/1"  X-Api-User:user123
+func PatchBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_checks", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	blazerchecks_, _, err := dao.PatchBlazerChecks_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, blazerchecks_)
+}
+
+// BulkBlazerChecks_ runs create, update and delete operations on records of the blazer_checks table in the rocket_development database
+// @Summary Bulk create, update and delete records of table blazer_checks
+// @Description BulkBlazerChecks_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BlazerChecks_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BlazerChecks_ records, the record of create and update is a BlazerChecks_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /blazerchecks_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 9,"creator_id": 71,"query_id": 7,"state": "crRNNIGcevJZjpPLSccRDOdme","schedule": "iIwHlFKttyxPYrhmuoPIwqFXl","emails": "vJQkEcIyhTbXCkfugrbsjoCpX","slack_channels": "GJTsMGnSSkbrIaMDFAxgAldAK","check_type": "xIsdZpENAnmNRgWOYMZEumeYm","message": "nvnwCTPFpIgeVsdktmiSyiYTi","last_run_at": "2255-09-05T06:19:59.846729943-04:00"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/blazerchecks_/bulk" X-Api-User:user123
+func BulkBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "blazer_checks")
+}
+
 // DeleteBlazerChecks_ Delete a single record from blazer_checks table in the rocket_development database
 // @Summary Delete a record from blazer_checks
 // @Description Delete a single record from blazer_checks table in the rocket_development database
@@ -221,9 +386,18 @@ func UpdateBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BlazerChecks_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerchecks_/{argID} [delete]
 // http DELETE "http://localhost:8080/blazerchecks_/1" X-Api-User:user123
 func DeleteBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +409,38 @@ func DeleteBlazerChecks_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "blazer_checks", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBlazerChecks_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_checks", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "blazer_checks", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBlazerChecks_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -20,7 +20,10 @@ func configBlazerDashboardQueries_Router(router *httprouter.Router) {
 	router.POST("/blazerdashboardqueries_", AddBlazerDashboardQueries_)
 	router.GET("/blazerdashboardqueries_/:argID", GetBlazerDashboardQueries_)
 	router.PUT("/blazerdashboardqueries_/:argID", UpdateBlazerDashboardQueries_)
+	router.PATCH("/blazerdashboardqueries_/:argID", PatchBlazerDashboardQueries_)
+	router.POST("/blazerdashboardqueries_/:argID", staticSegment("bulk", BulkBlazerDashboardQueries_, nil))
 	router.DELETE("/blazerdashboardqueries_/:argID", DeleteBlazerDashboardQueries_)
+	router.GET("/blazerdashboardqueries_/:argID/history", GetRecordHistory("blazer_dashboard_queries", "argID"))
 }
 
 func configGinBlazerDashboardQueries_Router(router gin.IRoutes) {
@@ -28,19 +31,36 @@ func configGinBlazerDashboardQueries_Router(router gin.IRoutes) {
 	router.POST("/blazerdashboardqueries_", ConverHttprouterToGin(AddBlazerDashboardQueries_))
 	router.GET("/blazerdashboardqueries_/:argID", ConverHttprouterToGin(GetBlazerDashboardQueries_))
 	router.PUT("/blazerdashboardqueries_/:argID", ConverHttprouterToGin(UpdateBlazerDashboardQueries_))
+	router.PATCH("/blazerdashboardqueries_/:argID", ConverHttprouterToGin(PatchBlazerDashboardQueries_))
+	router.POST("/blazerdashboardqueries_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBlazerDashboardQueries_, nil)))
 	router.DELETE("/blazerdashboardqueries_/:argID", ConverHttprouterToGin(DeleteBlazerDashboardQueries_))
+	router.GET("/blazerdashboardqueries_/:argID/history", ConverHttprouterToGin(GetRecordHistory("blazer_dashboard_queries", "argID")))
 }
 
 // GetAllBlazerDashboardQueries_ is a function to get a slice of record(s) from blazer_dashboard_queries table in the rocket_development database
 // @Summary Get list of BlazerDashboardQueries_
 // @Tags BlazerDashboardQueries_
-// @Description GetAllBlazerDashboardQueries_ is a handler to get a slice of record(s) from blazer_dashboard_queries table in the rocket_development database
+// @Description GetAllBlazerDashboardQueries_ is a handler to get a slice of record(s) from blazer_dashboard_queries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   dashboard_id query    int     false        "filter dashboard_id=value or dashboard_id[op]=value"
+// @Param   query_id query    int     false        "filter query_id=value or query_id[op]=value"
+// @Param   position query    int     false        "filter position=value or position[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BlazerDashboardQueries_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /blazerdashboardqueries_ [get]
@@ -59,20 +79,40 @@ func GetAllBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "blazer_dashboard_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "blazer_dashboard_queries", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBlazerDashboardQueries_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "blazer_dashboard_queries", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "blazer_dashboard_queries", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBlazerDashboardQueries_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +124,13 @@ func GetAllBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BlazerDashboardQueries_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /blazerdashboardqueries_/{argID} [get]
@@ -98,18 +144,41 @@ func GetBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httpr
 		return
 	}
 
+	fields, err := readFields(r, "blazer_dashboard_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "blazer_dashboard_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "blazer_dashboard_queries", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBlazerDashboardQueries_(ctx, argID)
+	record, err := dao.GetBlazerDashboardQueries_(ctx, argID, recordFields("blazer_dashboard_queries", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "blazer_dashboard_queries", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBlazerDashboardQueries_ add to add a single record to blazer_dashboard_queries table in the rocket_development database
@@ -122,8 +191,12 @@ func GetBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httpr
 // @Success 200 {object} model.BlazerDashboardQueries_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboardqueries_ [post]
-// echo '{"id": 83,"dashboard_id": 66,"query_id": 60,"position": 37,"created_at": "2102-07-07T03:40:07.52114824-04:00","updated_at": "2254-04-24T07:25:54.102440438-04:00"}' | http POST "http://localhost:8080/blazerdashboardqueries_" X-Api-User:user123
+// echo '{"id": 83,"dashboard_id": 66,"query_id": 60,"position": 37}' | http POST "http://localhost:8080/blazerdashboardqueries_" X-Api-User:user123
 func AddBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	blazerdashboardqueries_ := &model.BlazerDashboardQueries_{}
@@ -134,13 +207,14 @@ func AddBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httpr
 	}
 
 	if err := blazerdashboardqueries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerdashboardqueries_.Prepare()
 
 	if err := blazerdashboardqueries_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +235,24 @@ func AddBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httpr
 
 // UpdateBlazerDashboardQueries_ Update a single record from blazer_dashboard_queries table in the rocket_development database
 // @Summary Update an record in table blazer_dashboard_queries
-// @Description Update a single record from blazer_dashboard_queries table in the rocket_development database
+// @Description Update a single record from blazer_dashboard_queries table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BlazerDashboardQueries_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BlazerDashboardQueries_ body model.BlazerDashboardQueries_ true "Update BlazerDashboardQueries_ record"
 // @Success 200 {object} model.BlazerDashboardQueries_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboardqueries_/{argID} [put]
-// echo '{"id": 83,"dashboard_id": 66,"query_id": 60,"position": 37,"created_at": "2102-07-07T03:40:07.52114824-04:00","updated_at": "2254-04-24T07:25:54.102440438-04:00"}' | http PUT "http://localhost:8080/blazerdashboardqueries_/1"  X-Api-User:user123
+// echo '{"id": 83,"dashboard_id": 66,"query_id": 60,"position": 37}' | http PUT "http://localhost:8080/blazerdashboardqueries_/1"  X-Api-User:user123
 func UpdateBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +262,12 @@ func UpdateBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	blazerdashboardqueries_ := &model.BlazerDashboardQueries_{}
 	if err := readJSON(r, blazerdashboardqueries_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +275,14 @@ func UpdateBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 	}
 
 	if err := blazerdashboardqueries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerdashboardqueries_.Prepare()
 
 	if err := blazerdashboardqueries_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +302,77 @@ func UpdateBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 	writeJSON(ctx, w, blazerdashboardqueries_)
 }
 
+// PatchBlazerDashboardQueries_ Patch a single record from blazer_dashboard_queries table in the rocket_development database
+// @Summary Patch an record in table blazer_dashboard_queries
+// @Description Patch a single record from blazer_dashboard_queries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BlazerDashboardQueries_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BlazerDashboardQueries_ body model.BlazerDashboardQueries_ true "fields of BlazerDashboardQueries_ to change, null clears a field"
+// @Success 200 {object} model.BlazerDashboardQueries_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /blazerdashboardqueries_/{argID} [patch]
+// echo '{"dashboard_id": null}' | http PATCH "http://localhost:8080/blazerdashboardqueries_/1"  X-Api-User:user123
+func PatchBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboard_queries", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	blazerdashboardqueries_, _, err := dao.PatchBlazerDashboardQueries_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, blazerdashboardqueries_)
+}
+
+// BulkBlazerDashboardQueries_ runs create, update and delete operations on records of the blazer_dashboard_queries table in the rocket_development database
+// @Summary Bulk create, update and delete records of table blazer_dashboard_queries
+// @Description BulkBlazerDashboardQueries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BlazerDashboardQueries_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BlazerDashboardQueries_ records, the record of create and update is a BlazerDashboardQueries_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /blazerdashboardqueries_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 83,"dashboard_id": 66,"query_id": 60,"position": 37}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/blazerdashboardqueries_/bulk" X-Api-User:user123
+func BulkBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "blazer_dashboard_queries")
+}
+
 // DeleteBlazerDashboardQueries_ Delete a single record from blazer_dashboard_queries table in the rocket_development database
 // @Summary Delete a record from blazer_dashboard_queries
 // @Description Delete a single record from blazer_dashboard_queries table in the rocket_development database
@@ -221,9 +380,18 @@ func UpdateBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BlazerDashboardQueries_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboardqueries_/{argID} [delete]
 // http DELETE "http://localhost:8080/blazerdashboardqueries_/1" X-Api-User:user123
 func DeleteBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +403,38 @@ func DeleteBlazerDashboardQueries_(w http.ResponseWriter, r *http.Request, ps ht
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "blazer_dashboard_queries", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBlazerDashboardQueries_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboard_queries", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "blazer_dashboard_queries", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBlazerDashboardQueries_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
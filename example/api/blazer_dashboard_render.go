@@ -0,0 +1,214 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// BlazerDashboardQueriesRequest ordered list of blazer query ids placed on a dashboard
+type BlazerDashboardQueriesRequest struct {
+	QueryIDs []int64 `json:"query_ids"`
+}
+
+// BlazerDashboardQueryRequest blazer query to place on a dashboard, position is optional and defaults to the end
+type BlazerDashboardQueryRequest struct {
+	QueryID  int64  `json:"query_id"`
+	Position *int64 `json:"position"`
+}
+
+// RenderBlazerDashboard is a function to get a dashboard with its queries in position order
+// @Summary Render a BlazerDashboards_ with its queries
+// @Tags BlazerDashboards_
+// @Description RenderBlazerDashboard returns the dashboard and its queries sorted by position, optionally running every query concurrently
+// @Accept  json
+// @Produce  json
+// @Param  argID   path  int64 true  "id"
+// @Param  run     query bool  false "run the queries in the sql sandbox and include their result sets (defaults to false)"
+// @Param  timeout query int   false "seconds allowed for running all the queries (defaults to 30, capped at the sandbox max_time)"
+// @Success 200 {object} dao.BlazerDashboardRender
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /blazerdashboards_/{argID}/render [get]
+// http "http://localhost:8080/blazerdashboards_/1/render?run=true&timeout=10" X-Api-User:user123
+func RenderBlazerDashboard(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	run, err := readBool(r, "run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	timeout, err := readInt(r, "timeout", 30)
+	if err != nil || timeout <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	// the statements run are read from blazer_queries
+	if run {
+		if err := ValidateRequest(ctx, r, "blazer_queries", model.RetrieveMany); err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+	}
+
+	render, err := dao.GetBlazerDashboardRender(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if run {
+		dao.RunBlazerDashboardQueries(ctx, render, time.Duration(timeout)*time.Second)
+	}
+
+	writeJSON(ctx, w, render)
+}
+
+// ReplaceBlazerDashboardQueries is a function to atomically replace the queries placed on a dashboard
+// @Summary Replace the queries of a BlazerDashboards_
+// @Tags BlazerDashboards_
+// @Description ReplaceBlazerDashboardQueries reorders, adds and removes dashboard queries in one transaction, query_ids are stored in the given order
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  BlazerDashboardQueriesRequest body api.BlazerDashboardQueriesRequest true "ordered query ids"
+// @Success 200 {object} dao.BlazerDashboardRender
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /blazerdashboards_/{argID}/queries [put]
+// echo '{"query_ids": [3, 1, 2]}' | http PUT "http://localhost:8080/blazerdashboards_/1/queries" X-Api-User:user123
+func ReplaceBlazerDashboardQueries(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	request := &BlazerDashboardQueriesRequest{}
+	if err := readJSON(r, request); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	render, err := dao.ReplaceBlazerDashboardQueries(ctx, argID, request.QueryIDs)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, render)
+}
+
+// AddBlazerDashboardQuery is a function to place a query on a dashboard
+// @Summary Add a query to a BlazerDashboards_
+// @Tags BlazerDashboards_
+// @Description AddBlazerDashboardQuery inserts a query at position, shifting the following queries down
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  BlazerDashboardQueryRequest body api.BlazerDashboardQueryRequest true "query to add"
+// @Success 200 {object} dao.BlazerDashboardRender
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /blazerdashboards_/{argID}/queries [post]
+// echo '{"query_id": 4, "position": 0}' | http POST "http://localhost:8080/blazerdashboards_/1/queries" X-Api-User:user123
+func AddBlazerDashboardQuery(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	request := &BlazerDashboardQueryRequest{}
+	if err := readJSON(r, request); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	position := int64(-1)
+	if request.Position != nil {
+		position = *request.Position
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	render, err := dao.AddBlazerDashboardQuery(ctx, argID, request.QueryID, position)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, render)
+}
+
+// RemoveBlazerDashboardQuery is a function to remove a query from a dashboard
+// @Summary Remove a query from a BlazerDashboards_
+// @Tags BlazerDashboards_
+// @Description RemoveBlazerDashboardQuery removes a query from the dashboard and renumbers the remaining positions
+// @Accept  json
+// @Produce  json
+// @Param  argID   path int64 true "id"
+// @Param  queryID path int64 true "blazer query id"
+// @Success 200 {object} dao.BlazerDashboardRender
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /blazerdashboards_/{argID}/queries/{queryID} [delete]
+// http DELETE "http://localhost:8080/blazerdashboards_/1/queries/4" X-Api-User:user123
+func RemoveBlazerDashboardQuery(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	queryID, err := parseInt64(ps, "queryID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	render, err := dao.RemoveBlazerDashboardQuery(ctx, argID, queryID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, render)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestRenderBlazerDashboardValidation(t *testing.T) {
+	saved := RequestValidator
+	t.Cleanup(func() { RequestValidator = saved })
+
+	var validated []string
+	RequestValidator = func(ctx context.Context, r *http.Request, table string, action model.Action) error {
+		validated = append(validated, fmt.Sprint(table, " ", action))
+		if table == "blazer_queries" {
+			return fmt.Errorf("%w: blazer queries are not readable", dao.ErrBadParams)
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		status    int
+		validated []string
+	}{
+		{"run checks the queries", "?run=true", http.StatusBadRequest, []string{"blazer_dashboards " + model.RetrieveOne.String(), "blazer_queries " + model.RetrieveMany.String()}},
+		{"zero timeout", "?run=true&timeout=0", http.StatusBadRequest, nil},
+		{"invalid run", "?run=maybe", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validated = nil
+			r := httptest.NewRequest(http.MethodGet, "/blazerdashboards_/1/render"+tt.query, nil)
+			w := httptest.NewRecorder()
+			RenderBlazerDashboard(w, r, httprouter.Params{{Key: "argID", Value: "1"}})
+
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+			if fmt.Sprint(validated) != fmt.Sprint(tt.validated) {
+				t.Errorf("validated %v, want %v", validated, tt.validated)
+			}
+		})
+	}
+}
@@ -20,7 +20,14 @@ func configBlazerDashboards_Router(router *httprouter.Router) {
 	router.POST("/blazerdashboards_", AddBlazerDashboards_)
 	router.GET("/blazerdashboards_/:argID", GetBlazerDashboards_)
 	router.PUT("/blazerdashboards_/:argID", UpdateBlazerDashboards_)
+	router.PATCH("/blazerdashboards_/:argID", PatchBlazerDashboards_)
+	router.POST("/blazerdashboards_/:argID", staticSegment("bulk", BulkBlazerDashboards_, nil))
 	router.DELETE("/blazerdashboards_/:argID", DeleteBlazerDashboards_)
+	router.GET("/blazerdashboards_/:argID/history", GetRecordHistory("blazer_dashboards", "argID"))
+	router.GET("/blazerdashboards_/:argID/render", RenderBlazerDashboard)
+	router.PUT("/blazerdashboards_/:argID/queries", ReplaceBlazerDashboardQueries)
+	router.POST("/blazerdashboards_/:argID/queries", AddBlazerDashboardQuery)
+	router.DELETE("/blazerdashboards_/:argID/queries/:queryID", RemoveBlazerDashboardQuery)
 }
 
 func configGinBlazerDashboards_Router(router gin.IRoutes) {
@@ -28,19 +35,40 @@ func configGinBlazerDashboards_Router(router gin.IRoutes) {
 	router.POST("/blazerdashboards_", ConverHttprouterToGin(AddBlazerDashboards_))
 	router.GET("/blazerdashboards_/:argID", ConverHttprouterToGin(GetBlazerDashboards_))
 	router.PUT("/blazerdashboards_/:argID", ConverHttprouterToGin(UpdateBlazerDashboards_))
+	router.PATCH("/blazerdashboards_/:argID", ConverHttprouterToGin(PatchBlazerDashboards_))
+	router.POST("/blazerdashboards_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBlazerDashboards_, nil)))
 	router.DELETE("/blazerdashboards_/:argID", ConverHttprouterToGin(DeleteBlazerDashboards_))
+	router.GET("/blazerdashboards_/:argID/history", ConverHttprouterToGin(GetRecordHistory("blazer_dashboards", "argID")))
+	router.GET("/blazerdashboards_/:argID/render", ConverHttprouterToGin(RenderBlazerDashboard))
+	router.PUT("/blazerdashboards_/:argID/queries", ConverHttprouterToGin(ReplaceBlazerDashboardQueries))
+	router.POST("/blazerdashboards_/:argID/queries", ConverHttprouterToGin(AddBlazerDashboardQuery))
+	router.DELETE("/blazerdashboards_/:argID/queries/:queryID", ConverHttprouterToGin(RemoveBlazerDashboardQuery))
 }
 
 // GetAllBlazerDashboards_ is a function to get a slice of record(s) from blazer_dashboards table in the rocket_development database
 // @Summary Get list of BlazerDashboards_
 // @Tags BlazerDashboards_
-// @Description GetAllBlazerDashboards_ is a handler to get a slice of record(s) from blazer_dashboards table in the rocket_development database
+// @Description GetAllBlazerDashboards_ is a handler to get a slice of record(s) from blazer_dashboards table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   creator_id query    int     false        "filter creator_id=value or creator_id[op]=value"
+// @Param   name     query    string  false        "filter name=value or name[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BlazerDashboards_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /blazerdashboards_ [get]
@@ -59,20 +87,40 @@ func GetAllBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "blazer_dashboards")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBlazerDashboards_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "blazer_dashboards", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "blazer_dashboards", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBlazerDashboards_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +132,13 @@ func GetAllBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BlazerDashboards_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /blazerdashboards_/{argID} [get]
@@ -98,18 +152,41 @@ func GetBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
+	fields, err := readFields(r, "blazer_dashboards")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "blazer_dashboards")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBlazerDashboards_(ctx, argID)
+	record, err := dao.GetBlazerDashboards_(ctx, argID, recordFields("blazer_dashboards", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "blazer_dashboards", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBlazerDashboards_ add to add a single record to blazer_dashboards table in the rocket_development database
@@ -122,8 +199,12 @@ func GetBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Success 200 {object} model.BlazerDashboards_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboards_ [post]
-// echo '{"id": 76,"creator_id": 2,"name": "OJhLIJTHBAFwIWZcxwrnLosUn","created_at": "2033-08-03T17:59:33.147289267-04:00","updated_at": "2163-08-18T06:31:58.704720108-04:00"}' | http POST "http://localhost:8080/blazerdashboards_" X-Api-User:user123
+// echo '{"id": 76,"creator_id": 2,"name": "OJhLIJTHBAFwIWZcxwrnLosUn"}' | http POST "http://localhost:8080/blazerdashboards_" X-Api-User:user123
 func AddBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	blazerdashboards_ := &model.BlazerDashboards_{}
@@ -134,13 +215,14 @@ func AddBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	}
 
 	if err := blazerdashboards_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerdashboards_.Prepare()
 
 	if err := blazerdashboards_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +243,24 @@ func AddBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.
 
 // UpdateBlazerDashboards_ Update a single record from blazer_dashboards table in the rocket_development database
 // @Summary Update an record in table blazer_dashboards
-// @Description Update a single record from blazer_dashboards table in the rocket_development database
+// @Description Update a single record from blazer_dashboards table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BlazerDashboards_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BlazerDashboards_ body model.BlazerDashboards_ true "Update BlazerDashboards_ record"
 // @Success 200 {object} model.BlazerDashboards_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboards_/{argID} [put]
-// echo '{"id": 76,"creator_id": 2,"name": "OJhLIJTHBAFwIWZcxwrnLosUn","created_at": "2033-08-03T17:59:33.147289267-04:00","updated_at": "2163-08-18T06:31:58.704720108-04:00"}' | http PUT "http://localhost:8080/blazerdashboards_/1"  X-Api-User:user123
+// echo '{"id": 76,"creator_id": 2,"name": "OJhLIJTHBAFwIWZcxwrnLosUn"}' | http PUT "http://localhost:8080/blazerdashboards_/1"  X-Api-User:user123
 func UpdateBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +270,12 @@ func UpdateBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	blazerdashboards_ := &model.BlazerDashboards_{}
 	if err := readJSON(r, blazerdashboards_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +283,14 @@ func UpdateBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 	}
 
 	if err := blazerdashboards_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerdashboards_.Prepare()
 
 	if err := blazerdashboards_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +310,77 @@ func UpdateBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 	writeJSON(ctx, w, blazerdashboards_)
 }
 
+// PatchBlazerDashboards_ Patch a single record from blazer_dashboards table in the rocket_development database
+// @Summary Patch an record in table blazer_dashboards
+// @Description Patch a single record from blazer_dashboards table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BlazerDashboards_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BlazerDashboards_ body model.BlazerDashboards_ true "fields of BlazerDashboards_ to change, null clears a field"
+// @Success 200 {object} model.BlazerDashboards_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /blazerdashboards_/{argID} [patch]
+// echo '{"creator_id": null}' | http PATCH "http://localhost:8080/blazerdashboards_/1"  X-Api-User:user123
+func PatchBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	blazerdashboards_, _, err := dao.PatchBlazerDashboards_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, blazerdashboards_)
+}
+
+// BulkBlazerDashboards_ runs create, update and delete operations on records of the blazer_dashboards table in the rocket_development database
+// @Summary Bulk create, update and delete records of table blazer_dashboards
+// @Description BulkBlazerDashboards_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BlazerDashboards_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BlazerDashboards_ records, the record of create and update is a BlazerDashboards_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /blazerdashboards_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 76,"creator_id": 2,"name": "OJhLIJTHBAFwIWZcxwrnLosUn"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/blazerdashboards_/bulk" X-Api-User:user123
+func BulkBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "blazer_dashboards")
+}
+
 // DeleteBlazerDashboards_ Delete a single record from blazer_dashboards table in the rocket_development database
 // @Summary Delete a record from blazer_dashboards
 // @Description Delete a single record from blazer_dashboards table in the rocket_development database
@@ -221,9 +388,18 @@ func UpdateBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BlazerDashboards_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerdashboards_/{argID} [delete]
 // http DELETE "http://localhost:8080/blazerdashboards_/1" X-Api-User:user123
 func DeleteBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +411,38 @@ func DeleteBlazerDashboards_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBlazerDashboards_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_dashboards", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "blazer_dashboards", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBlazerDashboards_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
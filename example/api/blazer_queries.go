@@ -20,7 +20,10 @@ func configBlazerQueries_Router(router *httprouter.Router) {
 	router.POST("/blazerqueries_", AddBlazerQueries_)
 	router.GET("/blazerqueries_/:argID", GetBlazerQueries_)
 	router.PUT("/blazerqueries_/:argID", UpdateBlazerQueries_)
+	router.PATCH("/blazerqueries_/:argID", PatchBlazerQueries_)
+	router.POST("/blazerqueries_/:argID", staticSegment("bulk", BulkBlazerQueries_, nil))
 	router.DELETE("/blazerqueries_/:argID", DeleteBlazerQueries_)
+	router.GET("/blazerqueries_/:argID/history", GetRecordHistory("blazer_queries", "argID"))
 }
 
 func configGinBlazerQueries_Router(router gin.IRoutes) {
@@ -28,19 +31,40 @@ func configGinBlazerQueries_Router(router gin.IRoutes) {
 	router.POST("/blazerqueries_", ConverHttprouterToGin(AddBlazerQueries_))
 	router.GET("/blazerqueries_/:argID", ConverHttprouterToGin(GetBlazerQueries_))
 	router.PUT("/blazerqueries_/:argID", ConverHttprouterToGin(UpdateBlazerQueries_))
+	router.PATCH("/blazerqueries_/:argID", ConverHttprouterToGin(PatchBlazerQueries_))
+	router.POST("/blazerqueries_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBlazerQueries_, nil)))
 	router.DELETE("/blazerqueries_/:argID", ConverHttprouterToGin(DeleteBlazerQueries_))
+	router.GET("/blazerqueries_/:argID/history", ConverHttprouterToGin(GetRecordHistory("blazer_queries", "argID")))
 }
 
 // GetAllBlazerQueries_ is a function to get a slice of record(s) from blazer_queries table in the rocket_development database
 // @Summary Get list of BlazerQueries_
 // @Tags BlazerQueries_
-// @Description GetAllBlazerQueries_ is a handler to get a slice of record(s) from blazer_queries table in the rocket_development database
+// @Description GetAllBlazerQueries_ is a handler to get a slice of record(s) from blazer_queries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   creator_id query    int     false        "filter creator_id=value or creator_id[op]=value"
+// @Param   name     query    string  false        "filter name=value or name[op]=value"
+// @Param   description query    string  false        "filter description=value or description[op]=value"
+// @Param   statement query    string  false        "filter statement=value or statement[op]=value"
+// @Param   data_source query    string  false        "filter data_source=value or data_source[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BlazerQueries_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /blazerqueries_ [get]
@@ -59,20 +83,40 @@ func GetAllBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "blazer_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "blazer_queries", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBlazerQueries_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "blazer_queries", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "blazer_queries", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBlazerQueries_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +128,13 @@ func GetAllBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BlazerQueries_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /blazerqueries_/{argID} [get]
@@ -98,18 +148,41 @@ func GetBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 		return
 	}
 
+	fields, err := readFields(r, "blazer_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "blazer_queries")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "blazer_queries", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBlazerQueries_(ctx, argID)
+	record, err := dao.GetBlazerQueries_(ctx, argID, recordFields("blazer_queries", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "blazer_queries", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBlazerQueries_ add to add a single record to blazer_queries table in the rocket_development database
@@ -122,8 +195,12 @@ func GetBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 // @Success 200 {object} model.BlazerQueries_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerqueries_ [post]
-// echo '{"id": 8,"creator_id": 36,"name": "AxfmrEbJNxpWmooBLsmqUsglF","description": "FbJLVgJnJaSEXNArXUSGcTreu","statement": "IpIAuHuYxXToqxfHjKPidNmLy","data_source": "qIALNagNbboBuqcBTayiKpvUG","status": "OSgBPAGKijBcCDSXTbjsJFEdS","created_at": "2306-05-15T00:20:03.44145846-04:00","updated_at": "2302-08-14T07:53:36.278382772-04:00"}' | http POST "http://localhost:8080/blazerqueries_" X-Api-User:user123
+// echo '{"id": 8,"creator_id": 36,"name": "AxfmrEbJNxpWmooBLsmqUsglF","description": "FbJLVgJnJaSEXNArXUSGcTreu","statement": "IpIAuHuYxXToqxfHjKPidNmLy","data_source": "qIALNagNbboBuqcBTayiKpvUG","status": "OSgBPAGKijBcCDSXTbjsJFEdS"}' | http POST "http://localhost:8080/blazerqueries_" X-Api-User:user123
 func AddBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	blazerqueries_ := &model.BlazerQueries_{}
@@ -134,13 +211,14 @@ func AddBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 	}
 
 	if err := blazerqueries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerqueries_.Prepare()
 
 	if err := blazerqueries_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +239,24 @@ func AddBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 
 // UpdateBlazerQueries_ Update a single record from blazer_queries table in the rocket_development database
 // @Summary Update an record in table blazer_queries
-// @Description Update a single record from blazer_queries table in the rocket_development database
+// @Description Update a single record from blazer_queries table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BlazerQueries_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BlazerQueries_ body model.BlazerQueries_ true "Update BlazerQueries_ record"
 // @Success 200 {object} model.BlazerQueries_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerqueries_/{argID} [put]
-// echo '{"id": 8,"creator_id": 36,"name": "AxfmrEbJNxpWmooBLsmqUsglF","description": "FbJLVgJnJaSEXNArXUSGcTreu","statement": "IpIAuHuYxXToqxfHjKPidNmLy","data_source": "qIALNagNbboBuqcBTayiKpvUG","status": "OSgBPAGKijBcCDSXTbjsJFEdS","created_at": "2306-05-15T00:20:03.44145846-04:00","updated_at": "2302-08-14T07:53:36.278382772-04:00"}' | http PUT "http://localhost:8080/blazerqueries_/1"  X-Api-User:user123
+// echo '{"id": 8,"creator_id": 36,"name": "AxfmrEbJNxpWmooBLsmqUsglF","description": "FbJLVgJnJaSEXNArXUSGcTreu","statement": "IpIAuHuYxXToqxfHjKPidNmLy","data_source": "qIALNagNbboBuqcBTayiKpvUG","status": "OSgBPAGKijBcCDSXTbjsJFEdS"}' | http PUT "http://localhost:8080/blazerqueries_/1"  X-Api-User:user123
 func UpdateBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +266,12 @@ func UpdateBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	blazerqueries_ := &model.BlazerQueries_{}
 	if err := readJSON(r, blazerqueries_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +279,14 @@ func UpdateBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	}
 
 	if err := blazerqueries_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	blazerqueries_.Prepare()
 
 	if err := blazerqueries_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +306,77 @@ func UpdateBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	writeJSON(ctx, w, blazerqueries_)
 }
 
+// PatchBlazerQueries_ Patch a single record from blazer_queries table in the rocket_development database
+// @Summary Patch an record in table blazer_queries
+// @Description Patch a single record from blazer_queries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BlazerQueries_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BlazerQueries_ body model.BlazerQueries_ true "fields of BlazerQueries_ to change, null clears a field"
+// @Success 200 {object} model.BlazerQueries_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /blazerqueries_/{argID} [patch]
+// echo '{"creator_id": null}' | http PATCH "http://localhost:8080/blazerqueries_/1"  X-Api-User:user123
+func PatchBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_queries", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	blazerqueries_, _, err := dao.PatchBlazerQueries_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, blazerqueries_)
+}
+
+// BulkBlazerQueries_ runs create, update and delete operations on records of the blazer_queries table in the rocket_development database
+// @Summary Bulk create, update and delete records of table blazer_queries
+// @Description BulkBlazerQueries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BlazerQueries_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BlazerQueries_ records, the record of create and update is a BlazerQueries_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /blazerqueries_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 8,"creator_id": 36,"name": "AxfmrEbJNxpWmooBLsmqUsglF","description": "FbJLVgJnJaSEXNArXUSGcTreu","statement": "IpIAuHuYxXToqxfHjKPidNmLy","data_source": "qIALNagNbboBuqcBTayiKpvUG","status": "OSgBPAGKijBcCDSXTbjsJFEdS"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/blazerqueries_/bulk" X-Api-User:user123
+func BulkBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "blazer_queries")
+}
+
 // DeleteBlazerQueries_ Delete a single record from blazer_queries table in the rocket_development database
 // @Summary Delete a record from blazer_queries
 // @Description Delete a single record from blazer_queries table in the rocket_development database
@@ -221,9 +384,18 @@ func UpdateBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BlazerQueries_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /blazerqueries_/{argID} [delete]
 // http DELETE "http://localhost:8080/blazerqueries_/1" X-Api-User:user123
 func DeleteBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +407,38 @@ func DeleteBlazerQueries_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "blazer_queries", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBlazerQueries_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "blazer_queries", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "blazer_queries", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBlazerQueries_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"net/http"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetBuildingDetails is a function to get the details of a building as a key/value object
+// @Summary Get the details of a Buildings_ as key/value pairs
+// @Tags Buildings_
+// @Description GetBuildingDetails returns the building_details rows of a building as a single json object of key to value
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Success 200 {object} dao.BuildingDetails
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /buildings_/{argID}/details [get]
+// http "http://localhost:8080/buildings_/1/details" X-Api-User:user123
+func GetBuildingDetails(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "building_details", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	details, err := dao.GetBuildingDetails(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, details)
+}
+
+// ReplaceBuildingDetails is a function to replace the details of a building
+// @Summary Replace the details of a Buildings_
+// @Tags Buildings_
+// @Description ReplaceBuildingDetails replaces every detail of the building with the key/value pairs of the body in one transaction
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  BuildingDetails body dao.BuildingDetails true "key/value pairs"
+// @Success 200 {object} dao.BuildingDetails
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /buildings_/{argID}/details [put]
+// echo '{"floors": 12, "heating": "gas"}' | http PUT "http://localhost:8080/buildings_/1/details" X-Api-User:user123
+func ReplaceBuildingDetails(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	details := dao.BuildingDetails{}
+	if err := readJSON(r, &details); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "building_details", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	details, err = dao.ReplaceBuildingDetails(ctx, argID, details)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, details)
+}
+
+// MergeBuildingDetails is a function to merge keys into the details of a building
+// @Summary Merge keys into the details of a Buildings_
+// @Tags Buildings_
+// @Description MergeBuildingDetails sets the keys of the body on the building in one transaction, a null value removes the key
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  BuildingDetails body dao.BuildingDetails true "key/value pairs to merge"
+// @Success 200 {object} dao.BuildingDetails
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /buildings_/{argID}/details [patch]
+// echo '{"heating": null, "cooling": "central"}' | http PATCH "http://localhost:8080/buildings_/1/details" X-Api-User:user123
+func MergeBuildingDetails(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	details := dao.BuildingDetails{}
+	if err := readJSON(r, &details); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "building_details", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	details, err = dao.MergeBuildingDetails(ctx, argID, details)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, details)
+}
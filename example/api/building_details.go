@@ -20,7 +20,10 @@ func configBuildingDetails_Router(router *httprouter.Router) {
 	router.POST("/buildingdetails_", AddBuildingDetails_)
 	router.GET("/buildingdetails_/:argID", GetBuildingDetails_)
 	router.PUT("/buildingdetails_/:argID", UpdateBuildingDetails_)
+	router.PATCH("/buildingdetails_/:argID", PatchBuildingDetails_)
+	router.POST("/buildingdetails_/:argID", staticSegment("bulk", BulkBuildingDetails_, nil))
 	router.DELETE("/buildingdetails_/:argID", DeleteBuildingDetails_)
+	router.GET("/buildingdetails_/:argID/history", GetRecordHistory("building_details", "argID"))
 }
 
 func configGinBuildingDetails_Router(router gin.IRoutes) {
@@ -28,19 +31,38 @@ func configGinBuildingDetails_Router(router gin.IRoutes) {
 	router.POST("/buildingdetails_", ConverHttprouterToGin(AddBuildingDetails_))
 	router.GET("/buildingdetails_/:argID", ConverHttprouterToGin(GetBuildingDetails_))
 	router.PUT("/buildingdetails_/:argID", ConverHttprouterToGin(UpdateBuildingDetails_))
+	router.PATCH("/buildingdetails_/:argID", ConverHttprouterToGin(PatchBuildingDetails_))
+	router.POST("/buildingdetails_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBuildingDetails_, nil)))
 	router.DELETE("/buildingdetails_/:argID", ConverHttprouterToGin(DeleteBuildingDetails_))
+	router.GET("/buildingdetails_/:argID/history", ConverHttprouterToGin(GetRecordHistory("building_details", "argID")))
 }
 
 // GetAllBuildingDetails_ is a function to get a slice of record(s) from building_details table in the rocket_development database
 // @Summary Get list of BuildingDetails_
 // @Tags BuildingDetails_
-// @Description GetAllBuildingDetails_ is a handler to get a slice of record(s) from building_details table in the rocket_development database
+// @Description GetAllBuildingDetails_ is a handler to get a slice of record(s) from building_details table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: building"
+// @Param   building_id query    int     false        "filter building_id=value or building_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   information_key query    string  false        "filter information_key=value or information_key[op]=value"
+// @Param   value    query    string  false        "filter value=value or value[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.BuildingDetails_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /buildingdetails_ [get]
@@ -59,20 +81,40 @@ func GetAllBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "building_details")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "building_details", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBuildingDetails_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "building_details", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "building_details", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBuildingDetails_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +126,14 @@ func GetAllBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: building"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.BuildingDetails_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /buildingdetails_/{argID} [get]
@@ -98,18 +147,41 @@ func GetBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
+	fields, err := readFields(r, "building_details")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "building_details")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "building_details", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBuildingDetails_(ctx, argID)
+	record, err := dao.GetBuildingDetails_(ctx, argID, recordFields("building_details", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "building_details", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBuildingDetails_ add to add a single record to building_details table in the rocket_development database
@@ -122,8 +194,12 @@ func GetBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 // @Success 200 {object} model.BuildingDetails_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildingdetails_ [post]
-// echo '{"building_id": 32,"id": 43,"information_key": "mcqIsWqmIeHXTBFVPvWZtCPXK","value": "nWUeKMQoHkUAJsjeBuRnUXLTG","created_at": "2244-09-29T07:08:16.702936483-04:00","updated_at": "2038-01-19T05:44:49.149006966-05:00"}' | http POST "http://localhost:8080/buildingdetails_" X-Api-User:user123
+// echo '{"building_id": 32,"id": 43,"information_key": "mcqIsWqmIeHXTBFVPvWZtCPXK","value": "nWUeKMQoHkUAJsjeBuRnUXLTG"}' | http POST "http://localhost:8080/buildingdetails_" X-Api-User:user123
 func AddBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	buildingdetails_ := &model.BuildingDetails_{}
@@ -134,13 +210,14 @@ func AddBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	}
 
 	if err := buildingdetails_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	buildingdetails_.Prepare()
 
 	if err := buildingdetails_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +238,24 @@ func AddBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.P
 
 // UpdateBuildingDetails_ Update a single record from building_details table in the rocket_development database
 // @Summary Update an record in table building_details
-// @Description Update a single record from building_details table in the rocket_development database
+// @Description Update a single record from building_details table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags BuildingDetails_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  BuildingDetails_ body model.BuildingDetails_ true "Update BuildingDetails_ record"
 // @Success 200 {object} model.BuildingDetails_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildingdetails_/{argID} [put]
-// echo '{"building_id": 32,"id": 43,"information_key": "mcqIsWqmIeHXTBFVPvWZtCPXK","value": "nWUeKMQoHkUAJsjeBuRnUXLTG","created_at": "2244-09-29T07:08:16.702936483-04:00","updated_at": "2038-01-19T05:44:49.149006966-05:00"}' | http PUT "http://localhost:8080/buildingdetails_/1"  X-Api-User:user123
+// echo '{"building_id": 32,"id": 43,"information_key": "mcqIsWqmIeHXTBFVPvWZtCPXK","value": "nWUeKMQoHkUAJsjeBuRnUXLTG"}' | http PUT "http://localhost:8080/buildingdetails_/1"  X-Api-User:user123
 func UpdateBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +265,12 @@ func UpdateBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	buildingdetails_ := &model.BuildingDetails_{}
 	if err := readJSON(r, buildingdetails_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +278,14 @@ func UpdateBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 	}
 
 	if err := buildingdetails_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	buildingdetails_.Prepare()
 
 	if err := buildingdetails_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +305,77 @@ func UpdateBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 	writeJSON(ctx, w, buildingdetails_)
 }
 
+// PatchBuildingDetails_ Patch a single record from building_details table in the rocket_development database
+// @Summary Patch an record in table building_details
+// @Description Patch a single record from building_details table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags BuildingDetails_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  BuildingDetails_ body model.BuildingDetails_ true "fields of BuildingDetails_ to change, null clears a field"
+// @Success 200 {object} model.BuildingDetails_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /buildingdetails_/{argID} [patch]
+// echo '{"building_id": null}' | http PATCH "http://localhost:8080/buildingdetails_/1"  X-Api-User:user123
+func PatchBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "building_details", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	buildingdetails_, _, err := dao.PatchBuildingDetails_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, buildingdetails_)
+}
+
+// BulkBuildingDetails_ runs create, update and delete operations on records of the building_details table in the rocket_development database
+// @Summary Bulk create, update and delete records of table building_details
+// @Description BulkBuildingDetails_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags BuildingDetails_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on BuildingDetails_ records, the record of create and update is a BuildingDetails_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /buildingdetails_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"building_id": 32,"id": 43,"information_key": "mcqIsWqmIeHXTBFVPvWZtCPXK","value": "nWUeKMQoHkUAJsjeBuRnUXLTG"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/buildingdetails_/bulk" X-Api-User:user123
+func BulkBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "building_details")
+}
+
 // DeleteBuildingDetails_ Delete a single record from building_details table in the rocket_development database
 // @Summary Delete a record from building_details
 // @Description Delete a single record from building_details table in the rocket_development database
@@ -221,9 +383,18 @@ func UpdateBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.BuildingDetails_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildingdetails_/{argID} [delete]
 // http DELETE "http://localhost:8080/buildingdetails_/1" X-Api-User:user123
 func DeleteBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +406,38 @@ func DeleteBuildingDetails_(w http.ResponseWriter, r *http.Request, ps httproute
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "building_details", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBuildingDetails_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "building_details", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "building_details", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBuildingDetails_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -18,29 +18,66 @@ var (
 func configBuildings_Router(router *httprouter.Router) {
 	router.GET("/buildings_", GetAllBuildings_)
 	router.POST("/buildings_", AddBuildings_)
-	router.GET("/buildings_/:argID", GetBuildings_)
+	router.GET("/buildings_/:argID", staticSegment("trash", TrashBuildings_, GetBuildings_))
 	router.PUT("/buildings_/:argID", UpdateBuildings_)
+	router.PATCH("/buildings_/:argID", PatchBuildings_)
+	router.POST("/buildings_/:argID", staticSegment("bulk", BulkBuildings_, nil))
 	router.DELETE("/buildings_/:argID", DeleteBuildings_)
+	router.GET("/buildings_/:argID/history", GetRecordHistory("buildings", "argID"))
+	router.POST("/buildings_/:argID/restore", RestoreBuildings_)
+	router.GET("/buildings_/:argID/details", GetBuildingDetails)
+	router.PUT("/buildings_/:argID/details", ReplaceBuildingDetails)
+	router.PATCH("/buildings_/:argID/details", MergeBuildingDetails)
 }
 
 func configGinBuildings_Router(router gin.IRoutes) {
 	router.GET("/buildings_", ConverHttprouterToGin(GetAllBuildings_))
 	router.POST("/buildings_", ConverHttprouterToGin(AddBuildings_))
-	router.GET("/buildings_/:argID", ConverHttprouterToGin(GetBuildings_))
+	router.GET("/buildings_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashBuildings_, GetBuildings_)))
 	router.PUT("/buildings_/:argID", ConverHttprouterToGin(UpdateBuildings_))
+	router.PATCH("/buildings_/:argID", ConverHttprouterToGin(PatchBuildings_))
+	router.POST("/buildings_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkBuildings_, nil)))
 	router.DELETE("/buildings_/:argID", ConverHttprouterToGin(DeleteBuildings_))
+	router.GET("/buildings_/:argID/history", ConverHttprouterToGin(GetRecordHistory("buildings", "argID")))
+	router.POST("/buildings_/:argID/restore", ConverHttprouterToGin(RestoreBuildings_))
+	router.GET("/buildings_/:argID/details", ConverHttprouterToGin(GetBuildingDetails))
+	router.PUT("/buildings_/:argID/details", ConverHttprouterToGin(ReplaceBuildingDetails))
+	router.PATCH("/buildings_/:argID/details", ConverHttprouterToGin(MergeBuildingDetails))
 }
 
 // GetAllBuildings_ is a function to get a slice of record(s) from buildings table in the rocket_development database
 // @Summary Get list of Buildings_
 // @Tags Buildings_
-// @Description GetAllBuildings_ is a handler to get a slice of record(s) from buildings table in the rocket_development database
+// @Description GetAllBuildings_ is a handler to get a slice of record(s) from buildings table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: address, batteries, building_details, customer, interventions"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   customer_id query    int     false        "filter customer_id=value or customer_id[op]=value"
+// @Param   address_id query    int     false        "filter address_id=value or address_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   full_name_of_building_admin query    string  false        "filter full_name_of_building_admin=value or full_name_of_building_admin[op]=value"
+// @Param   email_of_admin_of_building query    string  false        "filter email_of_admin_of_building=value or email_of_admin_of_building[op]=value"
+// @Param   phone_num_of_building_admin query    int     false        "filter phone_num_of_building_admin=value or phone_num_of_building_admin[op]=value"
+// @Param   full_name_of_tech_contact_for_building query    string  false        "filter full_name_of_tech_contact_for_building=value or full_name_of_tech_contact_for_building[op]=value"
+// @Param   tech_contact_email_for_building query    string  false        "filter tech_contact_email_for_building=value or tech_contact_email_for_building[op]=value"
+// @Param   tech_contact_phone_for_building query    int     false        "filter tech_contact_phone_for_building=value or tech_contact_phone_for_building[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Buildings_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /buildings_ [get]
@@ -59,20 +96,128 @@ func GetAllBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "buildings")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "buildings", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllBuildings_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "buildings", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "buildings", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBuildings_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashBuildings_ is a function to get a slice of the soft deleted record(s) from buildings table in the rocket_development database
+// @Summary Get list of deleted Buildings_
+// @Tags Buildings_
+// @Description TrashBuildings_ is a handler to get a slice of the soft deleted record(s) from buildings table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: address, batteries, building_details, customer, interventions"
+// @Param   customer_id query    int     false        "filter customer_id=value or customer_id[op]=value"
+// @Param   address_id query    int     false        "filter address_id=value or address_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   full_name_of_building_admin query    string  false        "filter full_name_of_building_admin=value or full_name_of_building_admin[op]=value"
+// @Param   email_of_admin_of_building query    string  false        "filter email_of_admin_of_building=value or email_of_admin_of_building[op]=value"
+// @Param   phone_num_of_building_admin query    int     false        "filter phone_num_of_building_admin=value or phone_num_of_building_admin[op]=value"
+// @Param   full_name_of_tech_contact_for_building query    string  false        "filter full_name_of_tech_contact_for_building=value or full_name_of_tech_contact_for_building[op]=value"
+// @Param   tech_contact_email_for_building query    string  false        "filter tech_contact_email_for_building=value or tech_contact_email_for_building[op]=value"
+// @Param   tech_contact_phone_for_building query    int     false        "filter tech_contact_phone_for_building=value or tech_contact_phone_for_building[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Buildings_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /buildings_/trash [get]
+// http "http://localhost:8080/buildings_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "buildings")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "buildings", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "buildings", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "buildings", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllBuildings_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +229,14 @@ func GetAllBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: address, batteries, building_details, customer, interventions"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Buildings_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /buildings_/{argID} [get]
@@ -98,18 +250,41 @@ func GetBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "buildings")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "buildings")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "buildings", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetBuildings_(ctx, argID)
+	record, err := dao.GetBuildings_(ctx, argID, recordFields("buildings", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "buildings", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddBuildings_ add to add a single record to buildings table in the rocket_development database
@@ -122,8 +297,12 @@ func GetBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Buildings_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildings_ [post]
-// echo '{"customer_id": 44,"address_id": 4,"id": 2,"full_name_of_building_admin": "jjAUcjaLPmvdAhBJwIBgXZshd","email_of_admin_of_building": "XwQbXBbmtjgMGCJkkmmVXhtlx","phone_num_of_building_admin": 11,"full_name_of_tech_contact_for_building": "vhLlNFlvocQcdjFSDseiAhLKj","tech_contact_email_for_building": "LWjXMnruwKBSDnWDXOioiuFlV","tech_contact_phone_for_building": 29,"created_at": "2183-04-21T09:54:21.90221388-04:00","updated_at": "2116-12-14T20:39:39.841018286-05:00"}' | http POST "http://localhost:8080/buildings_" X-Api-User:user123
+// echo '{"customer_id": 44,"address_id": 4,"id": 2,"full_name_of_building_admin": "jjAUcjaLPmvdAhBJwIBgXZshd","email_of_admin_of_building": "XwQbXBbmtjgMGCJkkmmVXhtlx","phone_num_of_building_admin": 11,"full_name_of_tech_contact_for_building": "vhLlNFlvocQcdjFSDseiAhLKj","tech_contact_email_for_building": "LWjXMnruwKBSDnWDXOioiuFlV","tech_contact_phone_for_building": 29}' | http POST "http://localhost:8080/buildings_" X-Api-User:user123
 func AddBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	buildings_ := &model.Buildings_{}
@@ -134,13 +313,14 @@ func AddBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := buildings_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	buildings_.Prepare()
 
 	if err := buildings_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +341,24 @@ func AddBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateBuildings_ Update a single record from buildings table in the rocket_development database
 // @Summary Update an record in table buildings
-// @Description Update a single record from buildings table in the rocket_development database
+// @Description Update a single record from buildings table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Buildings_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Buildings_ body model.Buildings_ true "Update Buildings_ record"
 // @Success 200 {object} model.Buildings_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildings_/{argID} [put]
-// echo '{"customer_id": 44,"address_id": 4,"id": 2,"full_name_of_building_admin": "jjAUcjaLPmvdAhBJwIBgXZshd","email_of_admin_of_building": "XwQbXBbmtjgMGCJkkmmVXhtlx","phone_num_of_building_admin": 11,"full_name_of_tech_contact_for_building": "vhLlNFlvocQcdjFSDseiAhLKj","tech_contact_email_for_building": "LWjXMnruwKBSDnWDXOioiuFlV","tech_contact_phone_for_building": 29,"created_at": "2183-04-21T09:54:21.90221388-04:00","updated_at": "2116-12-14T20:39:39.841018286-05:00"}' | http PUT "http://localhost:8080/buildings_/1"  X-Api-User:user123
+// echo '{"customer_id": 44,"address_id": 4,"id": 2,"full_name_of_building_admin": "jjAUcjaLPmvdAhBJwIBgXZshd","email_of_admin_of_building": "XwQbXBbmtjgMGCJkkmmVXhtlx","phone_num_of_building_admin": 11,"full_name_of_tech_contact_for_building": "vhLlNFlvocQcdjFSDseiAhLKj","tech_contact_email_for_building": "LWjXMnruwKBSDnWDXOioiuFlV","tech_contact_phone_for_building": 29}' | http PUT "http://localhost:8080/buildings_/1"  X-Api-User:user123
 func UpdateBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +368,12 @@ func UpdateBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	buildings_ := &model.Buildings_{}
 	if err := readJSON(r, buildings_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +381,14 @@ func UpdateBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := buildings_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	buildings_.Prepare()
 
 	if err := buildings_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +408,77 @@ func UpdateBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	writeJSON(ctx, w, buildings_)
 }
 
+// PatchBuildings_ Patch a single record from buildings table in the rocket_development database
+// @Summary Patch an record in table buildings
+// @Description Patch a single record from buildings table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Buildings_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Buildings_ body model.Buildings_ true "fields of Buildings_ to change, null clears a field"
+// @Success 200 {object} model.Buildings_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /buildings_/{argID} [patch]
+// echo '{"customer_id": null}' | http PATCH "http://localhost:8080/buildings_/1"  X-Api-User:user123
+func PatchBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "buildings", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	buildings_, _, err := dao.PatchBuildings_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, buildings_)
+}
+
+// BulkBuildings_ runs create, update and delete operations on records of the buildings table in the rocket_development database
+// @Summary Bulk create, update and delete records of table buildings
+// @Description BulkBuildings_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Buildings_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Buildings_ records, the record of create and update is a Buildings_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /buildings_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"customer_id": 44,"address_id": 4,"id": 2,"full_name_of_building_admin": "jjAUcjaLPmvdAhBJwIBgXZshd","email_of_admin_of_building": "XwQbXBbmtjgMGCJkkmmVXhtlx","phone_num_of_building_admin": 11,"full_name_of_tech_contact_for_building": "vhLlNFlvocQcdjFSDseiAhLKj","tech_contact_email_for_building": "LWjXMnruwKBSDnWDXOioiuFlV","tech_contact_phone_for_building": 29}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/buildings_/bulk" X-Api-User:user123
+func BulkBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "buildings")
+}
+
 // DeleteBuildings_ Delete a single record from buildings table in the rocket_development database
 // @Summary Delete a record from buildings
 // @Description Delete a single record from buildings table in the rocket_development database
@@ -221,9 +486,18 @@ func UpdateBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Buildings_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /buildings_/{argID} [delete]
 // http DELETE "http://localhost:8080/buildings_/1" X-Api-User:user123
 func DeleteBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +509,85 @@ func DeleteBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "buildings", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteBuildings_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "buildings", argID)
+		return
+	}
+
+	if _, err := dao.DeleteBuildings_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreBuildings_ Restore a soft deleted record of the buildings table in the rocket_development database
+// @Summary Restore a deleted record of buildings
+// @Description Restore a soft deleted record of the buildings table in the rocket_development database, deleted_at is cleared
+// @Tags Buildings_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Buildings_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /buildings_/{argID}/restore [post]
+// http POST "http://localhost:8080/buildings_/1/restore" X-Api-User:user123
+func RestoreBuildings_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "buildings", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreBuildings_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
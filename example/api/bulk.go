@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+// BulkRequest is the body of a bulk request, operations run in order in a single transaction
+type BulkRequest struct {
+	// ContinueOnError commits the operations that succeeded instead of rolling back every operation on the first failure
+	ContinueOnError bool                 `json:"continue_on_error"`
+	Operations      []*dao.BulkOperation `json:"operations"`
+}
+
+// bulkActions maps the op of a bulk operation to the action validated for the request
+var bulkActions = map[string]model.Action{
+	"create": model.Create,
+	"update": model.Update,
+	"delete": model.Delete,
+}
+
+// bulkRecords runs the bulk request of r on the records of table. The results are returned with 200 when the
+// transaction was committed and 400 when it was rolled back.
+func bulkRecords(ctx context.Context, w http.ResponseWriter, r *http.Request, table string) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok {
+		returnError(ctx, w, r, dao.ErrNotFound)
+		return
+	}
+
+	request := &BulkRequest{}
+	if err := readJSON(r, request); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	validated := map[model.Action]bool{}
+	for _, operation := range request.Operations {
+		action, ok := bulkActions[operation.Op]
+		if !ok || validated[action] {
+			continue
+		}
+		if err := ValidateRequest(ctx, r, table, action); err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+		validated[action] = true
+	}
+
+	prepare := func(record model.Model, action model.Action) error {
+		if err := record.BeforeSave(); err != nil {
+			return invalidRecord(err)
+		}
+
+		record.Prepare()
+
+		if err := record.Validate(action); err != nil {
+			return invalidRecord(err)
+		}
+		return nil
+	}
+
+	results, err := dao.Bulk(ctx, tableInfo, request.Operations, request.ContinueOnError, prepare)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	if !results.Committed {
+		status = http.StatusBadRequest
+	}
+	writeJSONStatus(ctx, w, status, results)
+}
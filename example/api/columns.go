@@ -18,29 +18,58 @@ var (
 func configColumns_Router(router *httprouter.Router) {
 	router.GET("/columns_", GetAllColumns_)
 	router.POST("/columns_", AddColumns_)
-	router.GET("/columns_/:argID", GetColumns_)
+	router.GET("/columns_/:argID", staticSegment("trash", TrashColumns_, GetColumns_))
 	router.PUT("/columns_/:argID", UpdateColumns_)
+	router.PATCH("/columns_/:argID", PatchColumns_)
+	router.POST("/columns_/:argID", staticSegment("bulk", BulkColumns_, nil))
 	router.DELETE("/columns_/:argID", DeleteColumns_)
+	router.GET("/columns_/:argID/history", GetRecordHistory("columns", "argID"))
+	router.POST("/columns_/:argID/restore", RestoreColumns_)
 }
 
 func configGinColumns_Router(router gin.IRoutes) {
 	router.GET("/columns_", ConverHttprouterToGin(GetAllColumns_))
 	router.POST("/columns_", ConverHttprouterToGin(AddColumns_))
-	router.GET("/columns_/:argID", ConverHttprouterToGin(GetColumns_))
+	router.GET("/columns_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashColumns_, GetColumns_)))
 	router.PUT("/columns_/:argID", ConverHttprouterToGin(UpdateColumns_))
+	router.PATCH("/columns_/:argID", ConverHttprouterToGin(PatchColumns_))
+	router.POST("/columns_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkColumns_, nil)))
 	router.DELETE("/columns_/:argID", ConverHttprouterToGin(DeleteColumns_))
+	router.GET("/columns_/:argID/history", ConverHttprouterToGin(GetRecordHistory("columns", "argID")))
+	router.POST("/columns_/:argID/restore", ConverHttprouterToGin(RestoreColumns_))
 }
 
 // GetAllColumns_ is a function to get a slice of record(s) from columns table in the rocket_development database
 // @Summary Get list of Columns_
 // @Tags Columns_
-// @Description GetAllColumns_ is a handler to get a slice of record(s) from columns table in the rocket_development database
+// @Description GetAllColumns_ is a handler to get a slice of record(s) from columns table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: battery, elevators, interventions"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   battery_id query    int     false        "filter battery_id=value or battery_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   num_of_floors_served query    int     false        "filter num_of_floors_served=value or num_of_floors_served[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Columns_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /columns_ [get]
@@ -59,20 +88,126 @@ func GetAllColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "columns")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "columns", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllColumns_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "columns", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "columns", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllColumns_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashColumns_ is a function to get a slice of the soft deleted record(s) from columns table in the rocket_development database
+// @Summary Get list of deleted Columns_
+// @Tags Columns_
+// @Description TrashColumns_ is a handler to get a slice of the soft deleted record(s) from columns table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: battery, elevators, interventions"
+// @Param   battery_id query    int     false        "filter battery_id=value or battery_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   num_of_floors_served query    int     false        "filter num_of_floors_served=value or num_of_floors_served[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Columns_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /columns_/trash [get]
+// http "http://localhost:8080/columns_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "columns")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "columns", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "columns", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "columns", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllColumns_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +219,14 @@ func GetAllColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: battery, elevators, interventions"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Columns_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /columns_/{argID} [get]
@@ -98,18 +240,41 @@ func GetColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	fields, err := readFields(r, "columns")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "columns")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "columns", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetColumns_(ctx, argID)
+	record, err := dao.GetColumns_(ctx, argID, recordFields("columns", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "columns", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddColumns_ add to add a single record to columns table in the rocket_development database
@@ -122,8 +287,12 @@ func GetColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Success 200 {object} model.Columns_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /columns_ [post]
-// echo '{"battery_id": 40,"id": 43,"type": "MRcsyTHJDkIxTBMdAESRNbZvJ","num_of_floors_served": 59,"status": "gaJxcRAnhcJwTmnrVLMAfGtwk","information": "xEijvYGMinapPhajtKeaumxcn","notes": "vBDTVUGsGLkZweRWuqpHoDBqX","created_at": "2183-11-01T07:11:42.860060882-04:00","updated_at": "2314-02-24T23:11:20.823796502-05:00"}' | http POST "http://localhost:8080/columns_" X-Api-User:user123
+// echo '{"battery_id": 40,"id": 43,"type": "MRcsyTHJDkIxTBMdAESRNbZvJ","num_of_floors_served": 59,"status": "gaJxcRAnhcJwTmnrVLMAfGtwk","information": "xEijvYGMinapPhajtKeaumxcn","notes": "vBDTVUGsGLkZweRWuqpHoDBqX"}' | http POST "http://localhost:8080/columns_" X-Api-User:user123
 func AddColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	columns_ := &model.Columns_{}
@@ -134,13 +303,14 @@ func AddColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := columns_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	columns_.Prepare()
 
 	if err := columns_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +331,24 @@ func AddColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateColumns_ Update a single record from columns table in the rocket_development database
 // @Summary Update an record in table columns
-// @Description Update a single record from columns table in the rocket_development database
+// @Description Update a single record from columns table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Columns_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Columns_ body model.Columns_ true "Update Columns_ record"
 // @Success 200 {object} model.Columns_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /columns_/{argID} [put]
-// echo '{"battery_id": 40,"id": 43,"type": "MRcsyTHJDkIxTBMdAESRNbZvJ","num_of_floors_served": 59,"status": "gaJxcRAnhcJwTmnrVLMAfGtwk","information": "xEijvYGMinapPhajtKeaumxcn","notes": "vBDTVUGsGLkZweRWuqpHoDBqX","created_at": "2183-11-01T07:11:42.860060882-04:00","updated_at": "2314-02-24T23:11:20.823796502-05:00"}' | http PUT "http://localhost:8080/columns_/1"  X-Api-User:user123
+// echo '{"battery_id": 40,"id": 43,"type": "MRcsyTHJDkIxTBMdAESRNbZvJ","num_of_floors_served": 59,"status": "gaJxcRAnhcJwTmnrVLMAfGtwk","information": "xEijvYGMinapPhajtKeaumxcn","notes": "vBDTVUGsGLkZweRWuqpHoDBqX"}' | http PUT "http://localhost:8080/columns_/1"  X-Api-User:user123
 func UpdateColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +358,12 @@ func UpdateColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	columns_ := &model.Columns_{}
 	if err := readJSON(r, columns_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +371,14 @@ func UpdateColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 	}
 
 	if err := columns_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	columns_.Prepare()
 
 	if err := columns_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +398,77 @@ func UpdateColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 	writeJSON(ctx, w, columns_)
 }
 
+// PatchColumns_ Patch a single record from columns table in the rocket_development database
+// @Summary Patch an record in table columns
+// @Description Patch a single record from columns table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Columns_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Columns_ body model.Columns_ true "fields of Columns_ to change, null clears a field"
+// @Success 200 {object} model.Columns_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /columns_/{argID} [patch]
+// echo '{"battery_id": null}' | http PATCH "http://localhost:8080/columns_/1"  X-Api-User:user123
+func PatchColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "columns", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	columns_, _, err := dao.PatchColumns_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, columns_)
+}
+
+// BulkColumns_ runs create, update and delete operations on records of the columns table in the rocket_development database
+// @Summary Bulk create, update and delete records of table columns
+// @Description BulkColumns_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Columns_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Columns_ records, the record of create and update is a Columns_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /columns_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"battery_id": 40,"id": 43,"type": "MRcsyTHJDkIxTBMdAESRNbZvJ","num_of_floors_served": 59,"status": "gaJxcRAnhcJwTmnrVLMAfGtwk","information": "xEijvYGMinapPhajtKeaumxcn","notes": "vBDTVUGsGLkZweRWuqpHoDBqX"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/columns_/bulk" X-Api-User:user123
+func BulkColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "columns")
+}
+
 // DeleteColumns_ Delete a single record from columns table in the rocket_development database
 // @Summary Delete a record from columns
 // @Description Delete a single record from columns table in the rocket_development database
@@ -221,9 +476,18 @@ func UpdateColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Columns_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /columns_/{argID} [delete]
 // http DELETE "http://localhost:8080/columns_/1" X-Api-User:user123
 func DeleteColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +499,85 @@ func DeleteColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "columns", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteColumns_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "columns", argID)
+		return
+	}
+
+	if _, err := dao.DeleteColumns_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreColumns_ Restore a soft deleted record of the columns table in the rocket_development database
+// @Summary Restore a deleted record of columns
+// @Description Restore a soft deleted record of the columns table in the rocket_development database, deleted_at is cleared
+// @Tags Columns_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Columns_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /columns_/{argID}/restore [post]
+// http POST "http://localhost:8080/columns_/1/restore" X-Api-User:user123
+func RestoreColumns_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "columns", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreColumns_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
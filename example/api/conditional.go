@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+var (
+	// CacheControl Cache-Control header of the GET responses of a table keyed by table name, tables missing from it use
+	// DefaultCacheControl
+	CacheControl = map[string]string{}
+
+	// DefaultCacheControl Cache-Control header of GET responses, no-cache lets clients keep responses but revalidate
+	// them with If-None-Match or If-Modified-Since on every use
+	DefaultCacheControl = "no-cache"
+)
+
+// notModified sets the Cache-Control, ETag and Last-Modified headers of a GET response of table. When the
+// If-None-Match header, or without one the If-Modified-Since header, of r shows the client holds the current response
+// 304 Not Modified is written and true returned. An empty etag or zero lastModified is not sent.
+func notModified(w http.ResponseWriter, r *http.Request, table string, etag string, lastModified time.Time) bool {
+	cacheControl, ok := CacheControl[table]
+	if !ok {
+		cacheControl = DefaultCacheControl
+	}
+	w.Header().Set("Cache-Control", cacheControl)
+
+	if etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		if etag == "" || !etagListMatches(header, etag) {
+			return false
+		}
+	} else if header := r.Header.Get("If-Modified-Since"); header != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(header)
+		if err != nil || lastModified.Truncate(time.Second).After(since) {
+			return false
+		}
+	} else {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagListMatches returns true when one of the comma separated entity tags of header matches etag using the weak
+// comparison of If-None-Match
+func etagListMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// recordFields returns the columns read for a single record response of table holding fields and the included
+// relations, fields plus the relation keys and the updated_at column Last-Modified is read from. Every column is read
+// when fields is empty.
+func recordFields(table string, fields []*model.ColumnInfo, relations []*model.Relation) []*model.ColumnInfo {
+	columns := dao.RelationFields(fields, relations)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	if tableInfo, ok := model.GetTableInfo(table); ok {
+		if col, ok := tableInfo.Column("updated_at"); ok {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// recordValidators returns the entity tag and last modification time of the single record response data. The strong
+// tag of the record covers every column, so a response reduced to fields or holding included relations gets a weak
+// tag of data instead, the representation actually sent. Related records change on their own, so with included
+// relations there is no last modification time either.
+func recordValidators(record model.Model, data interface{}, fields []*model.ColumnInfo, relations []*model.Relation) (etag string, lastModified time.Time) {
+	if len(relations) > 0 {
+		return weakETag(data), time.Time{}
+	}
+
+	lastModified, _ = dao.RecordUpdatedAt(record)
+	if len(fields) > 0 {
+		return weakETag(data), lastModified
+	}
+	return dao.RecordETag(record), lastModified
+}
+
+// listValidators returns the weak entity tag and last modification time of a GET of the records of table matching
+// query, keyed on the latest updated_at and the number of the matching records along with the query string of r.
+// Lists of tables without updated_at and lists including related records have none.
+func listValidators(ctx context.Context, r *http.Request, table string, query *dao.ListQuery) (etag string, lastModified time.Time, err error) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok || len(query.Include) > 0 {
+		return "", time.Time{}, nil
+	}
+
+	updatedAt, count, ok, err := dao.ListVersion(ctx, tableInfo, query)
+	if err != nil || !ok {
+		return "", time.Time{}, err
+	}
+
+	return weakETag([]string{table, updatedAt.UTC().Format(time.RFC3339Nano), strconv.Itoa(count), r.URL.Query().Encode()}), updatedAt, nil
+}
+
+// weakETag returns a weak entity tag hashed from the json of v
+func weakETag(v interface{}) string {
+	data, _ := json.Marshal(v)
+	hash := sha256.Sum256(data)
+	return `W/"` + hex.EncodeToString(hash[:16]) + `"`
+}
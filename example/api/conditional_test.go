@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+func TestNotModified(t *testing.T) {
+	modified := time.Date(2021, 6, 1, 10, 0, 0, 500, time.UTC)
+	strong, weak := `"abc"`, `W/"abc"`
+
+	tests := []struct {
+		name         string
+		etag         string
+		lastModified time.Time
+		headers      map[string]string
+		notModified  bool
+	}{
+		{"no condition", strong, modified, nil, false},
+		{"if-none-match", strong, modified, map[string]string{"If-None-Match": `"abc"`}, true},
+		{"if-none-match in a list", strong, modified, map[string]string{"If-None-Match": `"x", "abc"`}, true},
+		{"if-none-match star", strong, modified, map[string]string{"If-None-Match": `*`}, true},
+		{"if-none-match compares weakly", weak, modified, map[string]string{"If-None-Match": `"abc"`}, true},
+		{"weak if-none-match of a strong tag", strong, modified, map[string]string{"If-None-Match": `W/"abc"`}, true},
+		{"if-none-match changed", strong, modified, map[string]string{"If-None-Match": `"abd"`}, false},
+		{"if-none-match without a tag", "", modified, map[string]string{"If-None-Match": `"abc"`}, false},
+		{"if-modified-since", strong, modified, map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)}, true},
+		{"if-modified-since later", strong, modified, map[string]string{"If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat)}, true},
+		{"if-modified-since earlier", strong, modified, map[string]string{"If-Modified-Since": modified.Add(-time.Second).Format(http.TimeFormat)}, false},
+		{"if-modified-since invalid", strong, modified, map[string]string{"If-Modified-Since": "yesterday"}, false},
+		{"if-modified-since without a time", strong, time.Time{}, map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)}, false},
+		{"if-none-match wins over if-modified-since", strong, modified,
+			map[string]string{"If-None-Match": `"abd"`, "If-Modified-Since": modified.Format(http.TimeFormat)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/elevators_/1", nil)
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+
+			if got := notModified(w, r, "elevators", tt.etag, tt.lastModified); got != tt.notModified {
+				t.Errorf("notModified() = %v, want %v", got, tt.notModified)
+			}
+			if tt.notModified && w.Code != http.StatusNotModified {
+				t.Errorf("status = %d, want 304", w.Code)
+			}
+			if w.Header().Get("ETag") != tt.etag {
+				t.Errorf("ETag = %q, want %q", w.Header().Get("ETag"), tt.etag)
+			}
+			if want := tt.lastModified.Format(http.TimeFormat); !tt.lastModified.IsZero() && w.Header().Get("Last-Modified") != want {
+				t.Errorf("Last-Modified = %q, want %q", w.Header().Get("Last-Modified"), want)
+			}
+		})
+	}
+}
+
+func TestNotModifiedCacheControl(t *testing.T) {
+	saved, savedDefault := CacheControl, DefaultCacheControl
+	t.Cleanup(func() { CacheControl, DefaultCacheControl = saved, savedDefault })
+	CacheControl = map[string]string{"elevators": "max-age=10"}
+	DefaultCacheControl = "no-store"
+
+	for table, want := range map[string]string{"elevators": "max-age=10", "buildings": "no-store"} {
+		w := httptest.NewRecorder()
+		notModified(w, httptest.NewRequest(http.MethodGet, "/", nil), table, "", time.Time{})
+		if got := w.Header().Get("Cache-Control"); got != want {
+			t.Errorf("Cache-Control of %s = %q, want %q", table, got, want)
+		}
+	}
+}
+
+func TestWithIfMatch(t *testing.T) {
+	saved := RequireIfMatch
+	t.Cleanup(func() { RequireIfMatch = saved })
+
+	tests := []struct {
+		name    string
+		header  string
+		require bool
+		err     error
+	}{
+		{"no header", "", false, nil},
+		{"required", "", true, dao.ErrPreconditionRequired},
+		{"strong tag", `"abc"`, true, nil},
+		{"star", "*", false, nil},
+		{"weak and strong tags", `W/"abc", "abd"`, false, nil},
+		{"weak tags only", `W/"abc", W/"abd"`, false, dao.ErrPreconditionWeak},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RequireIfMatch = tt.require
+			r := httptest.NewRequest(http.MethodPut, "/elevators_/1", nil)
+			if tt.header != "" {
+				r.Header.Set("If-Match", tt.header)
+			}
+
+			if _, err := withIfMatch(context.Background(), r); !errors.Is(err, tt.err) {
+				t.Fatalf("withIfMatch(%q) error = %v, want %v", tt.header, err, tt.err)
+			}
+		})
+	}
+}
+
+// request serves a request of method to target with headers and body through the router and returns the response
+func request(t *testing.T, method, target string, headers map[string]string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	ConfigRouter().ServeHTTP(w, r)
+	return w
+}
+
+func TestConditionalRequests(t *testing.T) {
+	useTestTables(t, "elevators", "interventions")
+	if err := dao.DB.Create(&model.Elevators_{Status: null.StringFrom("Active")}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	whole := request(t, http.MethodGet, "/elevators_/1", nil, "")
+	etag := whole.Header().Get("ETag")
+	if whole.Code != http.StatusOK || etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Fatalf("GET = %d with etag %q, want 200 with a strong etag", whole.Code, etag)
+	}
+
+	projected := request(t, http.MethodGet, "/elevators_/1?fields=id,status", nil, "")
+	weak := projected.Header().Get("ETag")
+	if projected.Code != http.StatusOK || !strings.HasPrefix(weak, "W/") {
+		t.Fatalf("GET with fields = %d with etag %q, want 200 with a weak etag", projected.Code, weak)
+	}
+
+	steps := []struct {
+		name    string
+		method  string
+		target  string
+		headers map[string]string
+		body    string
+		status  int
+		detail  string
+	}{
+		{"revalidate", http.MethodGet, "/elevators_/1", map[string]string{"If-None-Match": etag}, "", http.StatusNotModified, ""},
+		{"revalidate the fields", http.MethodGet, "/elevators_/1?fields=id,status", map[string]string{"If-None-Match": weak}, "", http.StatusNotModified, ""},
+		{"revalidate with the tag of other fields", http.MethodGet, "/elevators_/1?fields=id", map[string]string{"If-None-Match": weak}, "", http.StatusOK, ""},
+		{"update with the weak tag", http.MethodPatch, "/elevators_/1", map[string]string{"If-Match": weak}, `{"status": "Inactive"}`,
+			http.StatusPreconditionFailed, dao.ErrPreconditionWeak.Error()},
+		{"update with a stale tag", http.MethodPatch, "/elevators_/1", map[string]string{"If-Match": `"stale"`}, `{"status": "Inactive"}`,
+			http.StatusPreconditionFailed, dao.ErrPreconditionFailed.Error()},
+		{"update with the current tag", http.MethodPatch, "/elevators_/1", map[string]string{"If-Match": etag}, `{"status": "Inactive"}`, http.StatusOK, ""},
+		{"update again with the same tag", http.MethodPatch, "/elevators_/1", map[string]string{"If-Match": etag}, `{"status": "Active"}`,
+			http.StatusPreconditionFailed, dao.ErrPreconditionFailed.Error()},
+		{"revalidate after the update", http.MethodGet, "/elevators_/1", map[string]string{"If-None-Match": etag}, "", http.StatusOK, ""},
+		{"delete with any tag", http.MethodDelete, "/elevators_/1", map[string]string{"If-Match": "*"}, "", http.StatusNoContent, ""},
+	}
+
+	for _, step := range steps {
+		w := request(t, step.method, step.target, step.headers, step.body)
+		if w.Code != step.status {
+			t.Errorf("%s: status = %d, want %d: %s", step.name, w.Code, step.status, w.Body.String())
+			continue
+		}
+		if step.detail != "" && !strings.Contains(w.Body.String(), step.detail) {
+			t.Errorf("%s: body = %s, want the detail %q", step.name, w.Body.String(), step.detail)
+		}
+	}
+}
+
+func TestRecordValidators(t *testing.T) {
+	modified := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	elevator := &model.Elevators_{ID: 1, Status: null.StringFrom("Active"), UpdatedAt: modified}
+	table, _ := model.GetTableInfo("elevators")
+	status, _ := table.Column("status")
+	relations, _ := dao.ParseInclude(table, "column")
+
+	etag, lastModified := recordValidators(elevator, elevator, nil, nil)
+	if etag != dao.RecordETag(elevator) || !lastModified.Equal(modified) {
+		t.Errorf("whole record = %s %v, want the strong etag of the record and its updated_at", etag, lastModified)
+	}
+
+	projected := map[string]interface{}{"status": "Active"}
+	etag, lastModified = recordValidators(elevator, projected, []*model.ColumnInfo{status}, nil)
+	if etag != weakETag(projected) || !lastModified.Equal(modified) {
+		t.Errorf("fields = %s %v, want a weak etag of the response and the updated_at", etag, lastModified)
+	}
+
+	etag, lastModified = recordValidators(elevator, projected, nil, relations)
+	if etag != weakETag(projected) || !lastModified.IsZero() {
+		t.Errorf("include = %s %v, want a weak etag of the response without a last modification", etag, lastModified)
+	}
+}
@@ -18,29 +18,65 @@ var (
 func configCustomers_Router(router *httprouter.Router) {
 	router.GET("/customers_", GetAllCustomers_)
 	router.POST("/customers_", AddCustomers_)
-	router.GET("/customers_/:argID", GetCustomers_)
+	router.GET("/customers_/:argID", staticSegment("trash", TrashCustomers_, GetCustomers_))
 	router.PUT("/customers_/:argID", UpdateCustomers_)
+	router.PATCH("/customers_/:argID", PatchCustomers_)
+	router.POST("/customers_/:argID", staticSegment("bulk", BulkCustomers_, nil))
 	router.DELETE("/customers_/:argID", DeleteCustomers_)
+	router.GET("/customers_/:argID/history", GetRecordHistory("customers", "argID"))
+	router.POST("/customers_/:argID/restore", RestoreCustomers_)
 }
 
 func configGinCustomers_Router(router gin.IRoutes) {
 	router.GET("/customers_", ConverHttprouterToGin(GetAllCustomers_))
 	router.POST("/customers_", ConverHttprouterToGin(AddCustomers_))
-	router.GET("/customers_/:argID", ConverHttprouterToGin(GetCustomers_))
+	router.GET("/customers_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashCustomers_, GetCustomers_)))
 	router.PUT("/customers_/:argID", ConverHttprouterToGin(UpdateCustomers_))
+	router.PATCH("/customers_/:argID", ConverHttprouterToGin(PatchCustomers_))
+	router.POST("/customers_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkCustomers_, nil)))
 	router.DELETE("/customers_/:argID", ConverHttprouterToGin(DeleteCustomers_))
+	router.GET("/customers_/:argID/history", ConverHttprouterToGin(GetRecordHistory("customers", "argID")))
+	router.POST("/customers_/:argID/restore", ConverHttprouterToGin(RestoreCustomers_))
 }
 
 // GetAllCustomers_ is a function to get a slice of record(s) from customers table in the rocket_development database
 // @Summary Get list of Customers_
 // @Tags Customers_
-// @Description GetAllCustomers_ is a handler to get a slice of record(s) from customers table in the rocket_development database
+// @Description GetAllCustomers_ is a handler to get a slice of record(s) from customers table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: address, buildings, interventions, user"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   address_id query    int     false        "filter address_id=value or address_id[op]=value"
+// @Param   user_id  query    int     false        "filter user_id=value or user_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   customer_creation_date query    string  false        "filter customer_creation_date=value or customer_creation_date[op]=value"
+// @Param   date     query    string  false        "filter date=value or date[op]=value"
+// @Param   company_name query    string  false        "filter company_name=value or company_name[op]=value"
+// @Param   company_hq_adress query    string  false        "filter company_hq_adress=value or company_hq_adress[op]=value"
+// @Param   full_name_of_company_contact query    string  false        "filter full_name_of_company_contact=value or full_name_of_company_contact[op]=value"
+// @Param   company_contact_phone query    string  false        "filter company_contact_phone=value or company_contact_phone[op]=value"
+// @Param   company_contact_e_mail query    string  false        "filter company_contact_e_mail=value or company_contact_e_mail[op]=value"
+// @Param   company_desc query    string  false        "filter company_desc=value or company_desc[op]=value"
+// @Param   full_name_service_tech_auth query    string  false        "filter full_name_service_tech_auth=value or full_name_service_tech_auth[op]=value"
+// @Param   tech_auth_phone_service query    string  false        "filter tech_auth_phone_service=value or tech_auth_phone_service[op]=value"
+// @Param   tech_manager_email_service query    string  false        "filter tech_manager_email_service=value or tech_manager_email_service[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Customers_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /customers_ [get]
@@ -59,20 +95,133 @@ func GetAllCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "customers")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "customers", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllCustomers_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "customers", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "customers", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllCustomers_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashCustomers_ is a function to get a slice of the soft deleted record(s) from customers table in the rocket_development database
+// @Summary Get list of deleted Customers_
+// @Tags Customers_
+// @Description TrashCustomers_ is a handler to get a slice of the soft deleted record(s) from customers table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: address, buildings, interventions, user"
+// @Param   address_id query    int     false        "filter address_id=value or address_id[op]=value"
+// @Param   user_id  query    int     false        "filter user_id=value or user_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   customer_creation_date query    string  false        "filter customer_creation_date=value or customer_creation_date[op]=value"
+// @Param   date     query    string  false        "filter date=value or date[op]=value"
+// @Param   company_name query    string  false        "filter company_name=value or company_name[op]=value"
+// @Param   company_hq_adress query    string  false        "filter company_hq_adress=value or company_hq_adress[op]=value"
+// @Param   full_name_of_company_contact query    string  false        "filter full_name_of_company_contact=value or full_name_of_company_contact[op]=value"
+// @Param   company_contact_phone query    string  false        "filter company_contact_phone=value or company_contact_phone[op]=value"
+// @Param   company_contact_e_mail query    string  false        "filter company_contact_e_mail=value or company_contact_e_mail[op]=value"
+// @Param   company_desc query    string  false        "filter company_desc=value or company_desc[op]=value"
+// @Param   full_name_service_tech_auth query    string  false        "filter full_name_service_tech_auth=value or full_name_service_tech_auth[op]=value"
+// @Param   tech_auth_phone_service query    string  false        "filter tech_auth_phone_service=value or tech_auth_phone_service[op]=value"
+// @Param   tech_manager_email_service query    string  false        "filter tech_manager_email_service=value or tech_manager_email_service[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Customers_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers_/trash [get]
+// http "http://localhost:8080/customers_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "customers")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "customers", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "customers", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "customers", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllCustomers_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +233,14 @@ func GetAllCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: address, buildings, interventions, user"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Customers_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /customers_/{argID} [get]
@@ -98,18 +254,41 @@ func GetCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "customers")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "customers")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "customers", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetCustomers_(ctx, argID)
+	record, err := dao.GetCustomers_(ctx, argID, recordFields("customers", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "customers", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddCustomers_ add to add a single record to customers table in the rocket_development database
@@ -122,8 +301,12 @@ func GetCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Customers_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /customers_ [post]
-// echo '{"address_id": 44,"user_id": 2,"id": 93,"customer_creation_date": "VZNdjPMdgJbnBOXWwEcoZBddF","date": "WCdPvfuxhNmkfOVxtFOsuIgCU","company_name": "OOsKhOXcPRCxjEMEeXSeieCxS","company_hq_adress": "ySkdGuXDAqdePaJJjyavqykJm","full_name_of_company_contact": "wYCjgqRNIoKjujYqaVYxdMWiJ","company_contact_phone": "eueltgJZGMWISqwNIILkwLYRs","company_contact_e_mail": "wdCePrGEthWDpcyLxDSCZJURg","company_desc": "MVOTvwQKQVaxLkeOPMHVLucSX","full_name_service_tech_auth": "ZpWBrKhcFDTXnmbmfDnfUBWJk","tech_auth_phone_service": "vZoEcrYHnEihInwxArxRaAFdU","tech_manager_email_service": "JTxpobNqnXjHXywurCZxLoyUp","created_at": "2245-11-08T05:54:50.870909776-05:00","updated_at": "2168-06-09T12:13:23.24668422-04:00"}' | http POST "http://localhost:8080/customers_" X-Api-User:user123
+// echo '{"address_id": 44,"user_id": 2,"id": 93,"customer_creation_date": "VZNdjPMdgJbnBOXWwEcoZBddF","date": "WCdPvfuxhNmkfOVxtFOsuIgCU","company_name": "OOsKhOXcPRCxjEMEeXSeieCxS","company_hq_adress": "ySkdGuXDAqdePaJJjyavqykJm","full_name_of_company_contact": "wYCjgqRNIoKjujYqaVYxdMWiJ","company_contact_phone": "eueltgJZGMWISqwNIILkwLYRs","company_contact_e_mail": "wdCePrGEthWDpcyLxDSCZJURg","company_desc": "MVOTvwQKQVaxLkeOPMHVLucSX","full_name_service_tech_auth": "ZpWBrKhcFDTXnmbmfDnfUBWJk","tech_auth_phone_service": "vZoEcrYHnEihInwxArxRaAFdU","tech_manager_email_service": "JTxpobNqnXjHXywurCZxLoyUp"}' | http POST "http://localhost:8080/customers_" X-Api-User:user123
 func AddCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	customers_ := &model.Customers_{}
@@ -134,13 +317,14 @@ func AddCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := customers_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	customers_.Prepare()
 
 	if err := customers_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +345,24 @@ func AddCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateCustomers_ Update a single record from customers table in the rocket_development database
 // @Summary Update an record in table customers
-// @Description Update a single record from customers table in the rocket_development database
+// @Description Update a single record from customers table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Customers_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Customers_ body model.Customers_ true "Update Customers_ record"
 // @Success 200 {object} model.Customers_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /customers_/{argID} [put]
-// echo '{"address_id": 44,"user_id": 2,"id": 93,"customer_creation_date": "VZNdjPMdgJbnBOXWwEcoZBddF","date": "WCdPvfuxhNmkfOVxtFOsuIgCU","company_name": "OOsKhOXcPRCxjEMEeXSeieCxS","company_hq_adress": "ySkdGuXDAqdePaJJjyavqykJm","full_name_of_company_contact": "wYCjgqRNIoKjujYqaVYxdMWiJ","company_contact_phone": "eueltgJZGMWISqwNIILkwLYRs","company_contact_e_mail": "wdCePrGEthWDpcyLxDSCZJURg","company_desc": "MVOTvwQKQVaxLkeOPMHVLucSX","full_name_service_tech_auth": "ZpWBrKhcFDTXnmbmfDnfUBWJk","tech_auth_phone_service": "vZoEcrYHnEihInwxArxRaAFdU","tech_manager_email_service": "JTxpobNqnXjHXywurCZxLoyUp","created_at": "2245-11-08T05:54:50.870909776-05:00","updated_at": "2168-06-09T12:13:23.24668422-04:00"}' | http PUT "http://localhost:8080/customers_/1"  X-Api-User:user123
+// echo '{"address_id": 44,"user_id": 2,"id": 93,"customer_creation_date": "VZNdjPMdgJbnBOXWwEcoZBddF","date": "WCdPvfuxhNmkfOVxtFOsuIgCU","company_name": "OOsKhOXcPRCxjEMEeXSeieCxS","company_hq_adress": "ySkdGuXDAqdePaJJjyavqykJm","full_name_of_company_contact": "wYCjgqRNIoKjujYqaVYxdMWiJ","company_contact_phone": "eueltgJZGMWISqwNIILkwLYRs","company_contact_e_mail": "wdCePrGEthWDpcyLxDSCZJURg","company_desc": "MVOTvwQKQVaxLkeOPMHVLucSX","full_name_service_tech_auth": "ZpWBrKhcFDTXnmbmfDnfUBWJk","tech_auth_phone_service": "vZoEcrYHnEihInwxArxRaAFdU","tech_manager_email_service": "JTxpobNqnXjHXywurCZxLoyUp"}' | http PUT "http://localhost:8080/customers_/1"  X-Api-User:user123
 func UpdateCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +372,12 @@ func UpdateCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	customers_ := &model.Customers_{}
 	if err := readJSON(r, customers_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +385,14 @@ func UpdateCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := customers_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	customers_.Prepare()
 
 	if err := customers_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +412,77 @@ func UpdateCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	writeJSON(ctx, w, customers_)
 }
 
+// PatchCustomers_ Patch a single record from customers table in the rocket_development database
+// @Summary Patch an record in table customers
+// @Description Patch a single record from customers table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Customers_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Customers_ body model.Customers_ true "fields of Customers_ to change, null clears a field"
+// @Success 200 {object} model.Customers_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /customers_/{argID} [patch]
+// echo '{"address_id": null}' | http PATCH "http://localhost:8080/customers_/1"  X-Api-User:user123
+func PatchCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	customers_, _, err := dao.PatchCustomers_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, customers_)
+}
+
+// BulkCustomers_ runs create, update and delete operations on records of the customers table in the rocket_development database
+// @Summary Bulk create, update and delete records of table customers
+// @Description BulkCustomers_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Customers_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Customers_ records, the record of create and update is a Customers_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /customers_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"address_id": 44,"user_id": 2,"id": 93,"customer_creation_date": "VZNdjPMdgJbnBOXWwEcoZBddF","date": "WCdPvfuxhNmkfOVxtFOsuIgCU","company_name": "OOsKhOXcPRCxjEMEeXSeieCxS","company_hq_adress": "ySkdGuXDAqdePaJJjyavqykJm","full_name_of_company_contact": "wYCjgqRNIoKjujYqaVYxdMWiJ","company_contact_phone": "eueltgJZGMWISqwNIILkwLYRs","company_contact_e_mail": "wdCePrGEthWDpcyLxDSCZJURg","company_desc": "MVOTvwQKQVaxLkeOPMHVLucSX","full_name_service_tech_auth": "ZpWBrKhcFDTXnmbmfDnfUBWJk","tech_auth_phone_service": "vZoEcrYHnEihInwxArxRaAFdU","tech_manager_email_service": "JTxpobNqnXjHXywurCZxLoyUp"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/customers_/bulk" X-Api-User:user123
+func BulkCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "customers")
+}
+
 // DeleteCustomers_ Delete a single record from customers table in the rocket_development database
 // @Summary Delete a record from customers
 // @Description Delete a single record from customers table in the rocket_development database
@@ -221,9 +490,18 @@ func UpdateCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Customers_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /customers_/{argID} [delete]
 // http DELETE "http://localhost:8080/customers_/1" X-Api-User:user123
 func DeleteCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +513,85 @@ func DeleteCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "customers", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteCustomers_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "customers", argID)
+		return
+	}
+
+	if _, err := dao.DeleteCustomers_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreCustomers_ Restore a soft deleted record of the customers table in the rocket_development database
+// @Summary Restore a deleted record of customers
+// @Description Restore a soft deleted record of the customers table in the rocket_development database, deleted_at is cleared
+// @Tags Customers_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Customers_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /customers_/{argID}/restore [post]
+// http POST "http://localhost:8080/customers_/1/restore" X-Api-User:user123
+func RestoreCustomers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreCustomers_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
@@ -18,29 +18,62 @@ var (
 func configElevators_Router(router *httprouter.Router) {
 	router.GET("/elevators_", GetAllElevators_)
 	router.POST("/elevators_", AddElevators_)
-	router.GET("/elevators_/:argID", GetElevators_)
+	router.GET("/elevators_/:argID", staticSegment("trash", TrashElevators_, GetElevators_))
 	router.PUT("/elevators_/:argID", UpdateElevators_)
+	router.PATCH("/elevators_/:argID", PatchElevators_)
+	router.POST("/elevators_/:argID", staticSegment("bulk", BulkElevators_, nil))
 	router.DELETE("/elevators_/:argID", DeleteElevators_)
+	router.GET("/elevators_/:argID/history", GetRecordHistory("elevators", "argID"))
+	router.POST("/elevators_/:argID/restore", RestoreElevators_)
 }
 
 func configGinElevators_Router(router gin.IRoutes) {
 	router.GET("/elevators_", ConverHttprouterToGin(GetAllElevators_))
 	router.POST("/elevators_", ConverHttprouterToGin(AddElevators_))
-	router.GET("/elevators_/:argID", ConverHttprouterToGin(GetElevators_))
+	router.GET("/elevators_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashElevators_, GetElevators_)))
 	router.PUT("/elevators_/:argID", ConverHttprouterToGin(UpdateElevators_))
+	router.PATCH("/elevators_/:argID", ConverHttprouterToGin(PatchElevators_))
+	router.POST("/elevators_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkElevators_, nil)))
 	router.DELETE("/elevators_/:argID", ConverHttprouterToGin(DeleteElevators_))
+	router.GET("/elevators_/:argID/history", ConverHttprouterToGin(GetRecordHistory("elevators", "argID")))
+	router.POST("/elevators_/:argID/restore", ConverHttprouterToGin(RestoreElevators_))
 }
 
 // GetAllElevators_ is a function to get a slice of record(s) from elevators table in the rocket_development database
 // @Summary Get list of Elevators_
 // @Tags Elevators_
-// @Description GetAllElevators_ is a handler to get a slice of record(s) from elevators table in the rocket_development database
+// @Description GetAllElevators_ is a handler to get a slice of record(s) from elevators table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: column, interventions"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   column_id query    int     false        "filter column_id=value or column_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   serial_number query    int     false        "filter serial_number=value or serial_number[op]=value"
+// @Param   model    query    string  false        "filter model=value or model[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   commision_date query    string  false        "filter commision_date=value or commision_date[op]=value"
+// @Param   last_inspection_date query    string  false        "filter last_inspection_date=value or last_inspection_date[op]=value"
+// @Param   inspection_cert query    string  false        "filter inspection_cert=value or inspection_cert[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Elevators_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /elevators_ [get]
@@ -59,20 +92,130 @@ func GetAllElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "elevators")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "elevators", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllElevators_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "elevators", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "elevators", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllElevators_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashElevators_ is a function to get a slice of the soft deleted record(s) from elevators table in the rocket_development database
+// @Summary Get list of deleted Elevators_
+// @Tags Elevators_
+// @Description TrashElevators_ is a handler to get a slice of the soft deleted record(s) from elevators table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: column, interventions"
+// @Param   column_id query    int     false        "filter column_id=value or column_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   serial_number query    int     false        "filter serial_number=value or serial_number[op]=value"
+// @Param   model    query    string  false        "filter model=value or model[op]=value"
+// @Param   type     query    string  false        "filter type=value or type[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   commision_date query    string  false        "filter commision_date=value or commision_date[op]=value"
+// @Param   last_inspection_date query    string  false        "filter last_inspection_date=value or last_inspection_date[op]=value"
+// @Param   inspection_cert query    string  false        "filter inspection_cert=value or inspection_cert[op]=value"
+// @Param   information query    string  false        "filter information=value or information[op]=value"
+// @Param   notes    query    string  false        "filter notes=value or notes[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Elevators_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /elevators_/trash [get]
+// http "http://localhost:8080/elevators_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "elevators")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "elevators", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "elevators", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "elevators", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllElevators_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +227,14 @@ func GetAllElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: column, interventions"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Elevators_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /elevators_/{argID} [get]
@@ -98,18 +248,41 @@ func GetElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "elevators")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "elevators")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "elevators", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetElevators_(ctx, argID)
+	record, err := dao.GetElevators_(ctx, argID, recordFields("elevators", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "elevators", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddElevators_ add to add a single record to elevators table in the rocket_development database
@@ -122,8 +295,12 @@ func GetElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Elevators_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /elevators_ [post]
-// echo '{"column_id": 58,"id": 94,"serial_number": 29,"model": "aTWVkrgnDpBTrjAaLFjmfjQuw","type": "KBsdoQXmoiPEJumhJfONxrhQb","status": "OutKALHimskroHgLbOdOlWHZs","commision_date": "2094-10-23T00:06:11.490859579-04:00","last_inspection_date": "2164-03-02T09:16:49.879178419-05:00","inspection_cert": "LBexhLjMQbjpHqJwqjLrQkpqP","information": "HsHBZJwoOjaeFNtsWwqSCNUUQ","notes": "luZCZfOtXhbHcYUcEVElUxGwm","created_at": "2210-12-04T23:23:38.463627408-05:00","updated_at": "2087-02-06T06:40:08.275658106-05:00"}' | http POST "http://localhost:8080/elevators_" X-Api-User:user123
+// echo '{"column_id": 58,"id": 94,"serial_number": 29,"model": "aTWVkrgnDpBTrjAaLFjmfjQuw","type": "KBsdoQXmoiPEJumhJfONxrhQb","status": "OutKALHimskroHgLbOdOlWHZs","commision_date": "2094-10-23T00:06:11.490859579-04:00","last_inspection_date": "2164-03-02T09:16:49.879178419-05:00","inspection_cert": "LBexhLjMQbjpHqJwqjLrQkpqP","information": "HsHBZJwoOjaeFNtsWwqSCNUUQ","notes": "luZCZfOtXhbHcYUcEVElUxGwm"}' | http POST "http://localhost:8080/elevators_" X-Api-User:user123
 func AddElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	elevators_ := &model.Elevators_{}
@@ -134,13 +311,14 @@ func AddElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := elevators_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	elevators_.Prepare()
 
 	if err := elevators_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +339,24 @@ func AddElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateElevators_ Update a single record from elevators table in the rocket_development database
 // @Summary Update an record in table elevators
-// @Description Update a single record from elevators table in the rocket_development database
+// @Description Update a single record from elevators table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Elevators_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Elevators_ body model.Elevators_ true "Update Elevators_ record"
 // @Success 200 {object} model.Elevators_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /elevators_/{argID} [put]
-// echo '{"column_id": 58,"id": 94,"serial_number": 29,"model": "aTWVkrgnDpBTrjAaLFjmfjQuw","type": "KBsdoQXmoiPEJumhJfONxrhQb","status": "OutKALHimskroHgLbOdOlWHZs","commision_date": "2094-10-23T00:06:11.490859579-04:00","last_inspection_date": "2164-03-02T09:16:49.879178419-05:00","inspection_cert": "LBexhLjMQbjpHqJwqjLrQkpqP","information": "HsHBZJwoOjaeFNtsWwqSCNUUQ","notes": "luZCZfOtXhbHcYUcEVElUxGwm","created_at": "2210-12-04T23:23:38.463627408-05:00","updated_at": "2087-02-06T06:40:08.275658106-05:00"}' | http PUT "http://localhost:8080/elevators_/1"  X-Api-User:user123
+// echo '{"column_id": 58,"id": 94,"serial_number": 29,"model": "aTWVkrgnDpBTrjAaLFjmfjQuw","type": "KBsdoQXmoiPEJumhJfONxrhQb","status": "OutKALHimskroHgLbOdOlWHZs","commision_date": "2094-10-23T00:06:11.490859579-04:00","last_inspection_date": "2164-03-02T09:16:49.879178419-05:00","inspection_cert": "LBexhLjMQbjpHqJwqjLrQkpqP","information": "HsHBZJwoOjaeFNtsWwqSCNUUQ","notes": "luZCZfOtXhbHcYUcEVElUxGwm"}' | http PUT "http://localhost:8080/elevators_/1"  X-Api-User:user123
 func UpdateElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +366,12 @@ func UpdateElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	elevators_ := &model.Elevators_{}
 	if err := readJSON(r, elevators_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +379,14 @@ func UpdateElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	}
 
 	if err := elevators_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	elevators_.Prepare()
 
 	if err := elevators_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +406,77 @@ func UpdateElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	writeJSON(ctx, w, elevators_)
 }
 
+// PatchElevators_ Patch a single record from elevators table in the rocket_development database
+// @Summary Patch an record in table elevators
+// @Description Patch a single record from elevators table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Elevators_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Elevators_ body model.Elevators_ true "fields of Elevators_ to change, null clears a field"
+// @Success 200 {object} model.Elevators_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /elevators_/{argID} [patch]
+// echo '{"inspection_cert": null}' | http PATCH "http://localhost:8080/elevators_/1"  X-Api-User:user123
+func PatchElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "elevators", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	elevators_, _, err := dao.PatchElevators_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, elevators_)
+}
+
+// BulkElevators_ runs create, update and delete operations on records of the elevators table in the rocket_development database
+// @Summary Bulk create, update and delete records of table elevators
+// @Description BulkElevators_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Elevators_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Elevators_ records, the record of create and update is a Elevators_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /elevators_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"column_id": 58,"id": 94,"serial_number": 29,"model": "aTWVkrgnDpBTrjAaLFjmfjQuw","type": "KBsdoQXmoiPEJumhJfONxrhQb","status": "OutKALHimskroHgLbOdOlWHZs","commision_date": "2094-10-23T00:06:11.490859579-04:00","last_inspection_date": "2164-03-02T09:16:49.879178419-05:00","inspection_cert": "LBexhLjMQbjpHqJwqjLrQkpqP","information": "HsHBZJwoOjaeFNtsWwqSCNUUQ","notes": "luZCZfOtXhbHcYUcEVElUxGwm"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/elevators_/bulk" X-Api-User:user123
+func BulkElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "elevators")
+}
+
 // DeleteElevators_ Delete a single record from elevators table in the rocket_development database
 // @Summary Delete a record from elevators
 // @Description Delete a single record from elevators table in the rocket_development database
@@ -221,9 +484,18 @@ func UpdateElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Elevators_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /elevators_/{argID} [delete]
 // http DELETE "http://localhost:8080/elevators_/1" X-Api-User:user123
 func DeleteElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +507,85 @@ func DeleteElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "elevators", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteElevators_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "elevators", argID)
+		return
+	}
+
+	if _, err := dao.DeleteElevators_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreElevators_ Restore a soft deleted record of the elevators table in the rocket_development database
+// @Summary Restore a deleted record of elevators
+// @Description Restore a soft deleted record of the elevators table in the rocket_development database, deleted_at is cleared
+// @Tags Elevators_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Elevators_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /elevators_/{argID}/restore [post]
+// http POST "http://localhost:8080/elevators_/1/restore" X-Api-User:user123
+func RestoreElevators_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "elevators", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreElevators_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
@@ -20,7 +20,10 @@ func configEmployeesRouter(router *httprouter.Router) {
 	router.POST("/employees", AddEmployees)
 	router.GET("/employees/:argID", GetEmployees)
 	router.PUT("/employees/:argID", UpdateEmployees)
+	router.PATCH("/employees/:argID", PatchEmployees)
+	router.POST("/employees/:argID", staticSegment("bulk", BulkEmployees, nil))
 	router.DELETE("/employees/:argID", DeleteEmployees)
+	router.GET("/employees/:argID/history", GetRecordHistory("employees", "argID"))
 }
 
 func configGinEmployeesRouter(router gin.IRoutes) {
@@ -28,19 +31,40 @@ func configGinEmployeesRouter(router gin.IRoutes) {
 	router.POST("/employees", ConverHttprouterToGin(AddEmployees))
 	router.GET("/employees/:argID", ConverHttprouterToGin(GetEmployees))
 	router.PUT("/employees/:argID", ConverHttprouterToGin(UpdateEmployees))
+	router.PATCH("/employees/:argID", ConverHttprouterToGin(PatchEmployees))
+	router.POST("/employees/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkEmployees, nil)))
 	router.DELETE("/employees/:argID", ConverHttprouterToGin(DeleteEmployees))
+	router.GET("/employees/:argID/history", ConverHttprouterToGin(GetRecordHistory("employees", "argID")))
 }
 
 // GetAllEmployees is a function to get a slice of record(s) from employees table in the rocket_development database
 // @Summary Get list of Employees
 // @Tags Employees
-// @Description GetAllEmployees is a handler to get a slice of record(s) from employees table in the rocket_development database
+// @Description GetAllEmployees is a handler to get a slice of record(s) from employees table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: batteries, interventions, user"
+// @Param   user_id  query    int     false        "filter user_id=value or user_id[op]=value"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   first_name query    string  false        "filter first_name=value or first_name[op]=value"
+// @Param   last_name query    string  false        "filter last_name=value or last_name[op]=value"
+// @Param   title    query    string  false        "filter title=value or title[op]=value"
+// @Param   email    query    string  false        "filter email=value or email[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Employees}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /employees [get]
@@ -59,20 +83,40 @@ func GetAllEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "employees")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "employees", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllEmployees(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "employees", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "employees", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllEmployees(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +128,14 @@ func GetAllEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: batteries, interventions, user"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Employees
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /employees/{argID} [get]
@@ -98,18 +149,41 @@ func GetEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	fields, err := readFields(r, "employees")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "employees")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "employees", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetEmployees(ctx, argID)
+	record, err := dao.GetEmployees(ctx, argID, recordFields("employees", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "employees", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddEmployees add to add a single record to employees table in the rocket_development database
@@ -122,8 +196,12 @@ func GetEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Success 200 {object} model.Employees
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /employees [post]
-// echo '{"user_id": 76,"id": 44,"first_name": "irZMmJdQJeuvMEDNBGWqHgcon","last_name": "BrrftMXwMBxtvEMufOQJvdpJt","title": "KFHdwLlcrhXQUEbJNucIQSqKq","email": "YZkiZrJyffBQiRMHMcqpVEidY","created_at": "2173-01-29T04:55:20.654753241-05:00","updated_at": "2274-02-15T19:00:20.172408353-05:00"}' | http POST "http://localhost:8080/employees" X-Api-User:user123
+// echo '{"user_id": 76,"id": 44,"first_name": "irZMmJdQJeuvMEDNBGWqHgcon","last_name": "BrrftMXwMBxtvEMufOQJvdpJt","title": "KFHdwLlcrhXQUEbJNucIQSqKq","email": "YZkiZrJyffBQiRMHMcqpVEidY"}' | http POST "http://localhost:8080/employees" X-Api-User:user123
 func AddEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	employees := &model.Employees{}
@@ -134,13 +212,14 @@ func AddEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := employees.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	employees.Prepare()
 
 	if err := employees.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +240,24 @@ func AddEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 
 // UpdateEmployees Update a single record from employees table in the rocket_development database
 // @Summary Update an record in table employees
-// @Description Update a single record from employees table in the rocket_development database
+// @Description Update a single record from employees table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Employees
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Employees body model.Employees true "Update Employees record"
 // @Success 200 {object} model.Employees
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /employees/{argID} [put]
-// echo '{"user_id": 76,"id": 44,"first_name": "irZMmJdQJeuvMEDNBGWqHgcon","last_name": "BrrftMXwMBxtvEMufOQJvdpJt","title": "KFHdwLlcrhXQUEbJNucIQSqKq","email": "YZkiZrJyffBQiRMHMcqpVEidY","created_at": "2173-01-29T04:55:20.654753241-05:00","updated_at": "2274-02-15T19:00:20.172408353-05:00"}' | http PUT "http://localhost:8080/employees/1"  X-Api-User:user123
+// echo '{"user_id": 76,"id": 44,"first_name": "irZMmJdQJeuvMEDNBGWqHgcon","last_name": "BrrftMXwMBxtvEMufOQJvdpJt","title": "KFHdwLlcrhXQUEbJNucIQSqKq","email": "YZkiZrJyffBQiRMHMcqpVEidY"}' | http PUT "http://localhost:8080/employees/1"  X-Api-User:user123
 func UpdateEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +267,12 @@ func UpdateEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	employees := &model.Employees{}
 	if err := readJSON(r, employees); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +280,14 @@ func UpdateEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 	}
 
 	if err := employees.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	employees.Prepare()
 
 	if err := employees.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +307,77 @@ func UpdateEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 	writeJSON(ctx, w, employees)
 }
 
+// PatchEmployees Patch a single record from employees table in the rocket_development database
+// @Summary Patch an record in table employees
+// @Description Patch a single record from employees table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Employees body model.Employees true "fields of Employees to change, null clears a field"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /employees/{argID} [patch]
+// echo '{"user_id": null}' | http PATCH "http://localhost:8080/employees/1"  X-Api-User:user123
+func PatchEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	employees, _, err := dao.PatchEmployees(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, employees)
+}
+
+// BulkEmployees runs create, update and delete operations on records of the employees table in the rocket_development database
+// @Summary Bulk create, update and delete records of table employees
+// @Description BulkEmployees runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Employees records, the record of create and update is a Employees"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /employees/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"user_id": 76,"id": 44,"first_name": "irZMmJdQJeuvMEDNBGWqHgcon","last_name": "BrrftMXwMBxtvEMufOQJvdpJt","title": "KFHdwLlcrhXQUEbJNucIQSqKq","email": "YZkiZrJyffBQiRMHMcqpVEidY"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/employees/bulk" X-Api-User:user123
+func BulkEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "employees")
+}
+
 // DeleteEmployees Delete a single record from employees table in the rocket_development database
 // @Summary Delete a record from employees
 // @Description Delete a single record from employees table in the rocket_development database
@@ -221,9 +385,18 @@ func UpdateEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Employees
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /employees/{argID} [delete]
 // http DELETE "http://localhost:8080/employees/1" X-Api-User:user123
 func DeleteEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +408,38 @@ func DeleteEmployees(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "employees", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteEmployees(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "employees", argID)
+		return
+	}
+
+	if _, err := dao.DeleteEmployees(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
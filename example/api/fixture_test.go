@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+// useTestTables sets dao.DB to a new sqlite database holding tables, created from their TableInfo, and the audit log
+// until the test ends
+func useTestTables(t *testing.T, tables ...string) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	db, err := gorm.Open("sqlite3", filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for _, name := range tables {
+		table, ok := model.GetTableInfo(name)
+		if !ok {
+			t.Fatalf("no table %s", name)
+		}
+
+		var columns []string
+		for _, col := range table.Columns {
+			switch {
+			case col.IsAutoIncrement:
+				columns = append(columns, fmt.Sprintf("%q INTEGER PRIMARY KEY AUTOINCREMENT", col.Name))
+			case col.IsPrimaryKey:
+				columns = append(columns, fmt.Sprintf("%q %s PRIMARY KEY", col.Name, col.DatabaseTypeName))
+			default:
+				columns = append(columns, fmt.Sprintf("%q %s", col.Name, col.DatabaseTypeName))
+			}
+		}
+		if err = db.Exec(fmt.Sprintf("CREATE TABLE %q (%s)", name, strings.Join(columns, ", "))).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = db.Exec(`CREATE TABLE audit_logs (id INTEGER PRIMARY KEY AUTOINCREMENT, table_name varchar, record_id varchar,
+		action varchar, principal varchar, request_id varchar, changes text, created_at datetime)`).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	saved := dao.DB
+	t.Cleanup(func() { dao.DB = saved })
+	dao.DB = db
+}
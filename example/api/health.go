@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+
+	"github.com/gin-gonic/gin"
+	"github.com/julienschmidt/httprouter"
+)
+
+var (
+	// ProbeTimeout bounds the readiness checks of a request to /readyz, a check still running is reported as failed
+	ProbeTimeout = 2 * time.Second
+
+	// ReadinessChecks checks run by /readyz along with the database ping, the server is ready when every check returns nil
+	ReadinessChecks []*ReadinessCheck
+
+	// ProbePaths paths of the health, readiness and version endpoints, served without request validation and left out
+	// of the access log
+	ProbePaths = []string{"/healthz", "/readyz", "/version"}
+)
+
+// ReadinessCheck named check of a dependency the server needs to serve requests
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// HealthStatus is the body of the health and readiness endpoints
+type HealthStatus struct {
+	Status string            `json:"status" example:"ok"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// configHealthRouter registers the probe endpoints on router
+func configHealthRouter(router *httprouter.Router) {
+	router.GET("/healthz", GetHealth)
+	router.GET("/readyz", GetReadiness)
+	router.GET("/version", GetVersion)
+}
+
+// configGinHealthRouter registers the probe endpoints on router
+func configGinHealthRouter(router gin.IRoutes) {
+	router.GET("/healthz", ConverHttprouterToGin(GetHealth))
+	router.GET("/readyz", ConverHttprouterToGin(GetReadiness))
+	router.GET("/version", ConverHttprouterToGin(GetVersion))
+}
+
+// GetHealth is a function to check the process is up
+// @Summary Liveness probe
+// @Tags Health
+// @Description GetHealth is a handler answering as long as the process serves requests, it checks no dependency
+// @Produce  json
+// @Success 200 {object} api.HealthStatus
+// @Router /healthz [get]
+// http "http://localhost:8080/healthz"
+func GetHealth(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	writeJSON(r.Context(), w, &HealthStatus{Status: "ok"})
+}
+
+// GetReadiness is a function to check the server is ready to serve requests
+// @Summary Readiness probe
+// @Tags Health
+// @Description GetReadiness is a handler pinging the database and running the readiness checks, e.g. migrations applied and background workers alive, within a timeout. The outcome of every check is listed in checks.
+// @Produce  json
+// @Success 200 {object} api.HealthStatus
+// @Failure 503 {object} api.HealthStatus "a check failed or timed out"
+// @Router /readyz [get]
+// http "http://localhost:8080/readyz"
+func GetReadiness(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx, cancel := context.WithTimeout(r.Context(), ProbeTimeout)
+	defer cancel()
+
+	checks := append([]*ReadinessCheck{{Name: "database", Check: dao.Ping}}, ReadinessChecks...)
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(checks))
+	for _, check := range checks {
+		go func(check *ReadinessCheck) {
+			results <- result{name: check.Name, err: check.Check(ctx)}
+		}(check)
+	}
+
+	status := &HealthStatus{Status: "ok", Checks: map[string]string{}}
+	for _, check := range checks {
+		status.Checks[check.Name] = "timed out"
+	}
+
+	for range checks {
+		select {
+		case res := <-results:
+			status.Checks[res.name] = "ok"
+			if res.err != nil {
+				status.Checks[res.name] = res.err.Error()
+			}
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	code := http.StatusOK
+	for _, outcome := range status.Checks {
+		if outcome != "ok" {
+			status.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+		}
+	}
+
+	writeJSONStatus(r.Context(), w, code, status)
+}
+
+// GetVersion is a function to get the build information of the server
+// @Summary Build information
+// @Tags Health
+// @Description GetVersion is a handler returning the build date, commit and runtime the server was built with
+// @Produce  json
+// @Success 200 {object} dao.BuildInfo
+// @Router /version [get]
+// http "http://localhost:8080/version"
+func GetVersion(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	buildInfo := dao.AppBuildInfo
+	if buildInfo == nil {
+		buildInfo = &dao.BuildInfo{}
+	}
+	writeJSON(r.Context(), w, buildInfo)
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+// jsonOf returns v written to json
+func jsonOf(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// healthStatus returns the HealthStatus of body
+func healthStatus(t *testing.T, body []byte) *HealthStatus {
+	t.Helper()
+
+	status := &HealthStatus{}
+	if err := json.Unmarshal(body, status); err != nil {
+		t.Fatalf("body %s: %v", body, err)
+	}
+	return status
+}
+
+func TestGetHealth(t *testing.T) {
+	saved := RequestValidator
+	t.Cleanup(func() { RequestValidator = saved })
+	RequestValidator = func(ctx context.Context, r *http.Request, table string, action model.Action) error {
+		return dao.ErrBadParams
+	}
+
+	// the probes answer without request validation
+	w := request(t, http.MethodGet, "/healthz", nil, "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /healthz = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if status := healthStatus(t, w.Body.Bytes()); status.Status != "ok" || status.Checks != nil {
+		t.Errorf("GET /healthz = %+v, want ok without checks", status)
+	}
+}
+
+func TestGetReadiness(t *testing.T) {
+	useTestTables(t)
+	savedChecks, savedTimeout := ReadinessChecks, ProbeTimeout
+	t.Cleanup(func() { ReadinessChecks, ProbeTimeout = savedChecks, savedTimeout })
+	ProbeTimeout = 100 * time.Millisecond
+
+	ok := &ReadinessCheck{Name: "workers", Check: func(ctx context.Context) error { return nil }}
+	failing := &ReadinessCheck{Name: "migrations", Check: func(ctx context.Context) error {
+		return errors.New("1 migrations in ./migrations are not applied")
+	}}
+	hanging := &ReadinessCheck{Name: "cache", Check: func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return ctx.Err()
+	}}
+
+	tests := []struct {
+		name   string
+		checks []*ReadinessCheck
+		code   int
+		status HealthStatus
+	}{
+		{"database only", nil, http.StatusOK, HealthStatus{Status: "ok", Checks: map[string]string{"database": "ok"}}},
+		{"every check ok", []*ReadinessCheck{ok}, http.StatusOK,
+			HealthStatus{Status: "ok", Checks: map[string]string{"database": "ok", "workers": "ok"}}},
+		{"a check failing", []*ReadinessCheck{ok, failing}, http.StatusServiceUnavailable,
+			HealthStatus{Status: "unavailable", Checks: map[string]string{"database": "ok", "workers": "ok",
+				"migrations": "1 migrations in ./migrations are not applied"}}},
+		{"a check running past the timeout", []*ReadinessCheck{ok, hanging}, http.StatusServiceUnavailable,
+			HealthStatus{Status: "unavailable", Checks: map[string]string{"database": "ok", "workers": "ok", "cache": "timed out"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ReadinessChecks = tt.checks
+
+			start := time.Now()
+			w := request(t, http.MethodGet, "/readyz", nil, "")
+			if elapsed := time.Since(start); elapsed > ProbeTimeout+500*time.Millisecond {
+				t.Errorf("GET /readyz took %v, want to answer at the probe timeout %v without waiting for the checks", elapsed, ProbeTimeout)
+			}
+			if w.Code != tt.code {
+				t.Errorf("GET /readyz = %d, want %d", w.Code, tt.code)
+			}
+			if got, want := jsonOf(t, healthStatus(t, w.Body.Bytes())), jsonOf(t, &tt.status); got != want {
+				t.Errorf("GET /readyz = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestGetReadinessDatabaseDown(t *testing.T) {
+	useTestTables(t)
+	savedChecks := ReadinessChecks
+	t.Cleanup(func() { ReadinessChecks = savedChecks })
+	ReadinessChecks = nil
+
+	if err := dao.DB.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	w := request(t, http.MethodGet, "/readyz", nil, "")
+	status := healthStatus(t, w.Body.Bytes())
+	if w.Code != http.StatusServiceUnavailable || status.Status != "unavailable" || status.Checks["database"] == "ok" {
+		t.Errorf("GET /readyz with the database closed = %d %+v, want 503 with the database check failed", w.Code, status)
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	saved := dao.AppBuildInfo
+	t.Cleanup(func() { dao.AppBuildInfo = saved })
+
+	tests := []struct {
+		name      string
+		buildInfo *dao.BuildInfo
+		want      *dao.BuildInfo
+	}{
+		{"no build info", nil, &dao.BuildInfo{}},
+		{"build info", &dao.BuildInfo{LatestCommit: "abc123", BuildNumber: "42"}, &dao.BuildInfo{LatestCommit: "abc123", BuildNumber: "42"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dao.AppBuildInfo = tt.buildInfo
+			w := request(t, http.MethodGet, "/version", nil, "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET /version = %d, want 200", w.Code)
+			}
+			got := &dao.BuildInfo{}
+			if err := json.Unmarshal(w.Body.Bytes(), got); err != nil {
+				t.Fatal(err)
+			}
+			if *got != *tt.want {
+				t.Errorf("GET /version = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -41,6 +41,24 @@ func SendJSON(w http.ResponseWriter, r *http.Request, code int, val interface{})
 	_, _ = w.Write(bytes)
 }
 
+// SendProblem will serialize an RFC 7807 problem details body and return it as application/problem+json with its status
+func SendProblem(w http.ResponseWriter, r *http.Request, problem *HTTPError) {
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.Header().Set("Expires", "0")
+
+	bytes, err := json.Marshal(problem)
+	if err != nil {
+		InternalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+	_, _ = w.Write(bytes)
+}
+
 // InternalServerError will return an error to the client, sending 500 error code to the client with generic string
 func InternalServerError(w http.ResponseWriter, r *http.Request, err error) {
 	w.Header().Set("Cache-Control", "no-cache, no-store")
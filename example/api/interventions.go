@@ -18,29 +18,64 @@ var (
 func configInterventions_Router(router *httprouter.Router) {
 	router.GET("/interventions_", GetAllInterventions_)
 	router.POST("/interventions_", AddInterventions_)
-	router.GET("/interventions_/:argID", GetInterventions_)
+	router.GET("/interventions_/:argID", staticSegment("trash", TrashInterventions_, GetInterventions_))
 	router.PUT("/interventions_/:argID", UpdateInterventions_)
+	router.PATCH("/interventions_/:argID", PatchInterventions_)
+	router.POST("/interventions_/:argID", staticSegment("bulk", BulkInterventions_, nil))
 	router.DELETE("/interventions_/:argID", DeleteInterventions_)
+	router.GET("/interventions_/:argID/history", GetRecordHistory("interventions", "argID"))
+	router.POST("/interventions_/:argID/restore", RestoreInterventions_)
 }
 
 func configGinInterventions_Router(router gin.IRoutes) {
 	router.GET("/interventions_", ConverHttprouterToGin(GetAllInterventions_))
 	router.POST("/interventions_", ConverHttprouterToGin(AddInterventions_))
-	router.GET("/interventions_/:argID", ConverHttprouterToGin(GetInterventions_))
+	router.GET("/interventions_/:argID", ConverHttprouterToGin(staticSegment("trash", TrashInterventions_, GetInterventions_)))
 	router.PUT("/interventions_/:argID", ConverHttprouterToGin(UpdateInterventions_))
+	router.PATCH("/interventions_/:argID", ConverHttprouterToGin(PatchInterventions_))
+	router.POST("/interventions_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkInterventions_, nil)))
 	router.DELETE("/interventions_/:argID", ConverHttprouterToGin(DeleteInterventions_))
+	router.GET("/interventions_/:argID/history", ConverHttprouterToGin(GetRecordHistory("interventions", "argID")))
+	router.POST("/interventions_/:argID/restore", ConverHttprouterToGin(RestoreInterventions_))
 }
 
 // GetAllInterventions_ is a function to get a slice of record(s) from interventions table in the rocket_development database
 // @Summary Get list of Interventions_
 // @Tags Interventions_
-// @Description GetAllInterventions_ is a handler to get a slice of record(s) from interventions table in the rocket_development database
+// @Description GetAllInterventions_ is a handler to get a slice of record(s) from interventions table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: battery, building, column, customer, elevator, employee"
+// @Param   include_deleted query bool false  "return the soft deleted records along with the others (defaults to false)"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   author   query    string  false        "filter author=value or author[op]=value"
+// @Param   customer_id query    int     false        "filter customer_id=value or customer_id[op]=value"
+// @Param   building_id query    int     false        "filter building_id=value or building_id[op]=value"
+// @Param   battery_id query    int     false        "filter battery_id=value or battery_id[op]=value"
+// @Param   column_id query    int     false        "filter column_id=value or column_id[op]=value"
+// @Param   elevator_id query    int     false        "filter elevator_id=value or elevator_id[op]=value"
+// @Param   employee_id query    int     false        "filter employee_id=value or employee_id[op]=value"
+// @Param   start_datetime query    string  false        "filter start_datetime=value or start_datetime[op]=value"
+// @Param   end_datetime query    string  false        "filter end_datetime=value or end_datetime[op]=value"
+// @Param   result   query    string  false        "filter result=value or result[op]=value"
+// @Param   report   query    string  false        "filter report=value or report[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Interventions_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /interventions_ [get]
@@ -59,20 +94,132 @@ func GetAllInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "interventions")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "interventions", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllInterventions_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "interventions", query)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	if notModified(w, r, "interventions", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllInterventions_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
+	writeJSON(ctx, w, result)
+}
+
+// TrashInterventions_ is a function to get a slice of the soft deleted record(s) from interventions table in the rocket_development database
+// @Summary Get list of deleted Interventions_
+// @Tags Interventions_
+// @Description TrashInterventions_ is a handler to get a slice of the soft deleted record(s) from interventions table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: battery, building, column, customer, elevator, employee"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   author   query    string  false        "filter author=value or author[op]=value"
+// @Param   customer_id query    int     false        "filter customer_id=value or customer_id[op]=value"
+// @Param   building_id query    int     false        "filter building_id=value or building_id[op]=value"
+// @Param   battery_id query    int     false        "filter battery_id=value or battery_id[op]=value"
+// @Param   column_id query    int     false        "filter column_id=value or column_id[op]=value"
+// @Param   elevator_id query    int     false        "filter elevator_id=value or elevator_id[op]=value"
+// @Param   employee_id query    int     false        "filter employee_id=value or employee_id[op]=value"
+// @Param   start_datetime query    string  false        "filter start_datetime=value or start_datetime[op]=value"
+// @Param   end_datetime query    string  false        "filter end_datetime=value or end_datetime[op]=value"
+// @Param   result   query    string  false        "filter result=value or result[op]=value"
+// @Param   report   query    string  false        "filter report=value or report[op]=value"
+// @Param   status   query    string  false        "filter status=value or status[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   deleted_at query    string  false        "filter deleted_at=value or deleted_at[op]=value"
+// @Success 200 {object} api.PagedResults{data=[]model.Interventions_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /interventions_/trash [get]
+// http "http://localhost:8080/interventions_/trash?page=0&pagesize=20" X-Api-User:user123
+func TrashInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	query, err := readListQuery(r, "interventions")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	query.Deleted = dao.OnlyDeleted
+
+	if err := ValidateRequest(ctx, r, "interventions", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified, err := listValidators(ctx, r, "interventions", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "interventions", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllInterventions_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +231,14 @@ func GetAllInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: battery, building, column, customer, elevator, employee"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Interventions_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /interventions_/{argID} [get]
@@ -98,18 +252,41 @@ func GetInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 		return
 	}
 
+	fields, err := readFields(r, "interventions")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "interventions")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "interventions", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetInterventions_(ctx, argID)
+	record, err := dao.GetInterventions_(ctx, argID, recordFields("interventions", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "interventions", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddInterventions_ add to add a single record to interventions table in the rocket_development database
@@ -122,8 +299,12 @@ func GetInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 // @Success 200 {object} model.Interventions_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /interventions_ [post]
-// echo '{"id": 65,"author": "KAnYaNnbOsMETHgRorXLarTfL","customer_id": 83,"building_id": 66,"battery_id": 87,"column_id": 66,"elevator_id": 84,"employee_id": 62,"start_datetime": "2078-04-19T19:56:42.25256109-04:00","end_datetime": "2133-01-30T05:31:22.685708736-05:00","result": "OuwZLFcJIuDNEigwnJFvIRXWv","report": "CttuQjQmffNkWpnQFTKCvZrlB","status": "KKypUFNTPhjaHbwMeDeftJCtd","created_at": "2206-06-15T13:57:41.061379409-04:00","updated_at": "2024-09-17T14:03:42.985744518-04:00"}' | http POST "http://localhost:8080/interventions_" X-Api-User:user123
+// echo '{"id": 65,"author": "KAnYaNnbOsMETHgRorXLarTfL","customer_id": 83,"building_id": 66,"battery_id": 87,"column_id": 66,"elevator_id": 84,"employee_id": 62,"start_datetime": "2078-04-19T19:56:42.25256109-04:00","end_datetime": "2133-01-30T05:31:22.685708736-05:00","result": "OuwZLFcJIuDNEigwnJFvIRXWv","report": "CttuQjQmffNkWpnQFTKCvZrlB","status": "KKypUFNTPhjaHbwMeDeftJCtd"}' | http POST "http://localhost:8080/interventions_" X-Api-User:user123
 func AddInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	interventions_ := &model.Interventions_{}
@@ -134,13 +315,14 @@ func AddInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 	}
 
 	if err := interventions_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	interventions_.Prepare()
 
 	if err := interventions_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +343,24 @@ func AddInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Par
 
 // UpdateInterventions_ Update a single record from interventions table in the rocket_development database
 // @Summary Update an record in table interventions
-// @Description Update a single record from interventions table in the rocket_development database
+// @Description Update a single record from interventions table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Interventions_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Interventions_ body model.Interventions_ true "Update Interventions_ record"
 // @Success 200 {object} model.Interventions_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /interventions_/{argID} [put]
-// echo '{"id": 65,"author": "KAnYaNnbOsMETHgRorXLarTfL","customer_id": 83,"building_id": 66,"battery_id": 87,"column_id": 66,"elevator_id": 84,"employee_id": 62,"start_datetime": "2078-04-19T19:56:42.25256109-04:00","end_datetime": "2133-01-30T05:31:22.685708736-05:00","result": "OuwZLFcJIuDNEigwnJFvIRXWv","report": "CttuQjQmffNkWpnQFTKCvZrlB","status": "KKypUFNTPhjaHbwMeDeftJCtd","created_at": "2206-06-15T13:57:41.061379409-04:00","updated_at": "2024-09-17T14:03:42.985744518-04:00"}' | http PUT "http://localhost:8080/interventions_/1"  X-Api-User:user123
+// echo '{"id": 65,"author": "KAnYaNnbOsMETHgRorXLarTfL","customer_id": 83,"building_id": 66,"battery_id": 87,"column_id": 66,"elevator_id": 84,"employee_id": 62,"start_datetime": "2078-04-19T19:56:42.25256109-04:00","end_datetime": "2133-01-30T05:31:22.685708736-05:00","result": "OuwZLFcJIuDNEigwnJFvIRXWv","report": "CttuQjQmffNkWpnQFTKCvZrlB","status": "KKypUFNTPhjaHbwMeDeftJCtd"}' | http PUT "http://localhost:8080/interventions_/1"  X-Api-User:user123
 func UpdateInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +370,12 @@ func UpdateInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	interventions_ := &model.Interventions_{}
 	if err := readJSON(r, interventions_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +383,14 @@ func UpdateInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	}
 
 	if err := interventions_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	interventions_.Prepare()
 
 	if err := interventions_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +410,77 @@ func UpdateInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	writeJSON(ctx, w, interventions_)
 }
 
+// PatchInterventions_ Patch a single record from interventions table in the rocket_development database
+// @Summary Patch an record in table interventions
+// @Description Patch a single record from interventions table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Interventions_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Interventions_ body model.Interventions_ true "fields of Interventions_ to change, null clears a field"
+// @Success 200 {object} model.Interventions_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /interventions_/{argID} [patch]
+// echo '{"author": null}' | http PATCH "http://localhost:8080/interventions_/1"  X-Api-User:user123
+func PatchInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "interventions", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	interventions_, _, err := dao.PatchInterventions_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, interventions_)
+}
+
+// BulkInterventions_ runs create, update and delete operations on records of the interventions table in the rocket_development database
+// @Summary Bulk create, update and delete records of table interventions
+// @Description BulkInterventions_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Interventions_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Interventions_ records, the record of create and update is a Interventions_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /interventions_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 65,"author": "KAnYaNnbOsMETHgRorXLarTfL","customer_id": 83,"building_id": 66,"battery_id": 87,"column_id": 66,"elevator_id": 84,"employee_id": 62,"start_datetime": "2078-04-19T19:56:42.25256109-04:00","end_datetime": "2133-01-30T05:31:22.685708736-05:00","result": "OuwZLFcJIuDNEigwnJFvIRXWv","report": "CttuQjQmffNkWpnQFTKCvZrlB","status": "KKypUFNTPhjaHbwMeDeftJCtd"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/interventions_/bulk" X-Api-User:user123
+func BulkInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "interventions")
+}
+
 // DeleteInterventions_ Delete a single record from interventions table in the rocket_development database
 // @Summary Delete a record from interventions
 // @Description Delete a single record from interventions table in the rocket_development database
@@ -221,9 +488,18 @@ func UpdateInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Interventions_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /interventions_/{argID} [delete]
 // http DELETE "http://localhost:8080/interventions_/1" X-Api-User:user123
 func DeleteInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +511,85 @@ func DeleteInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withCascade(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "interventions", model.Delete); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteInterventions_(ctx, argID)
+	if dryRun {
+		previewDelete(ctx, w, r, "interventions", argID)
+		return
+	}
+
+	if _, err := dao.DeleteInterventions_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreInterventions_ Restore a soft deleted record of the interventions table in the rocket_development database
+// @Summary Restore a deleted record of interventions
+// @Description Restore a soft deleted record of the interventions table in the rocket_development database, deleted_at is cleared
+// @Tags Interventions_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the deleted record, the request fails with 412 when the record changed since"
+// @Success 200 {object} model.Interventions_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /interventions_/{argID}/restore [post]
+// http POST "http://localhost:8080/interventions_/1/restore" X-Api-User:user123
+func RestoreInterventions_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "interventions", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, _, err := dao.RestoreInterventions_(ctx, argID)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	writeJSON(ctx, w, record)
 }
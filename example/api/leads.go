@@ -20,7 +20,10 @@ func configLeadsRouter(router *httprouter.Router) {
 	router.POST("/leads", AddLeads)
 	router.GET("/leads/:argID", GetLeads)
 	router.PUT("/leads/:argID", UpdateLeads)
+	router.PATCH("/leads/:argID", PatchLeads)
+	router.POST("/leads/:argID", staticSegment("bulk", BulkLeads, nil))
 	router.DELETE("/leads/:argID", DeleteLeads)
+	router.GET("/leads/:argID/history", GetRecordHistory("leads", "argID"))
 }
 
 func configGinLeadsRouter(router gin.IRoutes) {
@@ -28,19 +31,44 @@ func configGinLeadsRouter(router gin.IRoutes) {
 	router.POST("/leads", ConverHttprouterToGin(AddLeads))
 	router.GET("/leads/:argID", ConverHttprouterToGin(GetLeads))
 	router.PUT("/leads/:argID", ConverHttprouterToGin(UpdateLeads))
+	router.PATCH("/leads/:argID", ConverHttprouterToGin(PatchLeads))
+	router.POST("/leads/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkLeads, nil)))
 	router.DELETE("/leads/:argID", ConverHttprouterToGin(DeleteLeads))
+	router.GET("/leads/:argID/history", ConverHttprouterToGin(GetRecordHistory("leads", "argID")))
 }
 
 // GetAllLeads is a function to get a slice of record(s) from leads table in the rocket_development database
 // @Summary Get list of Leads
 // @Tags Leads
-// @Description GetAllLeads is a handler to get a slice of record(s) from leads table in the rocket_development database
+// @Description GetAllLeads is a handler to get a slice of record(s) from leads table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   full_name_of_the_contact query    string  false        "filter full_name_of_the_contact=value or full_name_of_the_contact[op]=value"
+// @Param   bussiness_name query    string  false        "filter bussiness_name=value or bussiness_name[op]=value"
+// @Param   email    query    string  false        "filter email=value or email[op]=value"
+// @Param   phone    query    string  false        "filter phone=value or phone[op]=value"
+// @Param   project_name query    string  false        "filter project_name=value or project_name[op]=value"
+// @Param   project_description query    string  false        "filter project_description=value or project_description[op]=value"
+// @Param   department_incharge query    string  false        "filter department_incharge=value or department_incharge[op]=value"
+// @Param   message  query    string  false        "filter message=value or message[op]=value"
+// @Param   attached_file query    string  false        "filter attached_file=value or attached_file[op]=value"
+// @Param   creation_date query    string  false        "filter creation_date=value or creation_date[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Leads}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /leads [get]
@@ -59,20 +87,40 @@ func GetAllLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "leads")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "leads", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllLeads(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "leads", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "leads", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllLeads(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +132,13 @@ func GetAllLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Leads
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /leads/{argID} [get]
@@ -98,18 +152,41 @@ func GetLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	fields, err := readFields(r, "leads")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "leads")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "leads", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetLeads(ctx, argID)
+	record, err := dao.GetLeads(ctx, argID, recordFields("leads", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "leads", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddLeads add to add a single record to leads table in the rocket_development database
@@ -122,8 +199,12 @@ func GetLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Success 200 {object} model.Leads
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /leads [post]
-// echo '{"id": 34,"full_name_of_the_contact": "VubQUclMrYnJdXEjigwVJYJpb","bussiness_name": "kmehEWaecfYpTnxbqsyjLgiPZ","email": "xIPxNLpBMEVJIYqwDvpYMsmAY","phone": "gJeiQZmqPfBfEvdORqmxAFZoS","project_name": "EnDHNvXkkfSELooLmqqwekxEX","project_description": "XfwcNpnoWSfZLLDIGWGFemTHx","department_incharge": "iZFyDVbwMIclhilMytscpMhyL","message": "srltjuVoYobsrQLNZmGVncWOw","attached_file": "GklaMxxFVQYvJz5QGyhgBEBaBhljMQUZOmIAJ15VH0ADPDxTGQpiXx8sCh8tXjo8KTI7Y0QrBz5hPUBjLT5jIFgMHg==","creation_date": "2314-02-22T11:24:02.085806613-05:00","created_at": "2187-09-09T23:29:36.135583678-04:00","updated_at": "2095-03-26T09:01:43.425372385-04:00"}' | http POST "http://localhost:8080/leads" X-Api-User:user123
+// echo '{"id": 34,"full_name_of_the_contact": "VubQUclMrYnJdXEjigwVJYJpb","bussiness_name": "kmehEWaecfYpTnxbqsyjLgiPZ","email": "xIPxNLpBMEVJIYqwDvpYMsmAY","phone": "gJeiQZmqPfBfEvdORqmxAFZoS","project_name": "EnDHNvXkkfSELooLmqqwekxEX","project_description": "XfwcNpnoWSfZLLDIGWGFemTHx","department_incharge": "iZFyDVbwMIclhilMytscpMhyL","message": "srltjuVoYobsrQLNZmGVncWOw","attached_file": "GklaMxxFVQYvJz5QGyhgBEBaBhljMQUZOmIAJ15VH0ADPDxTGQpiXx8sCh8tXjo8KTI7Y0QrBz5hPUBjLT5jIFgMHg==","creation_date": "2314-02-22T11:24:02.085806613-05:00"}' | http POST "http://localhost:8080/leads" X-Api-User:user123
 func AddLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	leads := &model.Leads{}
@@ -134,13 +215,14 @@ func AddLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := leads.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	leads.Prepare()
 
 	if err := leads.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +243,24 @@ func AddLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateLeads Update a single record from leads table in the rocket_development database
 // @Summary Update an record in table leads
-// @Description Update a single record from leads table in the rocket_development database
+// @Description Update a single record from leads table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Leads
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Leads body model.Leads true "Update Leads record"
 // @Success 200 {object} model.Leads
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /leads/{argID} [put]
-// echo '{"id": 34,"full_name_of_the_contact": "VubQUclMrYnJdXEjigwVJYJpb","bussiness_name": "kmehEWaecfYpTnxbqsyjLgiPZ","email": "xIPxNLpBMEVJIYqwDvpYMsmAY","phone": "gJeiQZmqPfBfEvdORqmxAFZoS","project_name": "EnDHNvXkkfSELooLmqqwekxEX","project_description": "XfwcNpnoWSfZLLDIGWGFemTHx","department_incharge": "iZFyDVbwMIclhilMytscpMhyL","message": "srltjuVoYobsrQLNZmGVncWOw","attached_file": "GklaMxxFVQYvJz5QGyhgBEBaBhljMQUZOmIAJ15VH0ADPDxTGQpiXx8sCh8tXjo8KTI7Y0QrBz5hPUBjLT5jIFgMHg==","creation_date": "2314-02-22T11:24:02.085806613-05:00","created_at": "2187-09-09T23:29:36.135583678-04:00","updated_at": "2095-03-26T09:01:43.425372385-04:00"}' | http PUT "http://localhost:8080/leads/1"  X-Api-User:user123
+// echo '{"id": 34,"full_name_of_the_contact": "VubQUclMrYnJdXEjigwVJYJpb","bussiness_name": "kmehEWaecfYpTnxbqsyjLgiPZ","email": "xIPxNLpBMEVJIYqwDvpYMsmAY","phone": "gJeiQZmqPfBfEvdORqmxAFZoS","project_name": "EnDHNvXkkfSELooLmqqwekxEX","project_description": "XfwcNpnoWSfZLLDIGWGFemTHx","department_incharge": "iZFyDVbwMIclhilMytscpMhyL","message": "srltjuVoYobsrQLNZmGVncWOw","attached_file": "GklaMxxFVQYvJz5QGyhgBEBaBhljMQUZOmIAJ15VH0ADPDxTGQpiXx8sCh8tXjo8KTI7Y0QrBz5hPUBjLT5jIFgMHg==","creation_date": "2314-02-22T11:24:02.085806613-05:00"}' | http PUT "http://localhost:8080/leads/1"  X-Api-User:user123
 func UpdateLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +270,12 @@ func UpdateLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	leads := &model.Leads{}
 	if err := readJSON(r, leads); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +283,14 @@ func UpdateLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := leads.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	leads.Prepare()
 
 	if err := leads.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +310,77 @@ func UpdateLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	writeJSON(ctx, w, leads)
 }
 
+// PatchLeads Patch a single record from leads table in the rocket_development database
+// @Summary Patch an record in table leads
+// @Description Patch a single record from leads table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Leads
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Leads body model.Leads true "fields of Leads to change, null clears a field"
+// @Success 200 {object} model.Leads
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /leads/{argID} [patch]
+// echo '{"full_name_of_the_contact": null}' | http PATCH "http://localhost:8080/leads/1"  X-Api-User:user123
+func PatchLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "leads", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	leads, _, err := dao.PatchLeads(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, leads)
+}
+
+// BulkLeads runs create, update and delete operations on records of the leads table in the rocket_development database
+// @Summary Bulk create, update and delete records of table leads
+// @Description BulkLeads runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Leads
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Leads records, the record of create and update is a Leads"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /leads/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 34,"full_name_of_the_contact": "VubQUclMrYnJdXEjigwVJYJpb","bussiness_name": "kmehEWaecfYpTnxbqsyjLgiPZ","email": "xIPxNLpBMEVJIYqwDvpYMsmAY","phone": "gJeiQZmqPfBfEvdORqmxAFZoS","project_name": "EnDHNvXkkfSELooLmqqwekxEX","project_description": "XfwcNpnoWSfZLLDIGWGFemTHx","department_incharge": "iZFyDVbwMIclhilMytscpMhyL","message": "srltjuVoYobsrQLNZmGVncWOw","attached_file": "GklaMxxFVQYvJz5QGyhgBEBaBhljMQUZOmIAJ15VH0ADPDxTGQpiXx8sCh8tXjo8KTI7Y0QrBz5hPUBjLT5jIFgMHg==","creation_date": "2314-02-22T11:24:02.085806613-05:00"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/leads/bulk" X-Api-User:user123
+func BulkLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "leads")
+}
+
 // DeleteLeads Delete a single record from leads table in the rocket_development database
 // @Summary Delete a record from leads
 // @Description Delete a single record from leads table in the rocket_development database
@@ -221,9 +388,18 @@ func UpdateLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Leads
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /leads/{argID} [delete]
 // http DELETE "http://localhost:8080/leads/1" X-Api-User:user123
 func DeleteLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +411,38 @@ func DeleteLeads(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "leads", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteLeads(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "leads", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "leads", argID)
+		return
+	}
+
+	if _, err := dao.DeleteLeads(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+// listParams query parameters of the GetAll endpoints that are not column filters
+var listParams = map[string]bool{
+	"page":            true,
+	"pagesize":        true,
+	"sort":            true,
+	"cursor":          true,
+	"count":           true,
+	"fields":          true,
+	"q":               true,
+	"include":         true,
+	"include_deleted": true,
+}
+
+// readListQuery parses the sort, filters, cursor, count, fields, search, include and include_deleted parameters of a
+// GetAll request
+func readListQuery(r *http.Request, table string) (*dao.ListQuery, error) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok {
+		return nil, dao.ErrNotFound
+	}
+
+	query := &dao.ListQuery{}
+
+	var err error
+	if query.Sort, err = dao.ParseSort(tableInfo, r.URL.Query().Get("sort")); err != nil {
+		return nil, err
+	}
+
+	if query.Filters, err = readFilters(r, tableInfo); err != nil {
+		return nil, err
+	}
+
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		if r.URL.Query().Get("page") != "" {
+			return nil, dao.ErrBadParams
+		}
+		if query.Cursor, err = dao.ParseCursor(query.Sort, cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.Count, err = readBool(r, "count", true); err != nil {
+		return nil, dao.ErrBadParams
+	}
+
+	if query.Fields, err = dao.ParseFields(tableInfo, r.URL.Query().Get("fields")); err != nil {
+		return nil, err
+	}
+
+	if q := r.URL.Query().Get("q"); q != "" {
+		if query.Search, err = dao.ParseSearch(tableInfo, q); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.Include, err = dao.ParseInclude(tableInfo, r.URL.Query().Get("include")); err != nil {
+		return nil, err
+	}
+
+	includeDeleted, err := readBool(r, "include_deleted", false)
+	if err != nil {
+		return nil, dao.ErrBadParams
+	}
+	if includeDeleted {
+		if !tableInfo.SoftDelete() {
+			return nil, fmt.Errorf("%w: table %s has no soft delete", dao.ErrBadParams, table)
+		}
+		query.Deleted = dao.IncludeDeleted
+	}
+
+	return query, nil
+}
+
+// readFilters parses the column filters of a GetAll request, every query parameter other than the listParams is a
+// filter written as column=value or column[op]=value, e.g. status=Inactive&column_id[in]=12,13.
+func readFilters(r *http.Request, tableInfo *model.TableInfo) ([]*dao.Filter, error) {
+	query := r.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if !listParams[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var filters []*dao.Filter
+	for _, key := range keys {
+		name, op := key, "eq"
+		if i := strings.IndexByte(key, '['); i > 0 && strings.HasSuffix(key, "]") {
+			name, op = key[:i], key[i+1:len(key)-1]
+		}
+
+		for _, value := range query[key] {
+			filter, err := dao.ParseFilter(tableInfo, name, op, value)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters, nil
+}
+
+// readFields parses the fields parameter of a Get request, e.g. fields=id,status
+func readFields(r *http.Request, table string) ([]*model.ColumnInfo, error) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok {
+		return nil, dao.ErrNotFound
+	}
+
+	return dao.ParseFields(tableInfo, r.URL.Query().Get("fields"))
+}
+
+// readInclude parses the include parameter of a Get request, e.g. include=customer,address
+func readInclude(r *http.Request, table string) ([]*model.Relation, error) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok {
+		return nil, dao.ErrNotFound
+	}
+
+	return dao.ParseInclude(tableInfo, r.URL.Query().Get("include"))
+}
+
+// renderRecords reduces the json of a record, or of a slice of records, to the json fields of fields and adds the
+// related records of relations under their relation name. v is returned unchanged when both are empty.
+func renderRecords(ctx context.Context, r *http.Request, v interface{}, fields []*model.ColumnInfo, relations []*model.Relation) (interface{}, error) {
+	if len(fields) == 0 && len(relations) == 0 {
+		return v, nil
+	}
+
+	for _, relation := range relations {
+		if err := ValidateRequest(ctx, r, relation.Table.Name, model.RetrieveMany); err != nil {
+			return nil, err
+		}
+	}
+
+	related, err := dao.LoadRelations(ctx, v, relations)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err = decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	render := func(record interface{}, related map[string]interface{}) interface{} {
+		values, ok := record.(map[string]interface{})
+		if !ok {
+			return record
+		}
+		if len(fields) > 0 {
+			projected := make(map[string]interface{}, len(fields)+len(related))
+			for _, field := range fields {
+				if value, ok := values[field.JSONFieldName]; ok {
+					projected[field.JSONFieldName] = value
+				}
+			}
+			values = projected
+		}
+		for name, value := range related {
+			values[name] = value
+		}
+		return values
+	}
+
+	if records, ok := decoded.([]interface{}); ok {
+		for i, record := range records {
+			records[i] = render(record, related[i])
+		}
+		return records, nil
+	}
+	return render(decoded, related[0]), nil
+}
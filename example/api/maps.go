@@ -20,7 +20,10 @@ func configMaps_Router(router *httprouter.Router) {
 	router.POST("/maps_", AddMaps_)
 	router.GET("/maps_/:argID", GetMaps_)
 	router.PUT("/maps_/:argID", UpdateMaps_)
+	router.PATCH("/maps_/:argID", PatchMaps_)
+	router.POST("/maps_/:argID", staticSegment("bulk", BulkMaps_, nil))
 	router.DELETE("/maps_/:argID", DeleteMaps_)
+	router.GET("/maps_/:argID/history", GetRecordHistory("maps", "argID"))
 }
 
 func configGinMaps_Router(router gin.IRoutes) {
@@ -28,19 +31,33 @@ func configGinMaps_Router(router gin.IRoutes) {
 	router.POST("/maps_", ConverHttprouterToGin(AddMaps_))
 	router.GET("/maps_/:argID", ConverHttprouterToGin(GetMaps_))
 	router.PUT("/maps_/:argID", ConverHttprouterToGin(UpdateMaps_))
+	router.PATCH("/maps_/:argID", ConverHttprouterToGin(PatchMaps_))
+	router.POST("/maps_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkMaps_, nil)))
 	router.DELETE("/maps_/:argID", ConverHttprouterToGin(DeleteMaps_))
+	router.GET("/maps_/:argID/history", ConverHttprouterToGin(GetRecordHistory("maps", "argID")))
 }
 
 // GetAllMaps_ is a function to get a slice of record(s) from maps table in the rocket_development database
 // @Summary Get list of Maps_
 // @Tags Maps_
-// @Description GetAllMaps_ is a handler to get a slice of record(s) from maps table in the rocket_development database
+// @Description GetAllMaps_ is a handler to get a slice of record(s) from maps table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Maps_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /maps_ [get]
@@ -59,20 +76,40 @@ func GetAllMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "maps")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "maps", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllMaps_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "maps", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "maps", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllMaps_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +121,13 @@ func GetAllMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Maps_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /maps_/{argID} [get]
@@ -98,18 +141,41 @@ func GetMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	fields, err := readFields(r, "maps")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "maps")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "maps", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetMaps_(ctx, argID)
+	record, err := dao.GetMaps_(ctx, argID, recordFields("maps", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "maps", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddMaps_ add to add a single record to maps table in the rocket_development database
@@ -122,8 +188,12 @@ func GetMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Success 200 {object} model.Maps_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /maps_ [post]
-// echo '{"id": 28,"created_at": "2092-07-09T18:33:23.327500336-04:00","updated_at": "2092-07-20T02:13:53.355945152-04:00"}' | http POST "http://localhost:8080/maps_" X-Api-User:user123
+// echo '{"id": 28}' | http POST "http://localhost:8080/maps_" X-Api-User:user123
 func AddMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	maps_ := &model.Maps_{}
@@ -134,13 +204,14 @@ func AddMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := maps_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	maps_.Prepare()
 
 	if err := maps_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +232,24 @@ func AddMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateMaps_ Update a single record from maps table in the rocket_development database
 // @Summary Update an record in table maps
-// @Description Update a single record from maps table in the rocket_development database
+// @Description Update a single record from maps table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Maps_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Maps_ body model.Maps_ true "Update Maps_ record"
 // @Success 200 {object} model.Maps_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /maps_/{argID} [put]
-// echo '{"id": 28,"created_at": "2092-07-09T18:33:23.327500336-04:00","updated_at": "2092-07-20T02:13:53.355945152-04:00"}' | http PUT "http://localhost:8080/maps_/1"  X-Api-User:user123
+// echo '{"id": 28}' | http PUT "http://localhost:8080/maps_/1"  X-Api-User:user123
 func UpdateMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +259,12 @@ func UpdateMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	maps_ := &model.Maps_{}
 	if err := readJSON(r, maps_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +272,14 @@ func UpdateMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := maps_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	maps_.Prepare()
 
 	if err := maps_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +299,77 @@ func UpdateMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	writeJSON(ctx, w, maps_)
 }
 
+// PatchMaps_ Patch a single record from maps table in the rocket_development database
+// @Summary Patch an record in table maps
+// @Description Patch a single record from maps table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Maps_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Maps_ body model.Maps_ true "fields of Maps_ to change, null clears a field"
+// @Success 200 {object} model.Maps_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /maps_/{argID} [patch]
+// echo '{}' | http PATCH "http://localhost:8080/maps_/1"  X-Api-User:user123
+func PatchMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "maps", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	maps_, _, err := dao.PatchMaps_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, maps_)
+}
+
+// BulkMaps_ runs create, update and delete operations on records of the maps table in the rocket_development database
+// @Summary Bulk create, update and delete records of table maps
+// @Description BulkMaps_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Maps_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Maps_ records, the record of create and update is a Maps_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /maps_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 28}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/maps_/bulk" X-Api-User:user123
+func BulkMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "maps")
+}
+
 // DeleteMaps_ Delete a single record from maps table in the rocket_development database
 // @Summary Delete a record from maps
 // @Description Delete a single record from maps table in the rocket_development database
@@ -221,9 +377,18 @@ func UpdateMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Maps_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /maps_/{argID} [delete]
 // http DELETE "http://localhost:8080/maps_/1" X-Api-User:user123
 func DeleteMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +400,38 @@ func DeleteMaps_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "maps", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteMaps_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "maps", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "maps", argID)
+		return
+	}
+
+	if _, err := dao.DeleteMaps_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -20,7 +20,10 @@ func configQuotesRouter(router *httprouter.Router) {
 	router.POST("/quotes", AddQuotes)
 	router.GET("/quotes/:argID", GetQuotes)
 	router.PUT("/quotes/:argID", UpdateQuotes)
+	router.PATCH("/quotes/:argID", PatchQuotes)
+	router.POST("/quotes/:argID", staticSegment("bulk", BulkQuotes, nil))
 	router.DELETE("/quotes/:argID", DeleteQuotes)
+	router.GET("/quotes/:argID/history", GetRecordHistory("quotes", "argID"))
 }
 
 func configGinQuotesRouter(router gin.IRoutes) {
@@ -28,19 +31,56 @@ func configGinQuotesRouter(router gin.IRoutes) {
 	router.POST("/quotes", ConverHttprouterToGin(AddQuotes))
 	router.GET("/quotes/:argID", ConverHttprouterToGin(GetQuotes))
 	router.PUT("/quotes/:argID", ConverHttprouterToGin(UpdateQuotes))
+	router.PATCH("/quotes/:argID", ConverHttprouterToGin(PatchQuotes))
+	router.POST("/quotes/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkQuotes, nil)))
 	router.DELETE("/quotes/:argID", ConverHttprouterToGin(DeleteQuotes))
+	router.GET("/quotes/:argID/history", ConverHttprouterToGin(GetRecordHistory("quotes", "argID")))
 }
 
 // GetAllQuotes is a function to get a slice of record(s) from quotes table in the rocket_development database
 // @Summary Get list of Quotes
 // @Tags Quotes
-// @Description GetAllQuotes is a handler to get a slice of record(s) from quotes table in the rocket_development database
+// @Description GetAllQuotes is a handler to get a slice of record(s) from quotes table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   building_type query    string  false        "filter building_type=value or building_type[op]=value"
+// @Param   service_quality query    string  false        "filter service_quality=value or service_quality[op]=value"
+// @Param   number_of_apartments query    string  false        "filter number_of_apartments=value or number_of_apartments[op]=value"
+// @Param   number_of_floors query    string  false        "filter number_of_floors=value or number_of_floors[op]=value"
+// @Param   number_of_businesses query    string  false        "filter number_of_businesses=value or number_of_businesses[op]=value"
+// @Param   number_of_basements query    string  false        "filter number_of_basements=value or number_of_basements[op]=value"
+// @Param   number_of_parking query    string  false        "filter number_of_parking=value or number_of_parking[op]=value"
+// @Param   number_of_cages query    string  false        "filter number_of_cages=value or number_of_cages[op]=value"
+// @Param   number_of_occupants query    string  false        "filter number_of_occupants=value or number_of_occupants[op]=value"
+// @Param   number_of_hours query    string  false        "filter number_of_hours=value or number_of_hours[op]=value"
+// @Param   number_of_elevators_needed query    string  false        "filter number_of_elevators_needed=value or number_of_elevators_needed[op]=value"
+// @Param   price_per_unit query    string  false        "filter price_per_unit=value or price_per_unit[op]=value"
+// @Param   elevator_price query    string  false        "filter elevator_price=value or elevator_price[op]=value"
+// @Param   installation_fee query    string  false        "filter installation_fee=value or installation_fee[op]=value"
+// @Param   final_price query    string  false        "filter final_price=value or final_price[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
+// @Param   name     query    string  false        "filter name=value or name[op]=value"
+// @Param   company_name query    string  false        "filter company_name=value or company_name[op]=value"
+// @Param   email    query    string  false        "filter email=value or email[op]=value"
+// @Param   phone    query    string  false        "filter phone=value or phone[op]=value"
+// @Param   department query    string  false        "filter department=value or department[op]=value"
+// @Param   project_name query    string  false        "filter project_name=value or project_name[op]=value"
+// @Param   project_description query    string  false        "filter project_description=value or project_description[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Quotes}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /quotes [get]
@@ -59,20 +99,40 @@ func GetAllQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "quotes")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "quotes", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllQuotes(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "quotes", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "quotes", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllQuotes(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +144,13 @@ func GetAllQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Quotes
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /quotes/{argID} [get]
@@ -98,18 +164,41 @@ func GetQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	fields, err := readFields(r, "quotes")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "quotes")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "quotes", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetQuotes(ctx, argID)
+	record, err := dao.GetQuotes(ctx, argID, recordFields("quotes", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "quotes", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddQuotes add to add a single record to quotes table in the rocket_development database
@@ -122,8 +211,12 @@ func GetQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Success 200 {object} model.Quotes
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /quotes [post]
-// echo '{"id": 79,"building_type": "ESAooBBcJNoyvQbDlvusUAUPo","service_quality": "jseOEGuRmPxLPFPJiULjtTJuB","number_of_apartments": "PsiYDnwUSVrbXYQlohUWDJvFd","number_of_floors": "tNSTNoaeoKxLmrSPYpKeGUabE","number_of_businesses": "tTVSoEfbYUAhqEVpCFZDjsNSd","number_of_basements": "OYGSXyPqXXjMVIDKfhMuaOfsF","number_of_parking": "cOtjYsUhlHdvRFlBUcJsRhktT","number_of_cages": "xivkgMhaeIQiIDVCRKSkKCstE","number_of_occupants": "CGLnLHjSIsGAnCnQQmrsolFpv","number_of_hours": "SfyUQjILLYAfqiPAUdGRnunrN","number_of_elevators_needed": "BlTAFebldTIBrGGLncPgVvgRN","price_per_unit": "VFtaNlqYrmoXPDIbxuYsrxDsq","elevator_price": "xedKEPGUTwClAijhJpKNolRnd","installation_fee": "KLIRnievMyKKjFCNWCcHcYIbY","final_price": "RAHntpWhokjnOeLSMgRHVLWRA","created_at": "2163-08-02T09:01:32.631839129-04:00","updated_at": "2107-10-22T00:57:07.232250514-04:00","name": "xWkkeZwWulukOLhqktxrqIqBc","company_name": "ZNQWWWDQxfUHmWSOKPvsaqxCV","email": "evXiBlPWXCPWDhnoLpYZRtMOW","phone": "oPcNhXCPirwSeUxhYtHEPhWNA","department": "JbjWtyBeeGRlYWVnkHtZZrQja","project_name": "yvHZFQnYFXHAyLAKpvHGHOnvO","project_description": "PLmmpUCsrXIsWnUFCLRsywZxG"}' | http POST "http://localhost:8080/quotes" X-Api-User:user123
+// echo '{"id": 79,"building_type": "ESAooBBcJNoyvQbDlvusUAUPo","service_quality": "jseOEGuRmPxLPFPJiULjtTJuB","number_of_apartments": "PsiYDnwUSVrbXYQlohUWDJvFd","number_of_floors": "tNSTNoaeoKxLmrSPYpKeGUabE","number_of_businesses": "tTVSoEfbYUAhqEVpCFZDjsNSd","number_of_basements": "OYGSXyPqXXjMVIDKfhMuaOfsF","number_of_parking": "cOtjYsUhlHdvRFlBUcJsRhktT","number_of_cages": "xivkgMhaeIQiIDVCRKSkKCstE","number_of_occupants": "CGLnLHjSIsGAnCnQQmrsolFpv","number_of_hours": "SfyUQjILLYAfqiPAUdGRnunrN","number_of_elevators_needed": "BlTAFebldTIBrGGLncPgVvgRN","price_per_unit": "VFtaNlqYrmoXPDIbxuYsrxDsq","elevator_price": "xedKEPGUTwClAijhJpKNolRnd","installation_fee": "KLIRnievMyKKjFCNWCcHcYIbY","final_price": "RAHntpWhokjnOeLSMgRHVLWRA","name": "xWkkeZwWulukOLhqktxrqIqBc","company_name": "ZNQWWWDQxfUHmWSOKPvsaqxCV","email": "evXiBlPWXCPWDhnoLpYZRtMOW","phone": "oPcNhXCPirwSeUxhYtHEPhWNA","department": "JbjWtyBeeGRlYWVnkHtZZrQja","project_name": "yvHZFQnYFXHAyLAKpvHGHOnvO","project_description": "PLmmpUCsrXIsWnUFCLRsywZxG"}' | http POST "http://localhost:8080/quotes" X-Api-User:user123
 func AddQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	quotes := &model.Quotes{}
@@ -134,13 +227,14 @@ func AddQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := quotes.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	quotes.Prepare()
 
 	if err := quotes.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +255,24 @@ func AddQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateQuotes Update a single record from quotes table in the rocket_development database
 // @Summary Update an record in table quotes
-// @Description Update a single record from quotes table in the rocket_development database
+// @Description Update a single record from quotes table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Quotes
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Quotes body model.Quotes true "Update Quotes record"
 // @Success 200 {object} model.Quotes
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /quotes/{argID} [put]
-// echo '{"id": 79,"building_type": "ESAooBBcJNoyvQbDlvusUAUPo","service_quality": "jseOEGuRmPxLPFPJiULjtTJuB","number_of_apartments": "PsiYDnwUSVrbXYQlohUWDJvFd","number_of_floors": "tNSTNoaeoKxLmrSPYpKeGUabE","number_of_businesses": "tTVSoEfbYUAhqEVpCFZDjsNSd","number_of_basements": "OYGSXyPqXXjMVIDKfhMuaOfsF","number_of_parking": "cOtjYsUhlHdvRFlBUcJsRhktT","number_of_cages": "xivkgMhaeIQiIDVCRKSkKCstE","number_of_occupants": "CGLnLHjSIsGAnCnQQmrsolFpv","number_of_hours": "SfyUQjILLYAfqiPAUdGRnunrN","number_of_elevators_needed": "BlTAFebldTIBrGGLncPgVvgRN","price_per_unit": "VFtaNlqYrmoXPDIbxuYsrxDsq","elevator_price": "xedKEPGUTwClAijhJpKNolRnd","installation_fee": "KLIRnievMyKKjFCNWCcHcYIbY","final_price": "RAHntpWhokjnOeLSMgRHVLWRA","created_at": "2163-08-02T09:01:32.631839129-04:00","updated_at": "2107-10-22T00:57:07.232250514-04:00","name": "xWkkeZwWulukOLhqktxrqIqBc","company_name": "ZNQWWWDQxfUHmWSOKPvsaqxCV","email": "evXiBlPWXCPWDhnoLpYZRtMOW","phone": "oPcNhXCPirwSeUxhYtHEPhWNA","department": "JbjWtyBeeGRlYWVnkHtZZrQja","project_name": "yvHZFQnYFXHAyLAKpvHGHOnvO","project_description": "PLmmpUCsrXIsWnUFCLRsywZxG"}' | http PUT "http://localhost:8080/quotes/1"  X-Api-User:user123
+// echo '{"id": 79,"building_type": "ESAooBBcJNoyvQbDlvusUAUPo","service_quality": "jseOEGuRmPxLPFPJiULjtTJuB","number_of_apartments": "PsiYDnwUSVrbXYQlohUWDJvFd","number_of_floors": "tNSTNoaeoKxLmrSPYpKeGUabE","number_of_businesses": "tTVSoEfbYUAhqEVpCFZDjsNSd","number_of_basements": "OYGSXyPqXXjMVIDKfhMuaOfsF","number_of_parking": "cOtjYsUhlHdvRFlBUcJsRhktT","number_of_cages": "xivkgMhaeIQiIDVCRKSkKCstE","number_of_occupants": "CGLnLHjSIsGAnCnQQmrsolFpv","number_of_hours": "SfyUQjILLYAfqiPAUdGRnunrN","number_of_elevators_needed": "BlTAFebldTIBrGGLncPgVvgRN","price_per_unit": "VFtaNlqYrmoXPDIbxuYsrxDsq","elevator_price": "xedKEPGUTwClAijhJpKNolRnd","installation_fee": "KLIRnievMyKKjFCNWCcHcYIbY","final_price": "RAHntpWhokjnOeLSMgRHVLWRA","name": "xWkkeZwWulukOLhqktxrqIqBc","company_name": "ZNQWWWDQxfUHmWSOKPvsaqxCV","email": "evXiBlPWXCPWDhnoLpYZRtMOW","phone": "oPcNhXCPirwSeUxhYtHEPhWNA","department": "JbjWtyBeeGRlYWVnkHtZZrQja","project_name": "yvHZFQnYFXHAyLAKpvHGHOnvO","project_description": "PLmmpUCsrXIsWnUFCLRsywZxG"}' | http PUT "http://localhost:8080/quotes/1"  X-Api-User:user123
 func UpdateQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +282,12 @@ func UpdateQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	quotes := &model.Quotes{}
 	if err := readJSON(r, quotes); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +295,14 @@ func UpdateQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := quotes.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	quotes.Prepare()
 
 	if err := quotes.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +322,77 @@ func UpdateQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	writeJSON(ctx, w, quotes)
 }
 
+// PatchQuotes Patch a single record from quotes table in the rocket_development database
+// @Summary Patch an record in table quotes
+// @Description Patch a single record from quotes table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Quotes
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Quotes body model.Quotes true "fields of Quotes to change, null clears a field"
+// @Success 200 {object} model.Quotes
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /quotes/{argID} [patch]
+// echo '{"building_type": null}' | http PATCH "http://localhost:8080/quotes/1"  X-Api-User:user123
+func PatchQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "quotes", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	quotes, _, err := dao.PatchQuotes(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, quotes)
+}
+
+// BulkQuotes runs create, update and delete operations on records of the quotes table in the rocket_development database
+// @Summary Bulk create, update and delete records of table quotes
+// @Description BulkQuotes runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Quotes
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Quotes records, the record of create and update is a Quotes"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /quotes/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 79,"building_type": "ESAooBBcJNoyvQbDlvusUAUPo","service_quality": "jseOEGuRmPxLPFPJiULjtTJuB","number_of_apartments": "PsiYDnwUSVrbXYQlohUWDJvFd","number_of_floors": "tNSTNoaeoKxLmrSPYpKeGUabE","number_of_businesses": "tTVSoEfbYUAhqEVpCFZDjsNSd","number_of_basements": "OYGSXyPqXXjMVIDKfhMuaOfsF","number_of_parking": "cOtjYsUhlHdvRFlBUcJsRhktT","number_of_cages": "xivkgMhaeIQiIDVCRKSkKCstE","number_of_occupants": "CGLnLHjSIsGAnCnQQmrsolFpv","number_of_hours": "SfyUQjILLYAfqiPAUdGRnunrN","number_of_elevators_needed": "BlTAFebldTIBrGGLncPgVvgRN","price_per_unit": "VFtaNlqYrmoXPDIbxuYsrxDsq","elevator_price": "xedKEPGUTwClAijhJpKNolRnd","installation_fee": "KLIRnievMyKKjFCNWCcHcYIbY","final_price": "RAHntpWhokjnOeLSMgRHVLWRA","name": "xWkkeZwWulukOLhqktxrqIqBc","company_name": "ZNQWWWDQxfUHmWSOKPvsaqxCV","email": "evXiBlPWXCPWDhnoLpYZRtMOW","phone": "oPcNhXCPirwSeUxhYtHEPhWNA","department": "JbjWtyBeeGRlYWVnkHtZZrQja","project_name": "yvHZFQnYFXHAyLAKpvHGHOnvO","project_description": "PLmmpUCsrXIsWnUFCLRsywZxG"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/quotes/bulk" X-Api-User:user123
+func BulkQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "quotes")
+}
+
 // DeleteQuotes Delete a single record from quotes table in the rocket_development database
 // @Summary Delete a record from quotes
 // @Description Delete a single record from quotes table in the rocket_development database
@@ -221,9 +400,18 @@ func UpdateQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Quotes
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /quotes/{argID} [delete]
 // http DELETE "http://localhost:8080/quotes/1" X-Api-User:user123
 func DeleteQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +423,38 @@ func DeleteQuotes(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "quotes", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteQuotes(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "quotes", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "quotes", argID)
+		return
+	}
+
+	if _, err := dao.DeleteQuotes(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
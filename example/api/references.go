@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+// withCascade returns ctx cascading deletes when r has cascade=true, the records referencing a deleted record along a
+// foreign key with the restrict policy are then deleted along with it
+func withCascade(ctx context.Context, r *http.Request) (context.Context, error) {
+	cascade, err := readBool(r, "cascade", false)
+	if err != nil {
+		return ctx, dao.ErrBadParams
+	}
+	if cascade {
+		ctx = dao.WithCascade(ctx)
+	}
+	return ctx, nil
+}
+
+// previewDelete writes the changes deleting the record of table with primary key argID would make, without deleting
+func previewDelete(ctx context.Context, w http.ResponseWriter, r *http.Request, table string, argID interface{}) {
+	tableInfo, ok := model.GetTableInfo(table)
+	if !ok {
+		returnError(ctx, w, r, dao.ErrNotFound)
+		return
+	}
+
+	preview, err := dao.PreviewDelete(ctx, tableInfo, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, preview)
+}
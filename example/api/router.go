@@ -3,11 +3,14 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	_ "github.com/satori/go.uuid"
+	"github.com/satori/go.uuid"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -21,6 +24,26 @@ import (
 var (
 	_             = time.Second // import time.Second for unknown usage in api
 	crudEndpoints map[string]*CrudAPI
+
+	// RequireIfMatch rejects updates and deletes without an If-Match header with 428 Precondition Required
+	RequireIfMatch bool
+
+	// PrincipalHeader request header naming the principal making a request, recorded in the audit log of its changes.
+	// The header is sent by the client and not authenticated, any client can name any principal. A server with
+	// authentication sets the principal of the authenticated user with dao.WithPrincipal in its ContextInitializer,
+	// which takes precedence over the header, and sets PrincipalHeader to "" to ignore the header.
+	PrincipalHeader = "X-Api-User"
+
+	// RequestIDHeader request header carrying the id of a request, requests without one get a random id
+	RequestIDHeader = "X-Request-Id"
+
+	// RequestTimeout deadline of the context of a request, its db calls are cancelled once it passes, 0 for no deadline
+	RequestTimeout time.Duration
+)
+
+const (
+	// StatusClientClosedRequest non standard status of a request cancelled because the client closed the connection
+	StatusClientClosedRequest = 499
 )
 
 // CrudAPI describes requests available for tables in the database
@@ -41,12 +64,18 @@ type PagedResults struct {
 	PageSize     int64       `json:"page_size"`
 	Data         interface{} `json:"data"`
 	TotalRecords int         `json:"total_records"`
+	NextCursor   string      `json:"next_cursor,omitempty"`
+	PrevCursor   string      `json:"prev_cursor,omitempty"`
 }
 
-// HTTPError example
+// HTTPError is the RFC 7807 problem details body of a failed request, served as application/problem+json
 type HTTPError struct {
-	Code    int    `json:"code" example:"400"`
-	Message string `json:"message" example:"status bad request"`
+	Type     string              `json:"type" example:"about:blank"`
+	Title    string              `json:"title" example:"Not Found"`
+	Status   int                 `json:"status" example:"404"`
+	Detail   string              `json:"detail,omitempty" example:"record Not Found"`
+	Instance string              `json:"instance,omitempty" example:"/elevators_/12"`
+	Errors   []*model.FieldError `json:"errors,omitempty"`
 }
 
 // ConfigRouter configure http.Handler router
@@ -79,7 +108,14 @@ func ConfigRouter() http.Handler {
 
 	router.GET("/ddl/:argID", GetDdl)
 	router.GET("/ddl", GetDdlEndpoints)
-	return router
+	router.GET("/search", Search)
+	router.GET("/audit", GetAudit)
+	configHealthRouter(router)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, cancel := withRequestTimeout(r)
+		defer cancel()
+		router.ServeHTTP(w, r)
+	})
 }
 
 // ConfigGinRouter configure gin router
@@ -111,6 +147,9 @@ func ConfigGinRouter(router gin.IRoutes) {
 
 	router.GET("/ddl/:argID", ConverHttprouterToGin(GetDdl))
 	router.GET("/ddl", ConverHttprouterToGin(GetDdlEndpoints))
+	router.GET("/search", ConverHttprouterToGin(Search))
+	router.GET("/audit", ConverHttprouterToGin(GetAudit))
+	configGinHealthRouter(router)
 	return
 }
 
@@ -125,7 +164,37 @@ func ConverHttprouterToGin(f httprouter.Handle) gin.HandlerFunc {
 			params = ((*[1 << 10]httprouter.Param)(unsafe.Pointer(&c.Params[0])))[:_len]
 		}
 
-		f(c.Writer, c.Request, params)
+		r, cancel := withRequestTimeout(c.Request)
+		defer cancel()
+		f(c.Writer, r, params)
+	}
+}
+
+// withRequestTimeout returns r whose context ends after the RequestTimeout, or when the client disconnects, cancel
+// must be called once the request is served. A ContextInitializer must derive its context from the one of r to keep
+// the deadline.
+func withRequestTimeout(r *http.Request) (*http.Request, context.CancelFunc) {
+	if RequestTimeout <= 0 {
+		return r, func() {}
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), RequestTimeout)
+	return r.WithContext(ctx), cancel
+}
+
+// staticSegment serves a static path segment of a table, e.g. /buildings_/bulk, from the route of the wildcard at the
+// same position since httprouter can not register both for a method. Other values of the wildcard are served by next,
+// or not found when next is nil.
+func staticSegment(segment string, static httprouter.Handle, next httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if len(ps) > 0 && ps[len(ps)-1].Value == segment {
+			static(w, r, ps)
+			return
+		}
+		if next == nil {
+			http.NotFound(w, r)
+			return
+		}
+		next(w, r, ps)
 	}
 }
 
@@ -135,6 +204,24 @@ func initializeContext(r *http.Request) (ctx context.Context) {
 	} else {
 		ctx = r.Context()
 	}
+	return withRequestInfo(ctx, r)
+}
+
+// withRequestInfo returns ctx carrying the principal and request id of r unless the ContextInitializer already set them
+func withRequestInfo(ctx context.Context, r *http.Request) context.Context {
+	if dao.Principal(ctx) == "" {
+		if principal := r.Header.Get(PrincipalHeader); principal != "" {
+			ctx = dao.WithPrincipal(ctx, principal)
+		}
+	}
+
+	if dao.RequestID(ctx) == "" {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewV4().String()
+		}
+		ctx = dao.WithRequestID(ctx, requestID)
+	}
 	return ctx
 }
 
@@ -146,6 +233,8 @@ func ValidateRequest(ctx context.Context, r *http.Request, table string, action
 	return nil
 }
 
+// RequestValidatorFunc rejects a request by returning an error, errors wrapping a dao error get its status (e.g.
+// dao.ErrBadParams 400) while any other error is a 500
 type RequestValidatorFunc func(ctx context.Context, r *http.Request, table string, action model.Action) error
 
 var RequestValidator RequestValidatorFunc
@@ -163,17 +252,26 @@ func readInt(r *http.Request, param string, v int64) (int64, error) {
 	return strconv.ParseInt(p, 10, 64)
 }
 
+func readBool(r *http.Request, param string, v bool) (bool, error) {
+	p := r.FormValue(param)
+	if p == "" {
+		return v, nil
+	}
+
+	return strconv.ParseBool(p)
+}
+
 func writeJSON(ctx context.Context, w http.ResponseWriter, v interface{}) {
-	data, _ := json.Marshal(v)
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Write(data)
+	writeJSONStatus(ctx, w, http.StatusOK, v)
 }
 
-func writeRowsAffected(w http.ResponseWriter, rowsAffected int64) {
-	data, _ := json.Marshal(rowsAffected)
+func writeJSONStatus(ctx context.Context, w http.ResponseWriter, status int, v interface{}) {
+	data, _ := json.Marshal(v)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.Header().Set("Cache-Control", "no-cache")
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	w.WriteHeader(status)
 	w.Write(data)
 }
 
@@ -186,39 +284,120 @@ func readJSON(r *http.Request, v interface{}) error {
 	return json.Unmarshal(buf, v)
 }
 
+// withIfMatch returns ctx carrying the entity tags of the If-Match header of r, ctx is returned unchanged without one.
+// If-Match compares tags strongly so a weak tag, as sent with a GET reduced by fields or include, never matches and is
+// left out.
+// error - ErrPreconditionRequired, RequireIfMatch is set and r has no If-Match header
+// error - ErrPreconditionWeak, the If-Match header holds weak tags only
+func withIfMatch(ctx context.Context, r *http.Request) (context.Context, error) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		if RequireIfMatch {
+			return ctx, dao.ErrPreconditionRequired
+		}
+		return ctx, nil
+	}
+
+	var etags []string
+	for _, etag := range strings.Split(header, ",") {
+		if etag = strings.TrimSpace(etag); etag != "" && !strings.HasPrefix(etag, "W/") {
+			etags = append(etags, etag)
+		}
+	}
+	if len(etags) == 0 {
+		return ctx, dao.ErrPreconditionWeak
+	}
+	return dao.WithIfMatch(ctx, etags), nil
+}
+
+// readMergePatch reads the json merge patch (RFC 7396) body of a PATCH request
+func readMergePatch(r *http.Request) ([]byte, error) {
+	return ioutil.ReadAll(r.Body)
+}
+
 func returnError(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
-	status := 0
-	switch err {
-	case dao.ErrNotFound:
-		status = http.StatusBadRequest
-	case dao.ErrUnableToMarshalJSON:
-		status = http.StatusBadRequest
-	case dao.ErrUpdateFailed:
-		status = http.StatusBadRequest
-	case dao.ErrInsertFailed:
-		status = http.StatusBadRequest
-	case dao.ErrDeleteFailed:
-		status = http.StatusBadRequest
-	case dao.ErrBadParams:
-		status = http.StatusBadRequest
+	problem := NewProblem(r, errorStatus(err), err)
+
+	var validation *model.ValidationError
+	if errors.As(err, &validation) {
+		problem.Detail = "the record has invalid fields"
+		problem.Errors = validation.Errors
+	}
+
+	if problem.Status >= http.StatusInternalServerError {
+		detail := err.Error()
+		var dbErr *dao.DBError
+		if errors.As(err, &dbErr) {
+			detail += ": " + dbErr.Cause.Error()
+		}
+		log.Printf("%s %s request %s: %s", r.Method, r.URL.Path, dao.RequestID(ctx), detail)
+		problem.Detail = fmt.Sprintf("the request failed, the error was logged with request id %s", dao.RequestID(ctx))
+	}
+
+	SendProblem(w, r, problem)
+}
+
+// errorStatus returns the http status of err, unexpected errors are 500 Internal Server Error
+func errorStatus(err error) int {
+	var validation *model.ValidationError
+	var numErr *strconv.NumError
+	switch {
+	case errors.Is(err, dao.ErrNotFound):
+		return http.StatusNotFound
+	case errors.As(err, &validation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, dao.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, dao.ErrPreconditionFailed), errors.Is(err, dao.ErrPreconditionWeak):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, dao.ErrPreconditionRequired):
+		return http.StatusPreconditionRequired
+	case errors.Is(err, dao.ErrBadParams), errors.Is(err, dao.ErrUnableToMarshalJSON), errors.Is(err, dao.ErrStatementNotAllowed), errors.As(err, &numErr):
+		return http.StatusBadRequest
+	case errors.Is(err, dao.ErrSandboxUnavailable):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, dao.ErrTimeout):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, dao.ErrCanceled):
+		return StatusClientClosedRequest
 	default:
-		status = http.StatusBadRequest
+		return http.StatusInternalServerError
+	}
+}
+
+// statusText returns the text of status, including the non standard ones returned by errorStatus
+func statusText(status int) string {
+	if status == StatusClientClosedRequest {
+		return "Client Closed Request"
 	}
-	er := HTTPError{
-		Code:    status,
-		Message: err.Error(),
+	return http.StatusText(status)
+}
+
+// invalidRecord returns the error of a failed Validate or BeforeSave of a record as a *model.ValidationError
+func invalidRecord(err error) error {
+	var validation *model.ValidationError
+	if errors.As(err, &validation) {
+		return validation
 	}
+	return &model.ValidationError{Errors: []*model.FieldError{{Message: err.Error()}}}
+}
 
-	SendJSON(w, r, er.Code, er)
+// NewProblem returns the problem details of a request to r failing with status and err
+func NewProblem(r *http.Request, status int, err error) *HTTPError {
+	return &HTTPError{
+		Type:     "about:blank",
+		Title:    statusText(status),
+		Status:   status,
+		Detail:   err.Error(),
+		Instance: r.URL.Path,
+	}
 }
 
 // NewError example
 func NewError(ctx *gin.Context, status int, err error) {
-	er := HTTPError{
-		Code:    status,
-		Message: err.Error(),
-	}
-	ctx.JSON(status, er)
+	problem := NewProblem(ctx.Request, status, err)
+	ctx.Header("Content-Type", "application/problem+json")
+	ctx.JSON(status, problem)
 }
 
 func parseUint8(ps httprouter.Params, key string) (uint8, error) {
@@ -327,7 +506,7 @@ func GetDdl(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 	record, ok := crudEndpoints[argID]
 	if !ok {
-		returnError(ctx, w, r, fmt.Errorf("unable to find table: %s", argID))
+		returnError(ctx, w, r, fmt.Errorf("%w: unable to find table: %s", dao.ErrNotFound, argID))
 		return
 	}
 
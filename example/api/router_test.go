@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+func TestErrorStatus(t *testing.T) {
+	_, numErr := strconv.ParseInt("x", 10, 64)
+
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{dao.ErrNotFound, http.StatusNotFound},
+		{fmt.Errorf("%w: unable to find table: x", dao.ErrNotFound), http.StatusNotFound},
+		{&model.ValidationError{Errors: []*model.FieldError{{Field: "email", Message: "invalid"}}}, http.StatusUnprocessableEntity},
+		{dao.ErrConflict, http.StatusConflict},
+		{&dao.DBError{Err: fmt.Errorf("%w: duplicate value for key", dao.ErrConflict), Cause: fmt.Errorf("UNIQUE constraint failed: key")}, http.StatusConflict},
+		{dao.ErrPreconditionFailed, http.StatusPreconditionFailed},
+		{dao.ErrPreconditionWeak, http.StatusPreconditionFailed},
+		{dao.ErrPreconditionRequired, http.StatusPreconditionRequired},
+		{dao.ErrBadParams, http.StatusBadRequest},
+		{dao.ErrUnableToMarshalJSON, http.StatusBadRequest},
+		{dao.ErrStatementNotAllowed, http.StatusBadRequest},
+		{numErr, http.StatusBadRequest},
+		{dao.ErrSandboxUnavailable, http.StatusServiceUnavailable},
+		{&dao.DBError{Err: dao.ErrTimeout, Cause: context.DeadlineExceeded}, http.StatusGatewayTimeout},
+		{&dao.DBError{Err: dao.ErrCanceled, Cause: context.Canceled}, StatusClientClosedRequest},
+		{&dao.DBError{Err: dao.ErrReadFailed, Cause: fmt.Errorf("no such table: elevators")}, http.StatusInternalServerError},
+		{dao.ErrInsertFailed, http.StatusInternalServerError},
+		{fmt.Errorf("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if status := errorStatus(tt.err); status != tt.status {
+			t.Errorf("errorStatus(%v) = %d, want %d", tt.err, status, tt.status)
+		}
+	}
+}
+
+func TestReadFailedResponse(t *testing.T) {
+	useTestTables(t)
+
+	// the cause of a 500 is logged, not returned
+	w := request(t, http.MethodGet, "/elevators_/1", map[string]string{RequestIDHeader: "request-1"}, "")
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("GET of a missing table = %d, want 500: %s", w.Code, w.Body.String())
+	}
+	if body := w.Body.String(); !strings.Contains(body, "request-1") || strings.Contains(body, "no such table") {
+		t.Errorf("body = %s, want the request id without the db error", body)
+	}
+}
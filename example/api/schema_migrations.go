@@ -20,7 +20,10 @@ func configSchemaMigrations_Router(router *httprouter.Router) {
 	router.POST("/schemamigrations_", AddSchemaMigrations_)
 	router.GET("/schemamigrations_/:argVersion", GetSchemaMigrations_)
 	router.PUT("/schemamigrations_/:argVersion", UpdateSchemaMigrations_)
+	router.PATCH("/schemamigrations_/:argVersion", PatchSchemaMigrations_)
+	router.POST("/schemamigrations_/:argVersion", staticSegment("bulk", BulkSchemaMigrations_, nil))
 	router.DELETE("/schemamigrations_/:argVersion", DeleteSchemaMigrations_)
+	router.GET("/schemamigrations_/:argVersion/history", GetRecordHistory("schema_migrations", "argVersion"))
 }
 
 func configGinSchemaMigrations_Router(router gin.IRoutes) {
@@ -28,19 +31,31 @@ func configGinSchemaMigrations_Router(router gin.IRoutes) {
 	router.POST("/schemamigrations_", ConverHttprouterToGin(AddSchemaMigrations_))
 	router.GET("/schemamigrations_/:argVersion", ConverHttprouterToGin(GetSchemaMigrations_))
 	router.PUT("/schemamigrations_/:argVersion", ConverHttprouterToGin(UpdateSchemaMigrations_))
+	router.PATCH("/schemamigrations_/:argVersion", ConverHttprouterToGin(PatchSchemaMigrations_))
+	router.POST("/schemamigrations_/:argVersion", ConverHttprouterToGin(staticSegment("bulk", BulkSchemaMigrations_, nil)))
 	router.DELETE("/schemamigrations_/:argVersion", ConverHttprouterToGin(DeleteSchemaMigrations_))
+	router.GET("/schemamigrations_/:argVersion/history", ConverHttprouterToGin(GetRecordHistory("schema_migrations", "argVersion")))
 }
 
 // GetAllSchemaMigrations_ is a function to get a slice of record(s) from schema_migrations table in the rocket_development database
 // @Summary Get list of SchemaMigrations_
 // @Tags SchemaMigrations_
-// @Description GetAllSchemaMigrations_ is a handler to get a slice of record(s) from schema_migrations table in the rocket_development database
+// @Description GetAllSchemaMigrations_ is a handler to get a slice of record(s) from schema_migrations table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   version  query    string  false        "filter version=value or version[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.SchemaMigrations_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /schemamigrations_ [get]
@@ -59,20 +74,40 @@ func GetAllSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "schema_migrations")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "schema_migrations", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllSchemaMigrations_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "schema_migrations", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "schema_migrations", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllSchemaMigrations_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +119,13 @@ func GetAllSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 // @Accept  json
 // @Produce  json
 // @Param  argVersion path string true "version"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.SchemaMigrations_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /schemamigrations_/{argVersion} [get]
@@ -98,18 +139,41 @@ func GetSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.
 		return
 	}
 
+	fields, err := readFields(r, "schema_migrations")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "schema_migrations")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "schema_migrations", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetSchemaMigrations_(ctx, argVersion)
+	record, err := dao.GetSchemaMigrations_(ctx, argVersion, recordFields("schema_migrations", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "schema_migrations", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddSchemaMigrations_ add to add a single record to schema_migrations table in the rocket_development database
@@ -122,6 +186,10 @@ func GetSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.
 // @Success 200 {object} model.SchemaMigrations_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /schemamigrations_ [post]
 // echo '{"version": "PVRAgcYvPDrleEjACHPhPCScF"}' | http POST "http://localhost:8080/schemamigrations_" X-Api-User:user123
 func AddSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -134,13 +202,14 @@ func AddSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.
 	}
 
 	if err := schemamigrations_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	schemamigrations_.Prepare()
 
 	if err := schemamigrations_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,15 +230,22 @@ func AddSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.
 
 // UpdateSchemaMigrations_ Update a single record from schema_migrations table in the rocket_development database
 // @Summary Update an record in table schema_migrations
-// @Description Update a single record from schema_migrations table in the rocket_development database
+// @Description Update a single record from schema_migrations table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags SchemaMigrations_
 // @Accept  json
 // @Produce  json
 // @Param  argVersion path string true "version"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  SchemaMigrations_ body model.SchemaMigrations_ true "Update SchemaMigrations_ record"
 // @Success 200 {object} model.SchemaMigrations_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /schemamigrations_/{argVersion} [put]
 // echo '{"version": "PVRAgcYvPDrleEjACHPhPCScF"}' | http PUT "http://localhost:8080/schemamigrations_/hello world"  X-Api-User:user123
 func UpdateSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -181,6 +257,12 @@ func UpdateSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	schemamigrations_ := &model.SchemaMigrations_{}
 	if err := readJSON(r, schemamigrations_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +270,14 @@ func UpdateSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 	}
 
 	if err := schemamigrations_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	schemamigrations_.Prepare()
 
 	if err := schemamigrations_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +297,77 @@ func UpdateSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 	writeJSON(ctx, w, schemamigrations_)
 }
 
+// PatchSchemaMigrations_ Patch a single record from schema_migrations table in the rocket_development database
+// @Summary Patch an record in table schema_migrations
+// @Description Patch a single record from schema_migrations table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags SchemaMigrations_
+// @Accept  json
+// @Produce  json
+// @Param  argVersion path string true "version"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  SchemaMigrations_ body model.SchemaMigrations_ true "fields of SchemaMigrations_ to change, null clears a field"
+// @Success 200 {object} model.SchemaMigrations_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /schemamigrations_/{argVersion} [patch]
+// echo '{"version": "PVRAgcYvPDrleEjACHPhPCScF"}' | http PATCH "http://localhost:8080/schemamigrations_/hello world"  X-Api-User:user123
+func PatchSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argVersion, err := parseString(ps, "argVersion")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "schema_migrations", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	schemamigrations_, _, err := dao.PatchSchemaMigrations_(ctx, argVersion, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, schemamigrations_)
+}
+
+// BulkSchemaMigrations_ runs create, update and delete operations on records of the schema_migrations table in the rocket_development database
+// @Summary Bulk create, update and delete records of table schema_migrations
+// @Description BulkSchemaMigrations_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags SchemaMigrations_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on SchemaMigrations_ records, the record of create and update is a SchemaMigrations_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /schemamigrations_/bulk [post]
+// echo '{"continue_on_error": false, "operations": [{"op": "create", "record": {"version": "PVRAgcYvPDrleEjACHPhPCScF"}}, {"op": "delete", "id": "hello world"}]}' | http POST "http://localhost:8080/schemamigrations_/bulk" X-Api-User:user123
+func BulkSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "schema_migrations")
+}
+
 // DeleteSchemaMigrations_ Delete a single record from schema_migrations table in the rocket_development database
 // @Summary Delete a record from schema_migrations
 // @Description Delete a single record from schema_migrations table in the rocket_development database
@@ -221,9 +375,18 @@ func UpdateSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 // @Accept  json
 // @Produce  json
 // @Param  argVersion path string true "version"
-// @Success 204 {object} model.SchemaMigrations_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /schemamigrations_/{argVersion} [delete]
 // http DELETE "http://localhost:8080/schemamigrations_/hello world" X-Api-User:user123
 func DeleteSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +398,38 @@ func DeleteSchemaMigrations_(w http.ResponseWriter, r *http.Request, ps httprout
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "schema_migrations", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteSchemaMigrations_(ctx, argVersion)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "schema_migrations", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "schema_migrations", argVersion)
+		return
+	}
+
+	if _, err := dao.DeleteSchemaMigrations_(ctx, argVersion); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
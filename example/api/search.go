@@ -0,0 +1,86 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// SearchResults records matching a search grouped by table
+type SearchResults struct {
+	Q       string             `json:"q"`
+	Results []*dao.SearchGroup `json:"results"`
+}
+
+// Search is a function to search the searchable columns of several tables in the rocket_development database
+// @Summary Search records across tables
+// @Tags Search
+// @Description Search matches q against the columns flagged as searchable, using mysql FULLTEXT indexes when available, and returns the matching records grouped by table with a relevance score
+// @Accept  json
+// @Produce  json
+// @Param   q      query string true  "words to search for"
+// @Param   tables query string false "comma separated tables to search (defaults to every table with searchable columns)"
+// @Param   limit  query int    false "records returned per table (defaults to 10)"
+// @Success 200 {object} api.SearchResults
+// @Failure 400 {object} api.HTTPError
+// @Router /search [get]
+// http "http://localhost:8080/search?q=gold&tables=customers,elevators" X-Api-User:user123
+func Search(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	q := r.FormValue("q")
+	if strings.TrimSpace(q) == "" {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	limit, err := readInt(r, "limit", 10)
+	if err != nil || limit <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	var names []string
+	if tables := r.FormValue("tables"); tables != "" {
+		names = strings.Split(tables, ",")
+	} else {
+		for name, endpoint := range crudEndpoints {
+			if len(endpoint.TableInfo.SearchableColumns()) > 0 {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+
+	var tables []*model.TableInfo
+	for _, name := range names {
+		tableInfo, ok := model.GetTableInfo(strings.TrimSpace(name))
+		if !ok {
+			returnError(ctx, w, r, fmt.Errorf("%w: unknown table %q", dao.ErrBadParams, name))
+			return
+		}
+
+		if err := ValidateRequest(ctx, r, tableInfo.Name, model.RetrieveMany); err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+		tables = append(tables, tableInfo)
+	}
+
+	groups, err := dao.SearchTables(ctx, q, tables, int(limit))
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if groups == nil {
+		groups = []*dao.SearchGroup{}
+	}
+	writeJSON(ctx, w, &SearchResults{Q: q, Results: groups})
+}
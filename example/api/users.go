@@ -20,7 +20,10 @@ func configUsers_Router(router *httprouter.Router) {
 	router.POST("/users_", AddUsers_)
 	router.GET("/users_/:argID", GetUsers_)
 	router.PUT("/users_/:argID", UpdateUsers_)
+	router.PATCH("/users_/:argID", PatchUsers_)
+	router.POST("/users_/:argID", staticSegment("bulk", BulkUsers_, nil))
 	router.DELETE("/users_/:argID", DeleteUsers_)
+	router.GET("/users_/:argID/history", GetRecordHistory("users", "argID"))
 }
 
 func configGinUsers_Router(router gin.IRoutes) {
@@ -28,19 +31,40 @@ func configGinUsers_Router(router gin.IRoutes) {
 	router.POST("/users_", ConverHttprouterToGin(AddUsers_))
 	router.GET("/users_/:argID", ConverHttprouterToGin(GetUsers_))
 	router.PUT("/users_/:argID", ConverHttprouterToGin(UpdateUsers_))
+	router.PATCH("/users_/:argID", ConverHttprouterToGin(PatchUsers_))
+	router.POST("/users_/:argID", ConverHttprouterToGin(staticSegment("bulk", BulkUsers_, nil)))
 	router.DELETE("/users_/:argID", ConverHttprouterToGin(DeleteUsers_))
+	router.GET("/users_/:argID/history", ConverHttprouterToGin(GetRecordHistory("users", "argID")))
 }
 
 // GetAllUsers_ is a function to get a slice of record(s) from users table in the rocket_development database
 // @Summary Get list of Users_
 // @Tags Users_
-// @Description GetAllUsers_ is a handler to get a slice of record(s) from users table in the rocket_development database
+// @Description GetAllUsers_ is a handler to get a slice of record(s) from users table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)
 // @Accept  json
 // @Produce  json
 // @Param   page     query    int     false        "page requested (defaults to 0)"
 // @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
-// @Param   order    query    string  false        "db sort order column"
+// @Param   sort     query    string  false        "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)"
+// @Param   cursor   query    string  false        "next_cursor or prev_cursor of a previous page, replaces page"
+// @Param   count    query    bool    false        "count the matching records in total_records, -1 when false (defaults to true)"
+// @Param   If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param   If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
+// @Param   fields   query    string  false        "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param   q        query    string  false        "full text search of the searchable columns"
+// @Param   include  query    string  false        "comma separated related records to embed: customers, employees"
+// @Param   id       query    int     false        "filter id=value or id[op]=value"
+// @Param   email    query    string  false        "filter email=value or email[op]=value"
+// @Param   encrypted_password query    string  false        "filter encrypted_password=value or encrypted_password[op]=value"
+// @Param   reset_password_token query    string  false        "filter reset_password_token=value or reset_password_token[op]=value"
+// @Param   reset_password_sent_at query    string  false        "filter reset_password_sent_at=value or reset_password_sent_at[op]=value"
+// @Param   remember_created_at query    string  false        "filter remember_created_at=value or remember_created_at[op]=value"
+// @Param   created_at query    string  false        "filter created_at=value or created_at[op]=value"
+// @Param   updated_at query    string  false        "filter updated_at=value or updated_at[op]=value"
 // @Success 200 {object} api.PagedResults{data=[]model.Users_}
+// @Header 200 {string} ETag "weak entity tag of the latest updated_at, the number of matching records and the query"
+// @Header 200 {string} Last-Modified "latest updated_at of the matching records"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
 // @Router /users_ [get]
@@ -59,20 +83,40 @@ func GetAllUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	order := r.FormValue("order")
+	query, err := readListQuery(r, "users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
 
 	if err := ValidateRequest(ctx, r, "users", model.RetrieveMany); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	records, totalRows, err := dao.GetAllUsers_(ctx, page, pagesize, order)
+	etag, lastModified, err := listValidators(ctx, r, "users", query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if notModified(w, r, "users", etag, lastModified) {
+		return
+	}
+
+	records, totalRows, cursors, err := dao.GetAllUsers_(ctx, page, pagesize, query)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	data, err := renderRecords(ctx, r, records, query.Fields, query.Include)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: data, TotalRecords: totalRows, NextCursor: cursors.Next, PrevCursor: cursors.Prev}
 	writeJSON(ctx, w, result)
 }
 
@@ -84,7 +128,14 @@ func GetAllUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  fields query string false "comma separated fields to return, e.g. id,status (defaults to all)"
+// @Param  include query string false "comma separated related records to embed: customers, employees"
+// @Param  If-None-Match header string false "etag of a previous response, 304 Not Modified when unchanged"
+// @Param  If-Modified-Since header string false "Last-Modified of a previous response, 304 Not Modified when unchanged"
 // @Success 200 {object} model.Users_
+// @Header 200 {string} ETag "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+// @Header 200 {string} Last-Modified "updated_at of the record"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
 // @Router /users_/{argID} [get]
@@ -98,18 +149,41 @@ func GetUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 
+	fields, err := readFields(r, "users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	include, err := readInclude(r, "users")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	if err := ValidateRequest(ctx, r, "users", model.RetrieveOne); err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	record, err := dao.GetUsers_(ctx, argID)
+	record, err := dao.GetUsers_(ctx, argID, recordFields("users", fields, include))
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeJSON(ctx, w, record)
+	data, err := renderRecords(ctx, r, record, fields, include)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	etag, lastModified := recordValidators(record, data, fields, include)
+	if notModified(w, r, "users", etag, lastModified) {
+		return
+	}
+
+	writeJSON(ctx, w, data)
 }
 
 // AddUsers_ add to add a single record to users table in the rocket_development database
@@ -122,8 +196,12 @@ func GetUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 // @Success 200 {object} model.Users_
 // @Failure 400 {object} api.HTTPError
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /users_ [post]
-// echo '{"id": 81,"email": "ZLjaQBsblYtmCtyBbreFUINMM","encrypted_password": "JEpRNAbqJDLRdjppknMiiNXRp","reset_password_token": "NIUsWJRsjpobJNvhIcHLgFKfe","reset_password_sent_at": "2295-05-23T18:54:46.992966384-04:00","remember_created_at": "2271-10-15T07:11:01.040973272-04:00","created_at": "2266-07-31T18:52:32.549936617-04:00","updated_at": "2044-07-13T12:48:28.442731982-04:00"}' | http POST "http://localhost:8080/users_" X-Api-User:user123
+// echo '{"id": 81,"email": "ZLjaQBsblYtmCtyBbreFUINMM","encrypted_password": "JEpRNAbqJDLRdjppknMiiNXRp","reset_password_token": "NIUsWJRsjpobJNvhIcHLgFKfe","reset_password_sent_at": "2295-05-23T18:54:46.992966384-04:00","remember_created_at": "2271-10-15T07:11:01.040973272-04:00"}' | http POST "http://localhost:8080/users_" X-Api-User:user123
 func AddUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 	users_ := &model.Users_{}
@@ -134,13 +212,14 @@ func AddUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 
 	if err := users_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	users_.Prepare()
 
 	if err := users_.Validate(model.Create); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -161,17 +240,24 @@ func AddUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateUsers_ Update a single record from users table in the rocket_development database
 // @Summary Update an record in table users
-// @Description Update a single record from users table in the rocket_development database
+// @Description Update a single record from users table in the rocket_development database, every field is replaced and a field missing from the body is cleared
 // @Tags Users_
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
 // @Param  Users_ body model.Users_ true "Update Users_ record"
 // @Success 200 {object} model.Users_
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
 // @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /users_/{argID} [put]
-// echo '{"id": 81,"email": "ZLjaQBsblYtmCtyBbreFUINMM","encrypted_password": "JEpRNAbqJDLRdjppknMiiNXRp","reset_password_token": "NIUsWJRsjpobJNvhIcHLgFKfe","reset_password_sent_at": "2295-05-23T18:54:46.992966384-04:00","remember_created_at": "2271-10-15T07:11:01.040973272-04:00","created_at": "2266-07-31T18:52:32.549936617-04:00","updated_at": "2044-07-13T12:48:28.442731982-04:00"}' | http PUT "http://localhost:8080/users_/1"  X-Api-User:user123
+// echo '{"id": 81,"email": "ZLjaQBsblYtmCtyBbreFUINMM","encrypted_password": "JEpRNAbqJDLRdjppknMiiNXRp","reset_password_token": "NIUsWJRsjpobJNvhIcHLgFKfe","reset_password_sent_at": "2295-05-23T18:54:46.992966384-04:00","remember_created_at": "2271-10-15T07:11:01.040973272-04:00"}' | http PUT "http://localhost:8080/users_/1"  X-Api-User:user123
 func UpdateUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	ctx := initializeContext(r)
 
@@ -181,6 +267,12 @@ func UpdateUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
 	users_ := &model.Users_{}
 	if err := readJSON(r, users_); err != nil {
 		returnError(ctx, w, r, dao.ErrBadParams)
@@ -188,13 +280,14 @@ func UpdateUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	}
 
 	if err := users_.BeforeSave(); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
+		return
 	}
 
 	users_.Prepare()
 
 	if err := users_.Validate(model.Update); err != nil {
-		returnError(ctx, w, r, dao.ErrBadParams)
+		returnError(ctx, w, r, invalidRecord(err))
 		return
 	}
 
@@ -214,6 +307,77 @@ func UpdateUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	writeJSON(ctx, w, users_)
 }
 
+// PatchUsers_ Patch a single record from users table in the rocket_development database
+// @Summary Patch an record in table users
+// @Description Patch a single record from users table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared
+// @Tags Users_
+// @Accept  json
+// @Produce  json
+// @Param  argID path int64 true "id"
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  Users_ body model.Users_ true "fields of Users_ to change, null clears a field"
+// @Success 200 {object} model.Users_
+// @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
+// @Failure 422 {object} api.HTTPError "the record has invalid fields, listed in errors"
+// @Failure 500 {object} api.HTTPError "unexpected db error, logged with the request id"
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
+// @Router /users_/{argID} [patch]
+// echo '{"reset_password_token": null}' | http PATCH "http://localhost:8080/users_/1"  X-Api-User:user123
+func PatchUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt64(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	patch, err := readMergePatch(r)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "users", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	users_, _, err := dao.PatchUsers_(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, users_)
+}
+
+// BulkUsers_ runs create, update and delete operations on records of the users table in the rocket_development database
+// @Summary Bulk create, update and delete records of table users
+// @Description BulkUsers_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.
+// @Tags Users_
+// @Accept  json
+// @Produce  json
+// @Param  operations body api.BulkRequest true "operations on Users_ records, the record of create and update is a Users_"
+// @Success 200 {object} dao.BulkResults "committed, with the result of every operation"
+// @Failure 400 {object} dao.BulkResults "rolled back, with the result of every operation"
+// @Router /users_/bulk [post]
+// echo '{"continue_on_error": false,"operations": [{"op": "create","record": {"id": 81,"email": "ZLjaQBsblYtmCtyBbreFUINMM","encrypted_password": "JEpRNAbqJDLRdjppknMiiNXRp","reset_password_token": "NIUsWJRsjpobJNvhIcHLgFKfe","reset_password_sent_at": "2295-05-23T18:54:46.992966384-04:00","remember_created_at": "2271-10-15T07:11:01.040973272-04:00"}},{"op": "delete","id": 1}]}' | http POST "http://localhost:8080/users_/bulk" X-Api-User:user123
+func BulkUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	bulkRecords(ctx, w, r, "users")
+}
+
 // DeleteUsers_ Delete a single record from users table in the rocket_development database
 // @Summary Delete a record from users
 // @Description Delete a single record from users table in the rocket_development database
@@ -221,9 +385,18 @@ func UpdateUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 // @Accept  json
 // @Produce  json
 // @Param  argID path int64 true "id"
-// @Success 204 {object} model.Users_
+// @Param  If-Match header string false "etag of the record from GET, the request fails with 412 when the record changed since"
+// @Param  cascade query bool false "delete the records referencing the record along restrict foreign keys instead of failing with 409"
+// @Param  dry_run query bool false "return the changes the delete would make without deleting"
+// @Success 204 "the record was deleted"
+// @Success 200 {object} dao.DeletePreview "dry_run=true"
 // @Failure 400 {object} api.HTTPError
+// @Failure 412 {object} api.HTTPError "ErrPreconditionFailed, the record does not match If-Match"
+// @Failure 428 {object} api.HTTPError "ErrPreconditionRequired, If-Match is required by --require-if-match"
+// @Failure 404 {object} api.HTTPError
+// @Failure 409 {object} api.HTTPError "ErrConflict, a duplicate value of a unique index or a broken foreign key"
 // @Failure 500 {object} api.HTTPError
+// @Failure 504 {object} api.HTTPError "the db calls of the request were cancelled by the request or operation timeout"
 // @Router /users_/{argID} [delete]
 // http DELETE "http://localhost:8080/users_/1" X-Api-User:user123
 func DeleteUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -235,16 +408,38 @@ func DeleteUsers_(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		return
 	}
 
-	if err := ValidateRequest(ctx, r, "users", model.Delete); err != nil {
+	ctx, err = withIfMatch(ctx, r)
+	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	rowsAffected, err := dao.DeleteUsers_(ctx, argID)
+	ctx, err = withCascade(ctx, r)
 	if err != nil {
 		returnError(ctx, w, r, err)
 		return
 	}
 
-	writeRowsAffected(w, rowsAffected)
+	dryRun, err := readBool(r, "dry_run", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "users", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if dryRun {
+		previewDelete(ctx, w, r, "users", argID)
+		return
+	}
+
+	if _, err := dao.DeleteUsers_(ctx, argID); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,530 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/droundy/goopt"
+	"gopkg.in/yaml.v2"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+const (
+	// envPrefix prefix of the environment variables overriding the settings, e.g. APP_DB_DSN
+	envPrefix = "APP_"
+)
+
+// Config holds the settings of the server. Every setting is read, from the lowest to the highest precedence, from its
+// default, the yaml config file given with --config or APP_CONFIG, its APP_ environment variable and its flag. Each
+// field declares its yaml key, environment variable (without the APP_ prefix) and flag in its tags.
+type Config struct {
+	Database DatabaseConfig `yaml:"database"`
+	Server   ServerConfig   `yaml:"server"`
+	Log      LogConfig      `yaml:"log"`
+	Swagger  SwaggerConfig  `yaml:"swagger"`
+	Sandbox  SandboxConfig  `yaml:"sandbox"`
+	Features FeaturesConfig `yaml:"features"`
+}
+
+// DatabaseConfig database connection and migrations
+type DatabaseConfig struct {
+	Dialect         string `yaml:"dialect" env:"DB_DIALECT" flag:"--db-dialect" help:"gorm dialect of the database, mysql, postgres, sqlite3 or mssql"`
+	DSN             string `yaml:"dsn" env:"DB_DSN" flag:"--db-dsn" help:"data source name of the database"`
+	MaxOpenConns    int    `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" flag:"--db-max-open-conns" help:"connections open to the database, 0 for no limit"`
+	MaxIdleConns    int    `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" flag:"--db-max-idle-conns" help:"idle connections kept in the pool"`
+	ConnMaxLifetime int    `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" flag:"--db-conn-max-lifetime" help:"seconds a connection is reused before it is closed, 0 to reuse forever"`
+
+	OperationTimeout int `yaml:"operation_timeout" env:"OPERATION_TIMEOUT" flag:"--operation-timeout" help:"seconds a single db operation of a request may run before it is cancelled, 0 for no limit"`
+
+	MigrationsDir string `yaml:"migrations_dir" env:"MIGRATIONS_DIR" flag:"--migrations-dir" help:"directory holding the <version>_<name>.up.sql and .down.sql migration files"`
+	AutoMigrate   bool   `yaml:"automigrate" env:"AUTOMIGRATE" flag:"--automigrate" help:"create missing tables and columns from the models on startup (development only)"`
+
+	PurgeAfterDays int `yaml:"purge_after_days" env:"PURGE_AFTER_DAYS" flag:"--purge-after-days" help:"days soft deleted records are kept before the server purges them in the background, 0 to keep them"`
+	PurgeInterval  int `yaml:"purge_interval" env:"PURGE_INTERVAL" flag:"--purge-interval" help:"minutes between two background purges of the soft deleted records"`
+}
+
+// ServerConfig http server
+type ServerConfig struct {
+	Listen string `yaml:"listen" env:"LISTEN" flag:"--listen" help:"address the http server listens on"`
+
+	TLSCert string `yaml:"tls_cert" env:"TLS_CERT" flag:"--tls-cert" help:"certificate file served over https, along with --tls-key"`
+	TLSKey  string `yaml:"tls_key" env:"TLS_KEY" flag:"--tls-key" help:"private key file of --tls-cert"`
+
+	RequestTimeout  int `yaml:"request_timeout" env:"REQUEST_TIMEOUT" flag:"--request-timeout" help:"seconds a request may run before its db calls are cancelled with 504 Gateway Timeout, 0 for no limit"`
+	ShutdownTimeout int `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"--shutdown-timeout" help:"seconds the requests being served are given to complete on SIGINT or SIGTERM before their connections are closed, then the background workers to stop"`
+}
+
+// LogConfig access and sql logs
+type LogConfig struct {
+	Level     string `yaml:"level" env:"LOG_LEVEL" flag:"--log-level" help:"minimum level of the sql log entries, debug, info, warn or error, debug logs every statement regardless of --sql-log-sample"`
+	AccessLog bool   `yaml:"access_log" env:"ACCESS_LOG" flag:"--access-log" help:"log every request"`
+
+	SQLLog       string   `yaml:"sql_log" env:"SQL_LOG" flag:"--sql-log" help:"file the json lines log of the sql statements is appended to, - for stdout, empty to disable"`
+	SlowQueryMs  int      `yaml:"slow_query_ms" env:"SLOW_QUERY_MS" flag:"--slow-query-ms" help:"milliseconds above which a statement is logged at warn level with its EXPLAIN plan, 0 to disable"`
+	SQLLogSample float64  `yaml:"sql_log_sample" env:"SQL_LOG_SAMPLE" flag:"--sql-log-sample" help:"fraction of the statements logged at info level, between 0 and 1, warnings and errors are always logged"`
+	SQLLogRedact []string `yaml:"sql_log_redact" env:"SQL_LOG_REDACT" flag:"--sql-log-redact" help:"values of the columns whose name holds word are redacted from the sql log, in addition to password, token and secret (repeatable)"`
+}
+
+// SwaggerConfig swagger ui
+type SwaggerConfig struct {
+	Enabled bool   `yaml:"enabled" env:"SWAGGER" flag:"--swagger" help:"serve the swagger ui under /swagger/"`
+	Host    string `yaml:"host" env:"SWAGGER_HOST" flag:"--swagger-host" help:"host the swagger ui sends its requests to"`
+}
+
+// SandboxConfig read only sql sandbox running the blazer statements
+type SandboxConfig struct {
+	Dialect  string `yaml:"dialect" env:"SANDBOX_DIALECT" flag:"--sandbox-dialect" help:"database dialect of the read only sql sandbox"`
+	DSN      string `yaml:"dsn" env:"SANDBOX_DSN" flag:"--sandbox-dsn" help:"read only data source used to run blazer statements, running them is disabled when empty"`
+	MaxTime  int    `yaml:"max_time" env:"SANDBOX_MAX_TIME" flag:"--sandbox-max-time" help:"seconds a sandboxed statement may run before it is cancelled"`
+	MaxRows  int    `yaml:"max_rows" env:"SANDBOX_MAX_ROWS" flag:"--sandbox-max-rows" help:"rows returned by a sandboxed statement before it is truncated"`
+	MaxBytes int    `yaml:"max_bytes" env:"SANDBOX_MAX_BYTES" flag:"--sandbox-max-bytes" help:"result bytes returned by a sandboxed statement before it is truncated"`
+}
+
+// FeaturesConfig optional behavior of the api
+type FeaturesConfig struct {
+	RequireIfMatch bool `yaml:"require_if_match" env:"REQUIRE_IF_MATCH" flag:"--require-if-match" help:"reject updates and deletes without an If-Match header with 428 Precondition Required"`
+
+	CacheControl        map[string]string `yaml:"cache_control" env:"CACHE_CONTROL" flag:"--cache-control" help:"Cache-Control of the GET responses of a table, e.g. elevators=max-age=10 (repeatable)"`
+	DefaultCacheControl string            `yaml:"default_cache_control" env:"DEFAULT_CACHE_CONTROL" flag:"--default-cache-control" help:"Cache-Control of the GET responses of tables without --cache-control"`
+
+	MaxIncludeRecords int `yaml:"max_include_records" env:"MAX_INCLUDE_RECORDS" flag:"--max-include-records" help:"records an include of a one to many relation may load for a page, a page referenced by more is refused with 400 Bad Request"`
+
+	DeletePolicies map[string]string `yaml:"delete_policies" env:"DELETE_POLICIES" flag:"--delete-policy" help:"policy applied to the records referencing a deleted record along a foreign key, restrict, cascade or nullify, e.g. interventions.employee_id=nullify (repeatable)"`
+
+	BuildingDetailSchema string `yaml:"building_detail_schema" env:"BUILDING_DETAIL_SCHEMA" flag:"--building-detail-schema" help:"json file describing the type, allowed values and required building detail keys"`
+}
+
+// DefaultConfig returns the settings used when neither the config file, the environment nor a flag sets them
+func DefaultConfig() *Config {
+	return &Config{
+		Database: DatabaseConfig{
+			Dialect:       "mysql",
+			DSN:           "root@/rocket_development?parseTime=true",
+			MaxIdleConns:  2,
+			MigrationsDir: "./migrations",
+			PurgeInterval: 60,
+		},
+		Server: ServerConfig{
+			Listen:          ":80",
+			RequestTimeout:  30,
+			ShutdownTimeout: 30,
+		},
+		Log: LogConfig{
+			Level:        dao.LevelInfo,
+			AccessLog:    true,
+			SQLLog:       "-",
+			SlowQueryMs:  1000,
+			SQLLogSample: 1,
+		},
+		Swagger: SwaggerConfig{
+			Enabled: true,
+			Host:    "localhost:8080",
+		},
+		Sandbox: SandboxConfig{
+			Dialect:  "mysql",
+			MaxTime:  30,
+			MaxRows:  10000,
+			MaxBytes: 10 << 20,
+		},
+		Features: FeaturesConfig{
+			CacheControl:        map[string]string{},
+			DefaultCacheControl: "no-cache",
+			MaxIncludeRecords:   1000,
+			DeletePolicies:      map[string]string{},
+		},
+	}
+}
+
+// configField is a setting of a Config along with the environment variable and flag overriding it
+type configField struct {
+	value reflect.Value
+
+	// key yaml path of the setting, e.g. database.dsn
+	key  string
+	env  string
+	flag string
+	help string
+}
+
+// fields returns the settings of c
+func (c *Config) fields() []*configField {
+	var fields []*configField
+	sections := reflect.ValueOf(c).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		sectionKey := yamlKey(sections.Type().Field(i))
+		for j := 0; j < section.NumField(); j++ {
+			field := section.Type().Field(j)
+			fields = append(fields, &configField{
+				value: section.Field(j),
+				key:   sectionKey + "." + yamlKey(field),
+				env:   envPrefix + field.Tag.Get("env"),
+				flag:  field.Tag.Get("flag"),
+				help:  field.Tag.Get("help"),
+			})
+		}
+	}
+	return fields
+}
+
+func yamlKey(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("yaml"), ",")[0]
+}
+
+// set parses s into the setting, a list or map setting is replaced by the items of s, separated by , for a list and
+// by ; for a map since Cache-Control directives hold commas. With add the single item s is added instead, as done by
+// every occurrence of a repeatable flag after the first one.
+func (f *configField) set(s string, add bool) error {
+	v := f.value
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+
+	case reflect.Int:
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("%s must be a whole number, got %q", f.key, s)
+		}
+		v.SetInt(int64(n))
+
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number, got %q", f.key, s)
+		}
+		v.SetFloat(n)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("%s must be true or false, got %q", f.key, s)
+		}
+		v.SetBool(b)
+
+	case reflect.Slice:
+		items := []string{s}
+		if !add {
+			items = splitList(s, ",")
+			v.Set(reflect.MakeSlice(v.Type(), 0, len(items)))
+		}
+		v.Set(reflect.AppendSlice(v, reflect.ValueOf(items)))
+
+	case reflect.Map:
+		items := []string{s}
+		if !add {
+			items = splitList(s, ";")
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for _, item := range items {
+			parts := strings.SplitN(item, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("%s items must be key=value, got %q", f.key, item)
+			}
+			v.SetMapIndex(reflect.ValueOf(parts[0]), reflect.ValueOf(parts[1]))
+		}
+
+	default:
+		return fmt.Errorf("%s has unsupported type %s", f.key, v.Type())
+	}
+	return nil
+}
+
+// String returns the setting as shown in the usage of its flag
+func (f *configField) String() string {
+	switch v := f.value.Interface().(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case map[string]string:
+		items := make([]string, 0, len(v))
+		for key, value := range v {
+			items = append(items, key+"="+value)
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func splitList(s, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(s, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ConfigLoader reads a Config from its sources. NewConfigLoader must be called before goopt.Parse to register the
+// flags, Load after it.
+type ConfigLoader struct {
+	file  string
+	print bool
+
+	// flags settings given on the command line in order, applied over the config file and the environment
+	flags []*configFlag
+}
+
+// configFlag is the value of the setting of the field at index given with a flag
+type configFlag struct {
+	index int
+	value string
+}
+
+// NewConfigLoader registers the --config and --print-config flags and a flag for every setting of a Config
+func NewConfigLoader() *ConfigLoader {
+	l := &ConfigLoader{file: os.Getenv(envPrefix + "CONFIG")}
+
+	goopt.ReqArg([]string{"--config"}, "file", "yaml config file, also read from "+envPrefix+"CONFIG", func(s string) error {
+		l.file = s
+		return nil
+	})
+	goopt.NoArg([]string{"--print-config"}, "print the configuration read from the defaults, config file, environment and flags, then exit", func() error {
+		l.print = true
+		return nil
+	})
+
+	for i, field := range DefaultConfig().fields() {
+		index := i
+		help := fmt.Sprintf("%s (default %q, %s, %s)", field.help, field.String(), field.env, field.key)
+
+		if field.value.Kind() == reflect.Bool {
+			goopt.NoArg([]string{field.flag}, help, func() error {
+				l.flags = append(l.flags, &configFlag{index: index, value: "true"})
+				return nil
+			})
+			goopt.NoArg([]string{"--no-" + strings.TrimPrefix(field.flag, "--")}, "disable "+field.flag, func() error {
+				l.flags = append(l.flags, &configFlag{index: index, value: "false"})
+				return nil
+			})
+			continue
+		}
+
+		label := "value"
+		switch field.value.Kind() {
+		case reflect.Slice:
+			label = "word"
+		case reflect.Map:
+			label = "key=value"
+		}
+		goopt.ReqArg([]string{field.flag}, label, help, func(s string) error {
+			l.flags = append(l.flags, &configFlag{index: index, value: s})
+			return nil
+		})
+	}
+
+	return l
+}
+
+// Load returns the Config read from the defaults, the config file, the environment and the flags, in increasing order
+// of precedence, and validated
+func (l *ConfigLoader) Load() (*Config, error) {
+	config := DefaultConfig()
+
+	if l.file != "" {
+		if err := config.readFile(l.file); err != nil {
+			return nil, err
+		}
+	}
+
+	fields := config.fields()
+	for _, field := range fields {
+		if value, ok := os.LookupEnv(field.env); ok {
+			if err := field.set(value, false); err != nil {
+				return nil, fmt.Errorf("%s: %v", field.env, err)
+			}
+		}
+	}
+
+	// the first occurrence of a repeatable flag replaces the list of the config file or the environment
+	given := map[int]bool{}
+	for _, flag := range l.flags {
+		field := fields[flag.index]
+		kind := field.value.Kind()
+		add := given[flag.index]
+		if !add && (kind == reflect.Slice || kind == reflect.Map) {
+			field.value.Set(reflect.Zero(field.value.Type()))
+			add = true
+		}
+		given[flag.index] = true
+		if err := field.set(flag.value, add); err != nil {
+			return nil, fmt.Errorf("%s: %v", field.flag, err)
+		}
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// PrintConfig returns true when --print-config was given
+func (l *ConfigLoader) PrintConfig() bool {
+	return l.print
+}
+
+// readFile reads the settings of the yaml file over c, unknown keys are rejected
+func (c *Config) readFile(file string) error {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+	default:
+		return fmt.Errorf("config file %s must be a .yaml or .yml file", file)
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err = yaml.UnmarshalStrict(data, c); err != nil {
+		return fmt.Errorf("config file %s: %v", file, err)
+	}
+	return nil
+}
+
+// Validate checks every setting and returns an error listing all of the invalid ones
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(validDialect(c.Database.Dialect), "database.dialect %q must be mysql, postgres, sqlite3 or mssql", c.Database.Dialect)
+	check(c.Database.DSN != "", "database.dsn is required")
+	check(c.Database.MaxOpenConns >= 0, "database.max_open_conns must not be negative")
+	check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns must not be negative")
+	check(c.Database.ConnMaxLifetime >= 0, "database.conn_max_lifetime must not be negative")
+	check(c.Database.OperationTimeout >= 0, "database.operation_timeout must not be negative")
+	check(c.Database.PurgeAfterDays >= 0, "database.purge_after_days must not be negative")
+	check(c.Database.PurgeInterval > 0, "database.purge_interval must be positive")
+
+	_, _, err := net.SplitHostPort(c.Server.Listen)
+	check(err == nil, "server.listen %q must be host:port or :port", c.Server.Listen)
+	check((c.Server.TLSCert == "") == (c.Server.TLSKey == ""), "server.tls_cert and server.tls_key must be set together")
+	for _, file := range []string{c.Server.TLSCert, c.Server.TLSKey} {
+		if file != "" {
+			_, err := os.Stat(file)
+			check(err == nil, "tls file %s can not be read: %v", file, err)
+		}
+	}
+	check(c.Server.RequestTimeout >= 0, "server.request_timeout must not be negative")
+	check(c.Server.ShutdownTimeout >= 0, "server.shutdown_timeout must not be negative")
+
+	check(logLevel(c.Log.Level), "log.level %q must be debug, info, warn or error", c.Log.Level)
+	check(c.Log.SlowQueryMs >= 0, "log.slow_query_ms must not be negative")
+	check(c.Log.SQLLogSample >= 0 && c.Log.SQLLogSample <= 1, "log.sql_log_sample must be between 0 and 1")
+
+	check(!c.Swagger.Enabled || c.Swagger.Host != "", "swagger.host is required when the swagger ui is enabled")
+
+	if c.Sandbox.DSN != "" {
+		check(c.Sandbox.Dialect != "", "sandbox.dialect is required along with sandbox.dsn")
+		check(c.Sandbox.MaxTime > 0, "sandbox.max_time must be positive")
+		check(c.Sandbox.MaxRows > 0, "sandbox.max_rows must be positive")
+		check(c.Sandbox.MaxBytes > 0, "sandbox.max_bytes must be positive")
+	}
+
+	check(c.Features.MaxIncludeRecords > 0, "features.max_include_records must be positive")
+
+	tables := make([]string, 0, len(c.Features.CacheControl))
+	for table := range c.Features.CacheControl {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		_, ok := model.GetTableInfo(table)
+		check(ok, "features.cache_control names unknown table %q", table)
+	}
+
+	foreignKeys := make([]string, 0, len(c.Features.DeletePolicies))
+	for foreignKey := range c.Features.DeletePolicies {
+		foreignKeys = append(foreignKeys, foreignKey)
+	}
+	sort.Strings(foreignKeys)
+	for _, foreignKey := range foreignKeys {
+		table, column, err := splitForeignKey(foreignKey)
+		if err == nil {
+			err = model.CheckDeletePolicy(table, column, c.Features.DeletePolicies[foreignKey])
+		}
+		check(err == nil, "features.delete_policies: %v", err)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// splitForeignKey splits a foreign key of features.delete_policies, table.column
+func splitForeignKey(foreignKey string) (table, column string, err error) {
+	parts := strings.Split(foreignKey, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("foreign key %q must be table.column", foreignKey)
+	}
+	return parts[0], parts[1], nil
+}
+
+func validDialect(dialect string) bool {
+	switch dialect {
+	case "mysql", "postgres", "sqlite3", "mssql":
+		return true
+	}
+	return false
+}
+
+func logLevel(level string) bool {
+	switch level {
+	case dao.LevelDebug, dao.LevelInfo, dao.LevelWarn, dao.LevelError:
+		return true
+	}
+	return false
+}
+
+// dsnPasswords match the password of a data source name, user:password@ of mysql and url data source names and
+// password= of key value ones
+var dsnPasswords = []*regexp.Regexp{
+	regexp.MustCompile(`^([^:/@]+:)[^@]*@`),
+	regexp.MustCompile(`(//[^:/@]+:)[^@]*@`),
+	regexp.MustCompile(`(?i)(password=)[^;&\s]*`),
+}
+
+// Print writes c as yaml with the passwords of the data source names masked
+func (c *Config) Print() error {
+	masked := *c
+	masked.Database.DSN = maskDSN(c.Database.DSN)
+	masked.Sandbox.DSN = maskDSN(c.Sandbox.DSN)
+
+	data, err := yaml.Marshal(&masked)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func maskDSN(dsn string) string {
+	for _, password := range dsnPasswords {
+		dsn = password.ReplaceAllStringFunc(dsn, func(match string) string {
+			masked := password.ReplaceAllString(match, "${1}***")
+			if strings.HasSuffix(match, "@") {
+				masked += "@"
+			}
+			return masked
+		})
+	}
+	return dsn
+}
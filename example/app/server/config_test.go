@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// flagIndex returns the index of the field of the setting of flag, as registered by NewConfigLoader
+func flagIndex(t *testing.T, flag string) int {
+	t.Helper()
+
+	for i, field := range DefaultConfig().fields() {
+		if field.flag == flag {
+			return i
+		}
+	}
+	t.Fatalf("no setting has the flag %s", flag)
+	return -1
+}
+
+// setEnv sets the environment variables of env until the test ends
+func setEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+
+	for name, value := range env {
+		saved, ok := os.LookupEnv(name)
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+		name := name
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(name, saved)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+}
+
+func TestConfigLoaderLoad(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		env  map[string]string
+		// flags flag and value pairs in the order given
+		flags []string
+		check func(c *Config) interface{}
+		want  interface{}
+		err   string
+	}{
+		{name: "default", check: func(c *Config) interface{} { return c.Server.Listen }, want: ":80"},
+		{name: "yaml over default", yaml: "server:\n  listen: :81\n",
+			check: func(c *Config) interface{} { return c.Server.Listen }, want: ":81"},
+		{name: "env over default", env: map[string]string{"APP_LISTEN": ":82"},
+			check: func(c *Config) interface{} { return c.Server.Listen }, want: ":82"},
+		{name: "env over yaml", yaml: "server:\n  listen: :81\n", env: map[string]string{"APP_LISTEN": ":82"},
+			check: func(c *Config) interface{} { return c.Server.Listen }, want: ":82"},
+		{name: "flag over env and yaml", yaml: "server:\n  listen: :81\n", env: map[string]string{"APP_LISTEN": ":82"},
+			flags: []string{"--listen", ":83"}, check: func(c *Config) interface{} { return c.Server.Listen }, want: ":83"},
+		{name: "last flag wins", flags: []string{"--listen", ":83", "--listen", ":84"},
+			check: func(c *Config) interface{} { return c.Server.Listen }, want: ":84"},
+		{name: "yaml keeps the unset defaults", yaml: "server:\n  listen: :81\n",
+			check: func(c *Config) interface{} { return c.Server.RequestTimeout }, want: 30},
+
+		{name: "bool env over yaml", yaml: "log:\n  access_log: true\n", env: map[string]string{"APP_ACCESS_LOG": "false"},
+			check: func(c *Config) interface{} { return c.Log.AccessLog }, want: false},
+		{name: "bool flag over env", env: map[string]string{"APP_ACCESS_LOG": "false"}, flags: []string{"--access-log", "true"},
+			check: func(c *Config) interface{} { return c.Log.AccessLog }, want: true},
+
+		{name: "list env replaces yaml", yaml: "log:\n  sql_log_redact: [ssn]\n", env: map[string]string{"APP_SQL_LOG_REDACT": "iban, pin"},
+			check: func(c *Config) interface{} { return c.Log.SQLLogRedact }, want: []string{"iban", "pin"}},
+		{name: "repeated list flag replaces env", env: map[string]string{"APP_SQL_LOG_REDACT": "iban"},
+			flags: []string{"--sql-log-redact", "ssn", "--sql-log-redact", "pin"},
+			check: func(c *Config) interface{} { return c.Log.SQLLogRedact }, want: []string{"ssn", "pin"}},
+		{name: "map env replaces yaml", yaml: "features:\n  cache_control:\n    elevators: max-age=10\n",
+			env:   map[string]string{"APP_CACHE_CONTROL": "customers=no-store; buildings=max-age=5, private"},
+			check: func(c *Config) interface{} { return c.Features.CacheControl },
+			want:  map[string]string{"buildings": "max-age=5, private", "customers": "no-store"}},
+		{name: "repeated map flag replaces yaml", yaml: "features:\n  cache_control:\n    elevators: max-age=10\n",
+			flags: []string{"--cache-control", "customers=no-store", "--cache-control", "batteries=max-age=1"},
+			check: func(c *Config) interface{} { return c.Features.CacheControl },
+			want:  map[string]string{"batteries": "max-age=1", "customers": "no-store"}},
+
+		{name: "unknown key", yaml: "server:\n  listen: :81\n  listen_port: 81\n", err: "field listen_port not found"},
+		{name: "unknown section", yaml: "cache:\n  size: 1\n", err: "field cache not found"},
+		{name: "duplicate key", yaml: "server:\n  listen: :81\n  listen: :82\n", err: "field listen already set"},
+
+		{name: "duration with a unit in yaml", yaml: "server:\n  request_timeout: 30s\n", err: "cannot unmarshal !!str `30s` into int"},
+		{name: "duration with a unit in env", env: map[string]string{"APP_SHUTDOWN_TIMEOUT": "1m"},
+			err: `APP_SHUTDOWN_TIMEOUT: server.shutdown_timeout must be a whole number, got "1m"`},
+		{name: "fractional duration flag", flags: []string{"--operation-timeout", "1.5"},
+			err: `--operation-timeout: database.operation_timeout must be a whole number, got "1.5"`},
+		{name: "negative duration", yaml: "server:\n  request_timeout: -1\n", err: "server.request_timeout must not be negative"},
+		{name: "negative duration flag over a valid yaml", yaml: "database:\n  conn_max_lifetime: 60\n",
+			flags: []string{"--db-conn-max-lifetime", "-5"}, err: "database.conn_max_lifetime must not be negative"},
+		{name: "zero purge interval", env: map[string]string{"APP_PURGE_INTERVAL": "0"}, err: "database.purge_interval must be positive"},
+		{name: "zero max include records", flags: []string{"--max-include-records", "0"}, err: "features.max_include_records must be positive"},
+		{name: "invalid duration fixed by a flag", env: map[string]string{"APP_REQUEST_TIMEOUT": "-1"},
+			flags: []string{"--request-timeout", "10"}, check: func(c *Config) interface{} { return c.Server.RequestTimeout }, want: 10},
+
+		{name: "every problem listed", yaml: "server:\n  request_timeout: -1\n  shutdown_timeout: -1\n",
+			err: "server.request_timeout must not be negative\n  server.shutdown_timeout must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &ConfigLoader{}
+			if tt.yaml != "" {
+				dir, err := ioutil.TempDir("", "config")
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() { os.RemoveAll(dir) })
+
+				l.file = filepath.Join(dir, "config.yaml")
+				if err = ioutil.WriteFile(l.file, []byte(tt.yaml), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			setEnv(t, tt.env)
+			for i := 0; i+1 < len(tt.flags); i += 2 {
+				l.flags = append(l.flags, &configFlag{index: flagIndex(t, tt.flags[i]), value: tt.flags[i+1]})
+			}
+
+			config, err := l.Load()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Load() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if got := tt.check(config); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Load() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigLoaderFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	json := filepath.Join(dir, "config.json")
+	if err = ioutil.WriteFile(json, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		file string
+		err  string
+	}{
+		{"not yaml", json, "must be a .yaml or .yml file"},
+		{"missing", filepath.Join(dir, "missing.yml"), "no such file or directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &ConfigLoader{file: tt.file}
+			if _, err := l.Load(); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Load() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
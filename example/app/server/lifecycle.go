@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"restapi-golang-gin-gen/dao"
+	"restapi-golang-gin-gen/model"
+)
+
+// Workers runs the background tasks of the server until Stop is called
+type Workers struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu sync.Mutex
+
+	// running state of every started worker by name, false once it returned
+	running map[string]bool
+}
+
+// NewWorkers returns Workers with no task started
+func NewWorkers() *Workers {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Workers{ctx: ctx, cancel: cancel, running: map[string]bool{}}
+}
+
+// Start runs run in the background under name, run must return once its ctx is cancelled. A worker returning before
+// Stop, panics included, is reported by Alive.
+func (w *Workers) Start(name string, run func(ctx context.Context)) {
+	w.mu.Lock()
+	w.running[name] = true
+	w.mu.Unlock()
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("worker %s panicked: %v", name, r)
+			}
+			w.mu.Lock()
+			w.running[name] = false
+			w.mu.Unlock()
+		}()
+		run(w.ctx)
+	}()
+}
+
+// Alive returns an error naming the workers which stopped before Stop was called
+func (w *Workers) Alive() error {
+	if w.ctx.Err() != nil {
+		return fmt.Errorf("workers are stopping")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var stopped []string
+	for name, running := range w.running {
+		if !running {
+			stopped = append(stopped, name)
+		}
+	}
+	if len(stopped) > 0 {
+		sort.Strings(stopped)
+		return fmt.Errorf("workers stopped: %s", strings.Join(stopped, ", "))
+	}
+	return nil
+}
+
+// Stop cancels the workers and waits for them to return until ctx is done
+func (w *Workers) Stop(ctx context.Context) error {
+	w.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("workers did not stop in time: %w", ctx.Err())
+	}
+}
+
+// purgeWorker returns the worker permanently deleting the records soft deleted more than days ago every interval
+func purgeWorker(days int, interval time.Duration) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			before := time.Now().UTC().AddDate(0, 0, -days)
+			purgeTables(ctx, model.SoftDeleteTables(), before, func(table *model.TableInfo, purged int64, err error) {
+				switch {
+				case err != nil:
+					log.Printf("purge of %s failed, the error is '%v'", table.Name, err)
+				case purged > 0:
+					log.Printf("purged %d deleted records of %s", purged, table.Name)
+				}
+			})
+		}
+	}
+}
+
+// purgeTables permanently deletes the records of tables deleted before before, referencing tables first, and reports
+// the outcome of every table. It returns false when a table failed to purge.
+func purgeTables(ctx context.Context, tables []*model.TableInfo, before time.Time, report func(table *model.TableInfo, purged int64, err error)) bool {
+	ok := true
+	for _, table := range dao.PurgeOrder(tables) {
+		if ctx.Err() != nil {
+			return false
+		}
+		purged, err := dao.Purge(ctx, table, before)
+		if err != nil {
+			ok = false
+		}
+		report(table, purged, err)
+	}
+	return ok
+}
+
+// migrationsApplied returns the readiness check failing while migrations in dir are not applied, counted with the ctx of
+// the probe so a slow database fails the check within the probe timeout. Once they are applied it no longer queries the
+// database.
+func migrationsApplied(dir string) func(ctx context.Context) error {
+	var applied int32
+	return func(ctx context.Context) error {
+		if atomic.LoadInt32(&applied) == 1 {
+			return nil
+		}
+		pending, err := dao.PendingMigrationCount(ctx, dir)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("%d migrations in %s are not applied", pending, dir)
+		}
+		atomic.StoreInt32(&applied, 1)
+		return nil
+	}
+}
+
+// serve runs server until it is shut down, the error of a server failing to listen or serve is sent to errs
+func serve(server *http.Server, errs chan<- error) {
+	var err error
+	if AppConfig.Server.TLSCert != "" {
+		err = server.ListenAndServeTLS(AppConfig.Server.TLSCert, AppConfig.Server.TLSKey)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
+		errs <- err
+	}
+}
+
+// shutdown stops the server from accepting requests and waits for the requests being served to complete, then stops
+// the workers and closes the database connections. Draining the requests and stopping the workers are each given the
+// shutdown timeout, requests running until the end of theirs still leave the workers the time to return.
+func shutdown(server *http.Server, workers *Workers) error {
+	timeout := time.Duration(AppConfig.Server.ShutdownTimeout) * time.Second
+
+	var failures []string
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	err := server.Shutdown(ctx)
+	cancel()
+	if err != nil {
+		failures = append(failures, fmt.Sprintf("requests were not drained in time, closing their connections: %v", err))
+		server.Close()
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	err = workers.Stop(ctx)
+	cancel()
+	if err != nil {
+		failures = append(failures, err.Error())
+	}
+
+	if dao.SQLSandbox != nil {
+		if err := dao.SQLSandbox.Close(); err != nil {
+			failures = append(failures, fmt.Sprintf("closing the sql sandbox: %v", err))
+		}
+	}
+	if err := dao.DB.Close(); err != nil {
+		failures = append(failures, fmt.Sprintf("closing the database: %v", err))
+	}
+	if sqlLogFile != nil {
+		sqlLogFile.Close()
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("shutdown: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// toggleLogLevel switches the sql log between debug level and the configured level
+func toggleLogLevel() {
+	level := dao.LevelDebug
+	if dao.QueryLogLevel() == dao.LevelDebug {
+		level = AppConfig.Log.Level
+	}
+	if err := dao.SetQueryLogLevel(level); err != nil {
+		log.Printf("Unable to set the log level, the error is '%v'", err)
+		return
+	}
+	log.Printf("sql log level set to %s", level)
+}
+
+// signalName returns the name of a signal handled by LoopForever
+func signalName(sig os.Signal) string {
+	switch sig {
+	case syscall.SIGINT:
+		return "SIGINT"
+	case syscall.SIGTERM:
+		return "SIGTERM"
+	case syscall.SIGUSR1:
+		return "SIGUSR1"
+	default:
+		return sig.String()
+	}
+}
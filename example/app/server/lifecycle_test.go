@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+
+	"restapi-golang-gin-gen/dao"
+)
+
+func TestShutdownDeadlines(t *testing.T) {
+	savedConfig, savedDB := AppConfig, dao.DB
+	t.Cleanup(func() { AppConfig, dao.DB = savedConfig, savedDB })
+
+	AppConfig = DefaultConfig()
+	AppConfig.Server.ShutdownTimeout = 1
+
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dao.DB = db
+
+	// a request running past the shutdown timeout
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	go http.Get("http://" + listener.Addr().String())
+	<-started
+
+	// a worker taking a moment to return once cancelled
+	workers := NewWorkers()
+	workers.Start("slow", func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(100 * time.Millisecond)
+	})
+
+	err = shutdown(server, workers)
+	if err == nil || !strings.Contains(err.Error(), "requests were not drained in time") {
+		t.Errorf("shutdown() error = %v, want the requests not drained", err)
+	}
+	if err != nil && strings.Contains(err.Error(), "workers did not stop in time") {
+		t.Errorf("shutdown() error = %v, the workers were not given their own deadline", err)
+	}
+}
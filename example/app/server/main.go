@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	_ "github.com/jinzhu/gorm/dialects/mssql"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
@@ -22,7 +25,7 @@ import (
 	"restapi-golang-gin-gen/api"
 	"restapi-golang-gin-gen/dao"
 
-	_ "restapi-golang-gin-gen/docs"
+	"restapi-golang-gin-gen/docs"
 	"restapi-golang-gin-gen/model"
 )
 
@@ -47,23 +50,30 @@ var (
 
 	// OsSignal signal used to shutdown
 	OsSignal chan os.Signal
-)
 
-// GinServer launch gin server
-func GinServer() (err error) {
-	url := ginSwagger.URL("http://localhost:8080/swagger/doc.json") // The url pointing to API definition
+	// sqlLogFile file the sql statements are logged to, closed on shutdown
+	sqlLogFile *os.File
 
-	router := gin.Default()
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
+	// AppConfig configuration of the server read from the defaults, config file, environment and flags
+	AppConfig *Config
+)
 
-	api.ConfigGinRouter(router)
-	// router.Run(":8080")
-	router.Run(":80")
-	if err != nil {
-		log.Fatalf("Error starting server, the error is '%v'", err)
+// GinServer returns the http server of the gin router, serving the api on the listen address of AppConfig
+func GinServer() *http.Server {
+	router := gin.New()
+	router.Use(gin.Recovery())
+	if AppConfig.Log.AccessLog {
+		router.Use(gin.LoggerWithConfig(gin.LoggerConfig{SkipPaths: api.ProbePaths}))
 	}
 
-	return
+	if AppConfig.Swagger.Enabled {
+		docs.SwaggerInfo.Host = AppConfig.Swagger.Host
+		url := ginSwagger.URL("http://" + AppConfig.Swagger.Host + "/swagger/doc.json") // The url pointing to API definition
+		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
+	}
+
+	api.ConfigGinRouter(router)
+	return &http.Server{Addr: AppConfig.Server.Listen, Handler: router}
 }
 
 // @title Sample CRUD api for rocket_development db
@@ -84,6 +94,14 @@ func main() {
 	OsSignal = make(chan os.Signal, 1)
 
 	// Define version information
+	dao.AppBuildInfo = &dao.BuildInfo{
+		BuildDate:    BuildDate,
+		LatestCommit: LatestCommit,
+		BuildNumber:  BuildNumber,
+		BuiltOnIP:    BuiltOnIP,
+		BuiltOnOs:    BuiltOnOs,
+		RuntimeVer:   RuntimeVer,
+	}
 	goopt.Version = fmt.Sprintf(
 		`Application build information
   Build date      : %s
@@ -92,58 +110,292 @@ func main() {
   Runtime version : %s
   Built on OS     : %s
 `, BuildDate, BuildNumber, LatestCommit, RuntimeVer, BuiltOnOs)
+	configLoader := NewConfigLoader()
 	goopt.Parse(nil)
 
-	db, err := gorm.Open("mysql", "root@/rocket_development?parseTime=true")
+	var err error
+	if AppConfig, err = configLoader.Load(); err != nil {
+		log.Fatalf("%v", err)
+	}
+	if configLoader.PrintConfig() {
+		if err = AppConfig.Print(); err != nil {
+			log.Fatalf("Unable to print the configuration, the error is '%v'", err)
+		}
+		os.Exit(0)
+	}
+
+	db, err := gorm.Open(AppConfig.Database.Dialect, AppConfig.Database.DSN)
 	if err != nil {
 		log.Fatalf("Got error when connect database, the error is '%v'", err)
 	}
+	db.DB().SetMaxOpenConns(AppConfig.Database.MaxOpenConns)
+	db.DB().SetMaxIdleConns(AppConfig.Database.MaxIdleConns)
+	db.DB().SetConnMaxLifetime(time.Duration(AppConfig.Database.ConnMaxLifetime) * time.Second)
 
-	db.LogMode(true)
 	dao.DB = db
+	if err = configSQLLog(db, &AppConfig.Log); err != nil {
+		log.Fatalf("Invalid sql log configuration, the error is '%v'", err)
+	}
+
+	if AppConfig.Sandbox.DSN != "" {
+		dao.SQLSandbox, err = dao.NewSandbox(dao.SandboxConfig{
+			Dialect:          AppConfig.Sandbox.Dialect,
+			DSN:              AppConfig.Sandbox.DSN,
+			MaxExecutionTime: time.Duration(AppConfig.Sandbox.MaxTime) * time.Second,
+			MaxRows:          AppConfig.Sandbox.MaxRows,
+			MaxResultBytes:   int64(AppConfig.Sandbox.MaxBytes),
+		})
+		if err != nil {
+			log.Fatalf("Got error when connect sql sandbox, the error is '%v'", err)
+		}
+	}
+
+	api.RequestTimeout = time.Duration(AppConfig.Server.RequestTimeout) * time.Second
+	dao.OperationTimeout = time.Duration(AppConfig.Database.OperationTimeout) * time.Second
 
-	db.AutoMigrate(
-		&model.ActiveAdminComments{},
-		&model.ActiveStorageAttachments{},
-		&model.ActiveStorageBlobs{},
-		&model.Addresses{},
-		&model.AdminUsers{},
-		&model.ArInternalMetadata_{},
-		&model.Batteries_{},
-		&model.BlazerAudits_{},
-		&model.BlazerChecks_{},
-		&model.BlazerDashboardQueries_{},
-		&model.BlazerDashboards_{},
-		&model.BlazerQueries_{},
-		&model.BuildingDetails_{},
-		&model.Buildings_{},
-		&model.Columns_{},
-		&model.Customers_{},
-		&model.Elevators_{},
-		&model.Employees{},
-		&model.Interventions_{},
-		&model.Leads{},
-		&model.Maps_{},
-		&model.Quotes{},
-		&model.SchemaMigrations_{},
-		&model.Users_{},
+	api.RequireIfMatch = AppConfig.Features.RequireIfMatch
+
+	api.DefaultCacheControl = AppConfig.Features.DefaultCacheControl
+	for table, directives := range AppConfig.Features.CacheControl {
+		api.CacheControl[table] = directives
+	}
+
+	dao.MaxIncludeRecords = AppConfig.Features.MaxIncludeRecords
+
+	for foreignKey, policy := range AppConfig.Features.DeletePolicies {
+		table, column, _ := splitForeignKey(foreignKey)
+		if err = model.SetDeletePolicy(table, column, policy); err != nil {
+			log.Fatalf("Got error when setting delete policies, the error is '%v'", err)
+		}
+	}
+
+	if AppConfig.Features.BuildingDetailSchema != "" {
+		if err = dao.LoadBuildingDetailSchema(AppConfig.Features.BuildingDetailSchema); err != nil {
+			log.Fatalf("Got error when loading building detail schema, the error is '%v'", err)
+		}
+	}
+
+	if len(goopt.Args) > 0 {
+		os.Exit(RunCommand(goopt.Args))
+	}
+
+	if AppConfig.Database.AutoMigrate {
+		db.AutoMigrate(
+			&model.ActiveAdminComments{},
+			&model.ActiveStorageAttachments{},
+			&model.ActiveStorageBlobs{},
+			&model.Addresses{},
+			&model.AdminUsers{},
+			&model.ArInternalMetadata_{},
+			&model.Batteries_{},
+			&model.BlazerAudits_{},
+			&model.BlazerChecks_{},
+			&model.BlazerDashboardQueries_{},
+			&model.BlazerDashboards_{},
+			&model.BlazerQueries_{},
+			&model.BuildingDetails_{},
+			&model.Buildings_{},
+			&model.Columns_{},
+			&model.Customers_{},
+			&model.Elevators_{},
+			&model.Employees{},
+			&model.Interventions_{},
+			&model.Leads{},
+			&model.Maps_{},
+			&model.Quotes{},
+			&model.SchemaMigrations_{},
+			&model.Users_{},
+			&dao.AuditEntry{},
+		)
+	}
+
+	if pending, err := dao.PendingMigrationCount(context.Background(), AppConfig.Database.MigrationsDir); err != nil {
+		log.Printf("Unable to check migrations in %s, the error is '%v'", AppConfig.Database.MigrationsDir, err)
+	} else if pending > 0 {
+		log.Printf("%d migrations in %s are not applied, run the migrate command", pending, AppConfig.Database.MigrationsDir)
+	}
+
+	workers := NewWorkers()
+	if AppConfig.Database.PurgeAfterDays > 0 {
+		interval := time.Duration(AppConfig.Database.PurgeInterval) * time.Minute
+		workers.Start("purge", purgeWorker(AppConfig.Database.PurgeAfterDays, interval))
+	}
+
+	api.ReadinessChecks = append(api.ReadinessChecks,
+		&api.ReadinessCheck{Name: "migrations", Check: migrationsApplied(AppConfig.Database.MigrationsDir)},
+		&api.ReadinessCheck{Name: "workers", Check: func(ctx context.Context) error { return workers.Alive() }},
 	)
 
-	dao.Logger = func(ctx context.Context, sql string) {
-		fmt.Printf("SQL: %s\n", sql)
+	server := GinServer()
+	serverErrors := make(chan error, 1)
+	go serve(server, serverErrors)
+
+	os.Exit(LoopForever(server, workers, serverErrors))
+}
+
+// configSQLLog sets the dao.Logger writing the sql statements run on db as json lines as set by config
+func configSQLLog(db *gorm.DB, config *LogConfig) error {
+	if err := dao.SetQueryLogLevel(config.Level); err != nil {
+		return err
+	}
+	dao.QueryLogSampleRate = config.SQLLogSample
+	dao.SlowQueryThreshold = time.Duration(config.SlowQueryMs) * time.Millisecond
+	dao.SensitiveColumns = append(dao.SensitiveColumns, config.SQLLogRedact...)
+
+	switch config.SQLLog {
+	case "":
+		return nil
+	case "-":
+		dao.Logger = dao.JSONLogger(os.Stdout)
+	default:
+		f, err := os.OpenFile(config.SQLLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		dao.Logger = dao.JSONLogger(f)
+		sqlLogFile = f
 	}
 
-	go GinServer()
-	LoopForever()
+	dao.LogQueries(db)
+	return nil
 }
 
-// LoopForever on signal processing
-func LoopForever() {
+// RunCommand runs the migration or maintenance command in args and returns the process exit code
+//
+//	migrate                  apply the pending migrations
+//	rollback [n]             revert the last n applied migrations (defaults to 1)
+//	status                   list the migrations and whether they are applied
+//	purge <days> [table...]  permanently delete the records soft deleted more than days ago (defaults to every soft
+//	                         delete table)
+func RunCommand(args []string) int {
+	ctx := context.Background()
+
+	switch args[0] {
+	case "migrate":
+		applied, err := dao.Migrate(ctx, AppConfig.Database.MigrationsDir)
+		for _, migration := range applied {
+			fmt.Printf("applied   %s_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		fmt.Printf("%d migrations applied\n", len(applied))
+
+	case "rollback":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				fmt.Fprintf(os.Stderr, "rollback steps must be a positive number, got %q\n", args[1])
+				return 2
+			}
+			steps = n
+		}
+
+		rolledBack, err := dao.Rollback(ctx, AppConfig.Database.MigrationsDir, steps)
+		for _, migration := range rolledBack {
+			fmt.Printf("reverted  %s_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		fmt.Printf("%d migrations rolled back\n", len(rolledBack))
+
+	case "status":
+		migrations, err := dao.MigrationStatus(ctx, AppConfig.Database.MigrationsDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		for _, migration := range migrations {
+			status := "pending"
+			switch {
+			case migration.Missing:
+				status = "no file"
+			case migration.Applied:
+				status = "applied"
+			}
+			irreversible := ""
+			if !migration.Missing && migration.DownFile == "" {
+				irreversible = " (no down file, can not be rolled back)"
+			}
+			fmt.Printf("%-9s %s %s%s\n", status, migration.Version, migration.Name, irreversible)
+		}
+
+	case "purge":
+		if len(args) < 2 {
+			fmt.Fprintf(os.Stderr, "purge requires the number of days deleted records are kept\n")
+			return 2
+		}
+		days, err := strconv.Atoi(args[1])
+		if err != nil || days < 0 {
+			fmt.Fprintf(os.Stderr, "purge days must be zero or a positive number, got %q\n", args[1])
+			return 2
+		}
+
+		tables := model.SoftDeleteTables()
+		if len(args) > 2 {
+			tables = nil
+			for _, name := range args[2:] {
+				table, ok := model.GetTableInfo(name)
+				if !ok || !table.SoftDelete() {
+					fmt.Fprintf(os.Stderr, "table %q has no soft delete\n", name)
+					return 2
+				}
+				tables = append(tables, table)
+			}
+		}
+
+		before := time.Now().UTC().AddDate(0, 0, -days)
+		ok := purgeTables(ctx, tables, before, func(table *model.TableInfo, purged int64, err error) {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				return
+			}
+			fmt.Printf("purged    %s %d\n", table.Name, purged)
+		})
+		if !ok {
+			return 1
+		}
+
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q, expected migrate, rollback [n], status or purge <days> [table...]\n", args[0])
+		return 2
+	}
+
+	return 0
+}
+
+// LoopForever on signal processing, until SIGINT or SIGTERM shut the server down or the server fails. SIGUSR1
+// toggles the sql log between debug level and the configured level. It returns the process exit code.
+func LoopForever(server *http.Server, workers *Workers, serverErrors <-chan error) int {
 	fmt.Printf("Entering infinite loop\n")
 
 	signal.Notify(OsSignal, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR1)
-	_ = <-OsSignal
+	defer signal.Stop(OsSignal)
 
-	fmt.Printf("Exiting infinite loop received OsSignal\n")
+	code := 0
+	for {
+		select {
+		case err := <-serverErrors:
+			log.Printf("Error running server, the error is '%v'", err)
+			code = 1
 
+		case sig := <-OsSignal:
+			if sig == syscall.SIGUSR1 {
+				toggleLogLevel()
+				continue
+			}
+			fmt.Printf("Exiting infinite loop received OsSignal %s, shutting down\n", signalName(sig))
+		}
+		break
+	}
+
+	if err := shutdown(server, workers); err != nil {
+		log.Printf("%v", err)
+		code = 1
+	}
+	return code
 }
@@ -19,38 +19,47 @@ var (
 // GetAllAdminUsers is a function to get a slice of record(s) from admin_users table in the rocket_development database
 // params - page     - page requested (defaults to 0)
 // params - pagesize - number of records in a page  (defaults to 20)
-// params - order    - db sort order column
-// error - ErrNotFound, db Find error
-func GetAllAdminUsers(ctx context.Context, page, pagesize int64, order string) (results []*model.AdminUsers, totalRows int, err error) {
+// params - query    - sort, filters, cursor and count of the records requested
+// error - ErrReadFailed, db Find error
+// error - db Count error
+func GetAllAdminUsers(ctx context.Context, page, pagesize int64, query *ListQuery) (results []*model.AdminUsers, totalRows int, cursors *PageCursors, err error) {
 
-	resultOrm := DB.Model(&model.AdminUsers{})
-	resultOrm.Count(&totalRows)
+	db, done := session(ctx)
+	defer done(&err)
 
-	if page > 0 {
-		offset := (page - 1) * pagesize
-		resultOrm = resultOrm.Offset(offset).Limit(pagesize)
-	} else {
-		resultOrm = resultOrm.Limit(pagesize)
+	resultOrm := applyQuery(db.Model(&model.AdminUsers{}), query)
+	totalRows = -1
+	if query.Count {
+		if err = resultOrm.Count(&totalRows).Error; err != nil {
+			return nil, -1, nil, err
+		}
 	}
 
-	if order != "" {
-		resultOrm = resultOrm.Order(order)
-	}
+	resultOrm = applyPage(selectFields(resultOrm, query.Fields, queryColumns(query)...), page, pagesize, query)
 
 	if err = resultOrm.Find(&results).Error; err != nil {
-		err = ErrNotFound
-		return nil, -1, err
+		err = readError(err)
+		return nil, -1, nil, err
+	}
+
+	if cursors, err = pageCursors(page, pagesize, query, &results); err != nil {
+		return nil, -1, nil, err
 	}
 
-	return results, totalRows, nil
+	return results, totalRows, cursors, nil
 }
 
 // GetAdminUsers is a function to get a single record from the admin_users table in the rocket_development database
-// error - ErrNotFound, db Find error
-func GetAdminUsers(ctx context.Context, argID int64) (record *model.AdminUsers, err error) {
+// params - fields   - columns to read, every column when empty
+// error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
+func GetAdminUsers(ctx context.Context, argID int64, fields []*model.ColumnInfo) (record *model.AdminUsers, err error) {
 	record = &model.AdminUsers{}
-	if err = DB.First(record, argID).Error; err != nil {
-		err = ErrNotFound
+	db, done := session(ctx)
+	defer done(&err)
+
+	if err = selectFields(db, fields).First(record, argID).Error; err != nil {
+		err = readError(err)
 		return record, err
 	}
 
@@ -58,10 +67,34 @@ func GetAdminUsers(ctx context.Context, argID int64) (record *model.AdminUsers,
 }
 
 // AddAdminUsers is a function to add a single record to admin_users table in the rocket_development database
-// error - ErrInsertFailed, db save call failed
+// error - ErrInsertFailed, db create call failed
+// error - *model.ValidationError, a foreign key column references a missing record
 func AddAdminUsers(ctx context.Context, record *model.AdminUsers) (result *model.AdminUsers, RowsAffected int64, err error) {
-	db := DB.Save(record)
+	if err = setCreated(record, timestampNow()); err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = checkReferences(tx, record, nil); err != nil {
+		return nil, -1, err
+	}
+
+	db := tx.Create(record)
 	if err = db.Error; err != nil {
+		return nil, -1, dbError(err, ErrInsertFailed)
+	}
+
+	if err = writeAudit(ctx, tx, AuditCreate, record, nil); err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	if err = tx.Commit().Error; err != nil {
 		return nil, -1, ErrInsertFailed
 	}
 
@@ -70,21 +103,114 @@ func AddAdminUsers(ctx context.Context, record *model.AdminUsers) (result *model
 
 // UpdateAdminUsers is a function to update a single record from admin_users table in the rocket_development database
 // error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
 // error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+// error - ErrPreconditionFailed, the record does not match the If-Match precondition of ctx
+// error - *model.ValidationError, a foreign key column references a missing record
 func UpdateAdminUsers(ctx context.Context, argID int64, updated *model.AdminUsers) (result *model.AdminUsers, RowsAffected int64, err error) {
 
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
 	result = &model.AdminUsers{}
-	db := DB.First(result, argID)
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).First(result, argID).Error; err != nil {
+		return nil, -1, readError(err)
+	}
+
+	if err = checkIfMatch(ctx, result); err != nil {
+		return nil, -1, err
+	}
+
+	before, err := auditValues(result)
+	if err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = Replace(result, updated); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = setUpdated(result, timestampNow()); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = checkReferences(tx, result, before); err != nil {
+		return nil, -1, err
+	}
+
+	db := tx.Save(result)
 	if err = db.Error; err != nil {
-		return nil, -1, ErrNotFound
+		return nil, -1, dbError(err, ErrUpdateFailed)
 	}
 
-	if err = Copy(result, updated); err != nil {
+	if err = writeAudit(ctx, tx, AuditUpdate, result, before); err != nil {
 		return nil, -1, ErrUpdateFailed
 	}
 
-	db = db.Save(result)
+	if err = tx.Commit().Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	return result, db.RowsAffected, nil
+}
+
+// PatchAdminUsers is a function to apply a json merge patch to a single record from admin_users table in the rocket_development database
+// the patch is applied to the record locked by the update so concurrent changes to the fields it leaves out are kept
+// error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
+// error - ErrBadParams, the patch is not a json object or changes an unknown field
+// error - ErrUpdateFailed, db.Save call failed
+// error - ErrPreconditionFailed, the record does not match the If-Match precondition of ctx
+// error - *model.ValidationError, the patched record is invalid or a foreign key column references a missing record
+func PatchAdminUsers(ctx context.Context, argID int64, patch []byte) (result *model.AdminUsers, RowsAffected int64, err error) {
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	result = &model.AdminUsers{}
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).First(result, argID).Error; err != nil {
+		return nil, -1, readError(err)
+	}
+
+	if err = checkIfMatch(ctx, result); err != nil {
+		return nil, -1, err
+	}
+
+	before, err := auditValues(result)
+	if err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = patchRecord(result, patch); err != nil {
+		return nil, -1, err
+	}
+
+	if err = setUpdated(result, timestampNow()); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = checkReferences(tx, result, before); err != nil {
+		return nil, -1, err
+	}
+
+	db := tx.Save(result)
 	if err = db.Error; err != nil {
+		return nil, -1, dbError(err, ErrUpdateFailed)
+	}
+
+	if err = writeAudit(ctx, tx, AuditUpdate, result, before); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	if err = tx.Commit().Error; err != nil {
 		return nil, -1, ErrUpdateFailed
 	}
 
@@ -92,18 +218,43 @@ func UpdateAdminUsers(ctx context.Context, argID int64, updated *model.AdminUser
 }
 
 // DeleteAdminUsers is a function to delete a single record from admin_users table in the rocket_development database
-// error - ErrNotFound, db Find error
+// error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
 // error - ErrDeleteFailed, db Delete failed error
+// error - ErrPreconditionFailed, the record does not match the If-Match precondition of ctx
+// error - ErrConflict, the record is referenced along a foreign key with the restrict policy
 func DeleteAdminUsers(ctx context.Context, argID int64) (rowsAffected int64, err error) {
 
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return -1, ErrDeleteFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
 	record := &model.AdminUsers{}
-	db := DB.First(record, argID)
-	if db.Error != nil {
-		return -1, ErrNotFound
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).First(record, argID).Error; err != nil {
+		return -1, readError(err)
+	}
+
+	if err = checkIfMatch(ctx, record); err != nil {
+		return -1, err
+	}
+
+	if err = deleteReferences(ctx, tx, record); err != nil {
+		return -1, err
 	}
 
-	db = db.Delete(record)
+	db := tx.Delete(record)
 	if err = db.Error; err != nil {
+		return -1, dbError(err, ErrDeleteFailed)
+	}
+
+	if err = writeAudit(ctx, tx, AuditDelete, record, nil); err != nil {
+		return -1, ErrDeleteFailed
+	}
+
+	if err = tx.Commit().Error; err != nil {
 		return -1, ErrDeleteFailed
 	}
 
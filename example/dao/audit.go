@@ -0,0 +1,266 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// AuditCreate action of an audit entry of an inserted record
+	AuditCreate = "create"
+
+	// AuditUpdate action of an audit entry of an updated record
+	AuditUpdate = "update"
+
+	// AuditDelete action of an audit entry of a deleted record, soft deleted or not
+	AuditDelete = "delete"
+
+	// AuditRestore action of an audit entry of a restored soft deleted record
+	AuditRestore = "restore"
+
+	// AuditPurge action of an audit entry of a soft deleted record permanently deleted by a purge
+	AuditPurge = "purge"
+)
+
+// AuditEntry is a row of the audit_logs table recording a single change of a record
+type AuditEntry struct {
+	ID        int64       `gorm:"primary_key;AUTO_INCREMENT;column:id;type:bigint;" json:"id"`
+	Table     string      `gorm:"column:table_name;type:varchar;size:64;" json:"table" example:"elevators"`
+	RecordID  string      `gorm:"column:record_id;type:varchar;size:64;" json:"record_id" example:"12"`
+	Action    string      `gorm:"column:action;type:varchar;size:16;" json:"action" example:"update"`
+	Principal null.String `gorm:"column:principal;type:varchar;size:255;" json:"principal" swaggertype:"string" example:"user123"`
+	RequestID null.String `gorm:"column:request_id;type:varchar;size:64;" json:"request_id" swaggertype:"string"`
+	CreatedAt time.Time   `gorm:"column:created_at;type:datetime;" json:"created_at"`
+
+	// ChangesJSON the json of Changes as stored in the changes column
+	ChangesJSON string `gorm:"column:changes;type:mediumtext;" json:"-"`
+
+	// Changes the fields changed keyed by json field name
+	Changes map[string]*AuditChange `gorm:"-" json:"changes"`
+}
+
+// TableName sets the insert table name for this struct type
+func (a *AuditEntry) TableName() string {
+	return "audit_logs"
+}
+
+// AuditChange is the value of a field before and after a change, before is null for a created record and after is
+// null for a deleted one
+type AuditChange struct {
+	Before json.RawMessage `json:"before" swaggertype:"object"`
+	After  json.RawMessage `json:"after" swaggertype:"object"`
+}
+
+// AuditFilter narrows the audit entries returned by GetAuditEntries
+type AuditFilter struct {
+	// Table entries of the table, every table when empty
+	Table string
+
+	// RecordID entries of the record of Table with this primary key, every record when empty
+	RecordID string
+
+	// Since entries made at or after Since, every entry when zero
+	Since time.Time
+}
+
+type principalKey struct{}
+
+type requestIDKey struct{}
+
+// WithPrincipal returns ctx carrying the principal making a request, recorded with the changes made by the request
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// Principal returns the principal carried by ctx, empty when there is none
+func Principal(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
+
+// WithRequestID returns ctx carrying the id of a request, recorded with the changes made by the request
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the request id carried by ctx, empty when there is none
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// NewAuditFilter validates the table, primary key and since (a date or RFC 3339 time) narrowing an audit query, every
+// one of them is optional but id requires table
+// error - ErrBadParams, unknown table, id without table or since not a time
+func NewAuditFilter(table, id, since string) (*AuditFilter, error) {
+	filter := &AuditFilter{Table: table, RecordID: id}
+
+	if table != "" {
+		if _, ok := model.GetTableInfo(table); !ok {
+			return nil, fmt.Errorf("%w: unknown table %q", ErrBadParams, table)
+		}
+	} else if id != "" {
+		return nil, fmt.Errorf("%w: id requires table", ErrBadParams)
+	}
+
+	if since != "" {
+		t, ok := parseTime(since)
+		if !ok {
+			return nil, fmt.Errorf("%w: since must be a date or RFC 3339 time", ErrBadParams)
+		}
+		filter.Since = t
+	}
+
+	return filter, nil
+}
+
+// GetAuditEntries is a function to get a page of the audit entries matching filter, oldest first
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// error - ErrReadFailed, db Find error
+// error - db Count error
+func GetAuditEntries(ctx context.Context, filter *AuditFilter, page, pagesize int64) (results []*AuditEntry, totalRows int, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	resultOrm := db.Model(&AuditEntry{})
+	if filter.Table != "" {
+		resultOrm = resultOrm.Where("table_name = ?", filter.Table)
+	}
+	if filter.RecordID != "" {
+		resultOrm = resultOrm.Where("record_id = ?", filter.RecordID)
+	}
+	if !filter.Since.IsZero() {
+		resultOrm = resultOrm.Where("created_at >= ?", filter.Since.UTC())
+	}
+
+	if err = resultOrm.Count(&totalRows).Error; err != nil {
+		return nil, -1, err
+	}
+
+	if page > 0 {
+		resultOrm = resultOrm.Offset((page - 1) * pagesize)
+	}
+
+	if err = resultOrm.Order("id").Limit(pagesize).Find(&results).Error; err != nil {
+		return nil, -1, readError(err)
+	}
+
+	for _, entry := range results {
+		if err = json.Unmarshal([]byte(entry.ChangesJSON), &entry.Changes); err != nil {
+			return nil, -1, fmt.Errorf("audit entry %d: %v", entry.ID, err)
+		}
+	}
+
+	return results, totalRows, nil
+}
+
+// auditValues returns the json of the db columns of record keyed by json field name, created_at and updated_at are
+// left out since every change sets them
+func auditValues(record model.Model) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	values := map[string]json.RawMessage{}
+	for _, col := range record.TableInfo().Columns {
+		if !col.IsDBColumn() || col.Name == createdAtColumn || col.Name == updatedAtColumn {
+			continue
+		}
+		if value, ok := fields[col.JSONFieldName]; ok {
+			values[col.JSONFieldName] = value
+		}
+	}
+	return values, nil
+}
+
+// writeAudit records action on record in the audit log in tx, along with the principal and request id of ctx. before
+// holds the auditValues of record read before an update or restore and is nil otherwise. An update changing no field
+// is not recorded, the values of the SensitiveColumns are recorded redacted.
+func writeAudit(ctx context.Context, tx *gorm.DB, action string, record model.Model, before map[string]json.RawMessage) error {
+	current, err := auditValues(record)
+	if err != nil {
+		return err
+	}
+
+	after := current
+	if action == AuditDelete || action == AuditPurge {
+		before, after = current, nil
+	}
+
+	changes := map[string]*AuditChange{}
+	for _, values := range []map[string]json.RawMessage{before, after} {
+		for name := range values {
+			old, value := before[name], after[name]
+			if !bytes.Equal(auditJSON(old), auditJSON(value)) {
+				changes[name] = &AuditChange{Before: old, After: value}
+			}
+		}
+	}
+	if action == AuditUpdate && len(changes) == 0 {
+		return nil
+	}
+
+	for _, col := range record.TableInfo().Columns {
+		if change, ok := changes[col.JSONFieldName]; ok && sensitiveColumn(col.Name) {
+			change.Before, change.After = redactAudit(change.Before), redactAudit(change.After)
+		}
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	entry := &AuditEntry{
+		Table:       record.TableInfo().Name,
+		Action:      action,
+		Principal:   null.NewString(Principal(ctx), Principal(ctx) != ""),
+		RequestID:   null.NewString(RequestID(ctx), RequestID(ctx) != ""),
+		CreatedAt:   timestampNow(),
+		ChangesJSON: string(data),
+	}
+
+	if pk := record.TableInfo().PrimaryKey(); pk != nil {
+		field := reflect.Indirect(reflect.ValueOf(record)).FieldByName(pk.GoFieldName)
+		if !field.IsValid() {
+			return fmt.Errorf("column %s has no field %s", pk.Name, pk.GoFieldName)
+		}
+		if id := cursorValue(field.Interface()); id != nil {
+			entry.RecordID = *id
+		}
+	}
+
+	return tx.Create(entry).Error
+}
+
+// redactAudit returns the value of a SensitiveColumns field as recorded, redacted unless it is missing or null
+func redactAudit(value json.RawMessage) json.RawMessage {
+	if value == nil || bytes.Equal(value, []byte("null")) {
+		return value
+	}
+	return json.RawMessage(`"` + redacted + `"`)
+}
+
+// auditJSON returns value, or null when the field is missing
+func auditJSON(value json.RawMessage) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+	return value
+}
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+func TestWriteAudit(t *testing.T) {
+	useTestTables(t, "users")
+	user := &model.Users_{Email: "alice@example.com", EncryptedPassword: "hash-1"}
+	if err := DB.Create(user).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithRequestID(WithPrincipal(context.Background(), "alice"), "request-1")
+
+	steps := []struct {
+		name    string
+		ctx     context.Context
+		action  string
+		change  func(u *model.Users_)
+		changes string
+	}{
+		{"create", ctx, AuditCreate, func(u *model.Users_) {},
+			`{"email":{"before":null,"after":"alice@example.com"},"encrypted_password":{"before":null,"after":"[REDACTED]"},"id":{"before":null,"after":1}}`},
+		{"update", ctx, AuditUpdate, func(u *model.Users_) { u.Email = "alice@example.org" },
+			`{"email":{"before":"alice@example.com","after":"alice@example.org"}}`},
+		{"update changing nothing", ctx, AuditUpdate, func(u *model.Users_) {}, ""},
+		{"update of a sensitive field", ctx, AuditUpdate, func(u *model.Users_) { u.EncryptedPassword = "hash-2" },
+			`{"encrypted_password":{"before":"[REDACTED]","after":"[REDACTED]"}}`},
+		{"sensitive field set from null", ctx, AuditUpdate, func(u *model.Users_) { u.ResetPasswordToken = null.StringFrom("token") },
+			`{"reset_password_token":{"before":null,"after":"[REDACTED]"}}`},
+		{"without a principal", context.Background(), AuditUpdate, func(u *model.Users_) { u.ResetPasswordToken = null.String{} },
+			`{"reset_password_token":{"before":"[REDACTED]","after":null}}`},
+		{"delete", ctx, AuditDelete, func(u *model.Users_) {},
+			`{"email":{"before":"alice@example.org","after":null},"encrypted_password":{"before":"[REDACTED]","after":null},"id":{"before":1,"after":null}}`},
+	}
+
+	recorded := 0
+	for _, step := range steps {
+		var before map[string]json.RawMessage
+		if step.action == AuditUpdate {
+			var err error
+			if before, err = auditValues(user); err != nil {
+				t.Fatal(err)
+			}
+		}
+		step.change(user)
+		if err := writeAudit(step.ctx, DB, step.action, user, before); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+
+		entries, _, err := GetAuditEntries(context.Background(), &AuditFilter{Table: "users"}, 0, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if step.changes == "" {
+			if len(entries) != recorded {
+				t.Errorf("%s: recorded an entry, want none", step.name)
+			}
+			continue
+		}
+		recorded++
+		if len(entries) != recorded {
+			t.Fatalf("%s: %d entries, want %d", step.name, len(entries), recorded)
+		}
+
+		entry := entries[recorded-1]
+		if entry.Action != step.action || entry.RecordID != "1" {
+			t.Errorf("%s: entry %s of record %s, want %s of record 1", step.name, entry.Action, entry.RecordID, step.action)
+		}
+		if got := jsonOf(t, entry.Changes); got != step.changes {
+			t.Errorf("%s: changes = %s, want %s", step.name, got, step.changes)
+		}
+
+		principal, requestID := null.StringFrom("alice"), null.StringFrom("request-1")
+		if step.ctx != ctx {
+			principal, requestID = null.String{}, null.String{}
+		}
+		if entry.Principal != principal || entry.RequestID != requestID {
+			t.Errorf("%s: principal %v request id %v, want %v %v", step.name, entry.Principal, entry.RequestID, principal, requestID)
+		}
+	}
+}
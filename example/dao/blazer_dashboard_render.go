@@ -0,0 +1,341 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+	"github.com/jinzhu/gorm"
+)
+
+// BlazerDashboardRender is a dashboard along with its queries in position order
+type BlazerDashboardRender struct {
+	Dashboard *model.BlazerDashboards_     `json:"dashboard"`
+	Queries   []*BlazerDashboardRenderItem `json:"queries"`
+}
+
+// BlazerDashboardRenderItem is a single query placed on a dashboard
+type BlazerDashboardRenderItem struct {
+	DashboardQueryID int64                 `json:"dashboard_query_id"`
+	Position         null.Int              `json:"position"`
+	Query            *model.BlazerQueries_ `json:"query"`
+	Result           *StatementResult      `json:"result,omitempty"`
+}
+
+// GetBlazerDashboardRender is a function to get a dashboard along with its queries sorted by position
+// error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
+func GetBlazerDashboardRender(ctx context.Context, argID int64) (result *BlazerDashboardRender, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	dashboard := &model.BlazerDashboards_{}
+	if err = db.First(dashboard, argID).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	var dashboardQueries []*model.BlazerDashboardQueries_
+	if err = db.Where("dashboard_id = ?", argID).Order("position").Order("id").Find(&dashboardQueries).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	queryIDs := make([]int64, 0, len(dashboardQueries))
+	for _, dq := range dashboardQueries {
+		if dq.QueryID.Valid {
+			queryIDs = append(queryIDs, dq.QueryID.Int64)
+		}
+	}
+
+	queries := make(map[int64]*model.BlazerQueries_)
+	if len(queryIDs) > 0 {
+		var records []*model.BlazerQueries_
+		if err = db.Where("id IN (?)", queryIDs).Find(&records).Error; err != nil {
+			return nil, readError(err)
+		}
+
+		for _, q := range records {
+			queries[q.ID] = q
+		}
+	}
+
+	result = &BlazerDashboardRender{Dashboard: dashboard, Queries: make([]*BlazerDashboardRenderItem, 0, len(dashboardQueries))}
+	for _, dq := range dashboardQueries {
+		result.Queries = append(result.Queries, &BlazerDashboardRenderItem{
+			DashboardQueryID: dq.ID,
+			Position:         dq.Position,
+			Query:            queries[dq.QueryID.Int64],
+		})
+	}
+
+	return result, nil
+}
+
+// RunBlazerDashboardQueries executes every query of a rendered dashboard concurrently, all of them sharing the
+// same timeout, capped at the MaxExecutionTime of the sandbox. A failing query records its error in its result and
+// does not abort the others.
+func RunBlazerDashboardQueries(ctx context.Context, render *BlazerDashboardRender, timeout time.Duration) {
+	if SQLSandbox != nil {
+		if max := SQLSandbox.config.MaxExecutionTime; max > 0 && timeout > max {
+			timeout = max
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, item := range render.Queries {
+		if item.Query == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(item *BlazerDashboardRenderItem) {
+			defer wg.Done()
+			item.Result = RunBlazerStatement(ctx, item.Query.Statement.String)
+		}(item)
+	}
+
+	wg.Wait()
+}
+
+// RunBlazerStatement executes a blazer statement in the sql sandbox
+func RunBlazerStatement(ctx context.Context, statement string) *StatementResult {
+	if SQLSandbox == nil {
+		return &StatementResult{Columns: []string{}, Rows: [][]interface{}{}, Error: ErrSandboxUnavailable.Error()}
+	}
+
+	return SQLSandbox.Query(ctx, statement)
+}
+
+// ReplaceBlazerDashboardQueries is a function to atomically replace the queries placed on a dashboard, queryIDs are
+// stored in the given order.
+// error - ErrNotFound, dashboard for id not found
+// error - ErrBadParams, a query id does not exist
+// error - ErrUpdateFailed, db transaction failed
+func ReplaceBlazerDashboardQueries(ctx context.Context, argID int64, queryIDs []int64) (result *BlazerDashboardRender, err error) {
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = lockBlazerDashboard(tx, argID); err != nil {
+		return nil, err
+	}
+
+	if err = checkBlazerQueriesExist(tx, queryIDs); err != nil {
+		return nil, err
+	}
+
+	var existing []*model.BlazerDashboardQueries_
+	if err = tx.Where("dashboard_id = ?", argID).Order("position").Order("id").Find(&existing).Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	for _, record := range existing {
+		if err = tx.Delete(record).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+		if err = writeAudit(ctx, tx, AuditDelete, record, nil); err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	now := timestampNow()
+	for i, queryID := range queryIDs {
+		record := &model.BlazerDashboardQueries_{
+			DashboardID: null.IntFrom(argID),
+			QueryID:     null.IntFrom(queryID),
+			Position:    null.IntFrom(int64(i)),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err = tx.Create(record).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+		if err = writeAudit(ctx, tx, AuditCreate, record, nil); err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	return GetBlazerDashboardRender(ctx, argID)
+}
+
+// AddBlazerDashboardQuery is a function to place a query on a dashboard at position, the queries at or after
+// position are shifted down. A negative position appends the query.
+// error - ErrNotFound, dashboard for id not found
+// error - ErrBadParams, the query id does not exist
+// error - ErrInsertFailed, db transaction failed
+func AddBlazerDashboardQuery(ctx context.Context, argID, queryID, position int64) (result *BlazerDashboardRender, err error) {
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrInsertFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = lockBlazerDashboard(tx, argID); err != nil {
+		return nil, err
+	}
+
+	if err = checkBlazerQueriesExist(tx, []int64{queryID}); err != nil {
+		return nil, err
+	}
+
+	var existing []*model.BlazerDashboardQueries_
+	if err = tx.Where("dashboard_id = ?", argID).Order("position").Order("id").Find(&existing).Error; err != nil {
+		return nil, ErrInsertFailed
+	}
+
+	if position < 0 || position > int64(len(existing)) {
+		position = int64(len(existing))
+	}
+
+	now := timestampNow()
+	record := &model.BlazerDashboardQueries_{
+		DashboardID: null.IntFrom(argID),
+		QueryID:     null.IntFrom(queryID),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	ordered := make([]*model.BlazerDashboardQueries_, 0, len(existing)+1)
+	ordered = append(ordered, existing[:position]...)
+	ordered = append(ordered, record)
+	ordered = append(ordered, existing[position:]...)
+
+	if err = savePositions(ctx, tx, ordered, now); err != nil {
+		return nil, ErrInsertFailed
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrInsertFailed
+	}
+
+	return GetBlazerDashboardRender(ctx, argID)
+}
+
+// RemoveBlazerDashboardQuery is a function to remove a query from a dashboard, the remaining queries keep their
+// relative order and are renumbered.
+// error - ErrNotFound, dashboard for id not found or query not placed on the dashboard
+// error - ErrDeleteFailed, db transaction failed
+func RemoveBlazerDashboardQuery(ctx context.Context, argID, queryID int64) (result *BlazerDashboardRender, err error) {
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrDeleteFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = lockBlazerDashboard(tx, argID); err != nil {
+		return nil, err
+	}
+
+	var removed []*model.BlazerDashboardQueries_
+	if err = tx.Where("dashboard_id = ? AND query_id = ?", argID, queryID).Order("id").Find(&removed).Error; err != nil {
+		return nil, ErrDeleteFailed
+	}
+	if len(removed) == 0 {
+		return nil, ErrNotFound
+	}
+
+	for _, record := range removed {
+		if err = tx.Delete(record).Error; err != nil {
+			return nil, ErrDeleteFailed
+		}
+		if err = writeAudit(ctx, tx, AuditDelete, record, nil); err != nil {
+			return nil, ErrDeleteFailed
+		}
+	}
+
+	var remaining []*model.BlazerDashboardQueries_
+	if err = tx.Where("dashboard_id = ?", argID).Order("position").Order("id").Find(&remaining).Error; err != nil {
+		return nil, ErrDeleteFailed
+	}
+
+	if err = savePositions(ctx, tx, remaining, timestampNow()); err != nil {
+		return nil, ErrDeleteFailed
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrDeleteFailed
+	}
+
+	return GetBlazerDashboardRender(ctx, argID)
+}
+
+// lockBlazerDashboard loads the dashboard row for update so concurrent edits of the same dashboard serialize
+func lockBlazerDashboard(tx *gorm.DB, argID int64) error {
+	dashboard := &model.BlazerDashboards_{}
+	if err := tx.Set("gorm:query_option", forUpdate(tx)).First(dashboard, argID).Error; err != nil {
+		return readError(err)
+	}
+	return nil
+}
+
+func checkBlazerQueriesExist(tx *gorm.DB, queryIDs []int64) error {
+	if len(queryIDs) == 0 {
+		return nil
+	}
+
+	unique := make(map[int64]bool)
+	for _, id := range queryIDs {
+		unique[id] = true
+	}
+
+	count := 0
+	if err := tx.Model(&model.BlazerQueries_{}).Where("id IN (?)", queryIDs).Count(&count).Error; err != nil {
+		return ErrBadParams
+	}
+	if count != len(unique) {
+		return ErrBadParams
+	}
+	return nil
+}
+
+// savePositions stores records with consecutive positions starting at 0, only touching rows whose position changed,
+// and records every insert and update in the audit log
+func savePositions(ctx context.Context, tx *gorm.DB, records []*model.BlazerDashboardQueries_, now time.Time) error {
+	for i, record := range records {
+		if record.ID != 0 && record.Position.Valid && record.Position.Int64 == int64(i) {
+			continue
+		}
+
+		action, before := AuditCreate, map[string]json.RawMessage(nil)
+		if record.ID != 0 {
+			values, err := auditValues(record)
+			if err != nil {
+				return err
+			}
+			action, before = AuditUpdate, values
+		}
+
+		record.Position = null.IntFrom(int64(i))
+		record.UpdatedAt = now
+		if err := tx.Save(record).Error; err != nil {
+			return err
+		}
+		if err := writeAudit(ctx, tx, action, record, before); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forUpdate returns the row locking clause supported by the db dialect
+func forUpdate(db *gorm.DB) string {
+	if db.Dialect().GetName() == "sqlite3" {
+		return ""
+	}
+	return "FOR UPDATE"
+}
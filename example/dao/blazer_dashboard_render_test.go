@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// useTestDashboard sets DB to a database holding dashboard 1 and the blazer queries 1 to 3, query i selecting i
+func useTestDashboard(t *testing.T) string {
+	t.Helper()
+
+	file := useTestTables(t, "blazer_dashboards", "blazer_queries", "blazer_dashboard_queries")
+	if err := DB.Create(&model.BlazerDashboards_{Name: null.StringFrom("ops")}).Error; err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		query := &model.BlazerQueries_{Name: null.StringFrom(fmt.Sprint("q", i)), Statement: null.StringFrom(fmt.Sprint("SELECT ", i))}
+		if err := DB.Create(query).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return file
+}
+
+// renderedQueryIDs returns the ids of the queries of render in order
+func renderedQueryIDs(render *BlazerDashboardRender) []int64 {
+	ids := []int64{}
+	for _, item := range render.Queries {
+		ids = append(ids, item.Query.ID)
+	}
+	return ids
+}
+
+func TestBlazerDashboardQueries(t *testing.T) {
+	useTestDashboard(t)
+	ctx := context.Background()
+
+	steps := []struct {
+		name string
+		run  func() (*BlazerDashboardRender, error)
+		ids  []int64
+		err  error
+	}{
+		{"replace", func() (*BlazerDashboardRender, error) { return ReplaceBlazerDashboardQueries(ctx, 1, []int64{3, 1}) }, []int64{3, 1}, nil},
+		{"add at the start", func() (*BlazerDashboardRender, error) { return AddBlazerDashboardQuery(ctx, 1, 2, 0) }, []int64{2, 3, 1}, nil},
+		{"add past the end", func() (*BlazerDashboardRender, error) { return AddBlazerDashboardQuery(ctx, 1, 3, 10) }, []int64{2, 3, 1, 3}, nil},
+		{"remove every placement", func() (*BlazerDashboardRender, error) { return RemoveBlazerDashboardQuery(ctx, 1, 3) }, []int64{2, 1}, nil},
+		{"reorder", func() (*BlazerDashboardRender, error) { return ReplaceBlazerDashboardQueries(ctx, 1, []int64{1, 2}) }, []int64{1, 2}, nil},
+		{"render", func() (*BlazerDashboardRender, error) { return GetBlazerDashboardRender(ctx, 1) }, []int64{1, 2}, nil},
+
+		{"replace with an unknown query", func() (*BlazerDashboardRender, error) { return ReplaceBlazerDashboardQueries(ctx, 1, []int64{1, 9}) }, nil, ErrBadParams},
+		{"add an unknown query", func() (*BlazerDashboardRender, error) { return AddBlazerDashboardQuery(ctx, 1, 9, 0) }, nil, ErrBadParams},
+		{"remove a query not placed", func() (*BlazerDashboardRender, error) { return RemoveBlazerDashboardQuery(ctx, 1, 3) }, nil, ErrNotFound},
+		{"unknown dashboard", func() (*BlazerDashboardRender, error) { return AddBlazerDashboardQuery(ctx, 2, 1, 0) }, nil, ErrNotFound},
+		{"render an unknown dashboard", func() (*BlazerDashboardRender, error) { return GetBlazerDashboardRender(ctx, 2) }, nil, ErrNotFound},
+		{"unchanged by the failures", func() (*BlazerDashboardRender, error) { return GetBlazerDashboardRender(ctx, 1) }, []int64{1, 2}, nil},
+	}
+
+	// the steps build on each other
+	for _, step := range steps {
+		render, err := step.run()
+		if !errors.Is(err, step.err) {
+			t.Fatalf("%s: error = %v, want %v", step.name, err, step.err)
+		}
+		if err != nil {
+			continue
+		}
+
+		if got := renderedQueryIDs(render); fmt.Sprint(got) != fmt.Sprint(step.ids) {
+			t.Errorf("%s: queries = %v, want %v", step.name, got, step.ids)
+		}
+		for i, item := range render.Queries {
+			if item.Position != null.IntFrom(int64(i)) {
+				t.Errorf("%s: query %d at position %v, want %d", step.name, item.Query.ID, item.Position, i)
+			}
+		}
+	}
+}
+
+func TestRunBlazerDashboardQueries(t *testing.T) {
+	file := useTestDashboard(t)
+	if _, err := ReplaceBlazerDashboardQueries(context.Background(), 1, []int64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	// query 2 runs until it is cancelled
+	endless := "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT max(x) FROM c"
+	if err := DB.Model(&model.BlazerQueries_{ID: 2}).Update("statement", endless).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	sandbox, err := NewSandbox(SandboxConfig{Dialect: "sqlite3", DSN: "file:" + file + "?mode=ro", MaxExecutionTime: 100 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sandbox.Close() })
+	saved := SQLSandbox
+	t.Cleanup(func() { SQLSandbox = saved })
+	SQLSandbox = sandbox
+
+	render, err := GetBlazerDashboardRender(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the hour asked for is capped at the max execution time of the sandbox
+	start := time.Now()
+	RunBlazerDashboardQueries(context.Background(), render, time.Hour)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("RunBlazerDashboardQueries() took %v, want at most the sandbox max execution time", elapsed)
+	}
+
+	if result := render.Queries[0].Result; result.Error != "" || fmt.Sprint(result.Rows) != "[[1]]" {
+		t.Errorf("query 1 result = %v error %q, want [[1]]", result.Rows, result.Error)
+	}
+	if result := render.Queries[1].Result; result.Error == "" {
+		t.Errorf("query 2 result = %v, want the error of its cancellation", result.Rows)
+	}
+}
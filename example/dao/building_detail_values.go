@@ -0,0 +1,369 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// BuildingDetailKey describes the values accepted for a building detail key
+type BuildingDetailKey struct {
+	// Type of the value, one of string, integer, number, boolean or date (defaults to string)
+	Type string `json:"type"`
+
+	// AllowedValues restricts the value to one of the listed values when not empty
+	AllowedValues []string `json:"allowed_values,omitempty"`
+
+	// Required keys must be present in the details of every building
+	Required bool `json:"required"`
+}
+
+// BuildingDetails key/value view of the building_details rows of a building
+type BuildingDetails map[string]interface{}
+
+// BuildingDetailDate is the value of a building detail of type date, written to json as YYYY-MM-DD
+type BuildingDetailDate struct {
+	time.Time
+}
+
+// MarshalJSON returns the date as a YYYY-MM-DD json string
+func (d BuildingDetailDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(buildingDetailDateLayout))
+}
+
+var (
+	// BuildingDetailSchema per key schema applied to building details, keys without a schema are stored as strings
+	BuildingDetailSchema = map[string]*BuildingDetailKey{}
+
+	buildingDetailValueLength = 255
+
+	// buildingDetailDateLayout layout of the values of the building details of type date
+	buildingDetailDateLayout = "2006-01-02"
+)
+
+// LoadBuildingDetailSchema reads a json object of key to BuildingDetailKey into BuildingDetailSchema
+func LoadBuildingDetailSchema(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	schema := map[string]*BuildingDetailKey{}
+	if err = json.Unmarshal(data, &schema); err != nil {
+		return err
+	}
+
+	for key, spec := range schema {
+		switch spec.Type {
+		case "":
+			spec.Type = "string"
+		case "string", "integer", "number", "boolean", "date":
+		default:
+			return fmt.Errorf("building detail %q has unknown type %q", key, spec.Type)
+		}
+	}
+
+	BuildingDetailSchema = schema
+	return nil
+}
+
+// GetBuildingDetails is a function to get the details of a building as a key/value object
+// error - ErrNotFound, building for id not found
+// error - ErrReadFailed, db Find error
+func GetBuildingDetails(ctx context.Context, argID int64) (result BuildingDetails, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	if err = db.First(&model.Buildings_{}, argID).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	var records []*model.BuildingDetails_
+	if err = db.Where("building_id = ?", argID).Order("id").Find(&records).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	result = BuildingDetails{}
+	for _, record := range records {
+		if !record.InformationKey.Valid {
+			continue
+		}
+		result[record.InformationKey.String] = typedBuildingDetail(record.InformationKey.String, record.Value)
+	}
+
+	return result, nil
+}
+
+// ReplaceBuildingDetails is a function to replace every detail of a building in a single transaction, null values
+// are ignored. Every removed and added detail is recorded in the audit log.
+// error - ErrNotFound, building for id not found
+// error - ErrReadFailed, db Find error
+// error - ErrBadParams, a value does not match the building detail schema
+// error - ErrUpdateFailed, db transaction failed
+func ReplaceBuildingDetails(ctx context.Context, argID int64, details BuildingDetails) (result BuildingDetails, err error) {
+	values := map[string]string{}
+	for key, value := range details {
+		if value == nil {
+			continue
+		}
+		if values[key], err = buildingDetailString(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = checkRequiredBuildingDetails(values); err != nil {
+		return nil, err
+	}
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).First(&model.Buildings_{}, argID).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	var existing []*model.BuildingDetails_
+	if err = tx.Where("building_id = ?", argID).Order("id").Find(&existing).Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	for _, record := range existing {
+		if err = tx.Delete(record).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+		if err = writeAudit(ctx, tx, AuditDelete, record, nil); err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	now := timestampNow()
+	for _, key := range sortedKeys(values) {
+		record := &model.BuildingDetails_{
+			BuildingID:     null.IntFrom(argID),
+			InformationKey: null.StringFrom(key),
+			Value:          null.StringFrom(values[key]),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err = tx.Create(record).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+		if err = writeAudit(ctx, tx, AuditCreate, record, nil); err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	return GetBuildingDetails(ctx, argID)
+}
+
+// MergeBuildingDetails is a function to merge keys into the details of a building in a single transaction, a null
+// value removes the key. Every removed and added detail is recorded in the audit log.
+// error - ErrNotFound, building for id not found
+// error - ErrReadFailed, db Find error
+// error - ErrBadParams, a value does not match the building detail schema
+// error - ErrUpdateFailed, db transaction failed
+func MergeBuildingDetails(ctx context.Context, argID int64, details BuildingDetails) (result BuildingDetails, err error) {
+	values := map[string]string{}
+	for key, value := range details {
+		if value == nil {
+			continue
+		}
+		if values[key], err = buildingDetailString(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).First(&model.Buildings_{}, argID).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	var existing []*model.BuildingDetails_
+	if err = tx.Where("building_id = ?", argID).Order("id").Find(&existing).Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	merged := map[string]string{}
+	for _, record := range existing {
+		key := record.InformationKey.String
+		if _, ok := details[key]; ok {
+			if err = tx.Delete(record).Error; err != nil {
+				return nil, ErrUpdateFailed
+			}
+			if err = writeAudit(ctx, tx, AuditDelete, record, nil); err != nil {
+				return nil, ErrUpdateFailed
+			}
+			continue
+		}
+		merged[key] = record.Value.String
+	}
+
+	for key, value := range values {
+		merged[key] = value
+	}
+
+	if err = checkRequiredBuildingDetails(merged); err != nil {
+		return nil, err
+	}
+
+	now := timestampNow()
+	for _, key := range sortedKeys(values) {
+		record := &model.BuildingDetails_{
+			BuildingID:     null.IntFrom(argID),
+			InformationKey: null.StringFrom(key),
+			Value:          null.StringFrom(values[key]),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err = tx.Create(record).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+		if err = writeAudit(ctx, tx, AuditCreate, record, nil); err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	return GetBuildingDetails(ctx, argID)
+}
+
+// buildingDetailString validates value against the schema of key and returns the string stored in the Value column
+func buildingDetailString(key string, value interface{}) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("%w: building detail key must not be empty", ErrBadParams)
+	}
+
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		str = strconv.FormatBool(v)
+	default:
+		return "", fmt.Errorf("%w: building detail %q must be a scalar value", ErrBadParams, key)
+	}
+
+	spec := BuildingDetailSchema[key]
+	if spec != nil {
+		var err error
+		switch spec.Type {
+		case "integer":
+			var i int64
+			i, err = strconv.ParseInt(str, 10, 64)
+			str = strconv.FormatInt(i, 10)
+		case "number":
+			var f float64
+			f, err = strconv.ParseFloat(str, 64)
+			str = strconv.FormatFloat(f, 'f', -1, 64)
+		case "boolean":
+			var b bool
+			b, err = strconv.ParseBool(str)
+			str = strconv.FormatBool(b)
+		case "date":
+			var d time.Time
+			d, err = time.Parse(buildingDetailDateLayout, str)
+			str = d.Format(buildingDetailDateLayout)
+		}
+		if err != nil {
+			return "", fmt.Errorf("%w: building detail %q must be of type %s", ErrBadParams, key, spec.Type)
+		}
+
+		if len(spec.AllowedValues) > 0 && !containsString(spec.AllowedValues, str) {
+			return "", fmt.Errorf("%w: building detail %q must be one of %v", ErrBadParams, key, spec.AllowedValues)
+		}
+	}
+
+	if len(str) > buildingDetailValueLength {
+		return "", fmt.Errorf("%w: building detail %q is longer than %d characters", ErrBadParams, key, buildingDetailValueLength)
+	}
+	return str, nil
+}
+
+// typedBuildingDetail converts a stored value back to the json type declared in the schema of key, a date is returned
+// as a BuildingDetailDate
+func typedBuildingDetail(key string, value null.String) interface{} {
+	if !value.Valid {
+		return nil
+	}
+
+	spec := BuildingDetailSchema[key]
+	if spec == nil {
+		return value.String
+	}
+
+	switch spec.Type {
+	case "integer":
+		if i, err := strconv.ParseInt(value.String, 10, 64); err == nil {
+			return i
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(value.String, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value.String); err == nil {
+			return b
+		}
+	case "date":
+		if d, err := time.Parse(buildingDetailDateLayout, value.String); err == nil {
+			return BuildingDetailDate{d}
+		}
+	}
+	return value.String
+}
+
+func checkRequiredBuildingDetails(values map[string]string) error {
+	for key, spec := range BuildingDetailSchema {
+		if _, ok := values[key]; spec.Required && !ok {
+			return fmt.Errorf("%w: building detail %q is required", ErrBadParams, key)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
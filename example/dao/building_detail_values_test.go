@@ -0,0 +1,255 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// useBuildingDetailSchema sets the BuildingDetailSchema to schema until the test ends
+func useBuildingDetailSchema(t *testing.T, schema map[string]*BuildingDetailKey) {
+	t.Helper()
+
+	saved := BuildingDetailSchema
+	t.Cleanup(func() { BuildingDetailSchema = saved })
+	BuildingDetailSchema = schema
+}
+
+func TestBuildingDetailString(t *testing.T) {
+	useBuildingDetailSchema(t, map[string]*BuildingDetailKey{
+		"floors":  {Type: "integer"},
+		"area":    {Type: "number"},
+		"green":   {Type: "boolean"},
+		"built":   {Type: "date"},
+		"heating": {Type: "string", AllowedValues: []string{"gas", "electric"}},
+		"levels":  {Type: "integer", AllowedValues: []string{"1", "2"}},
+	})
+
+	tests := []struct {
+		name  string
+		key   string
+		value interface{}
+		want  string
+		err   error
+	}{
+		{"no schema string", "note", "hello", "hello", nil},
+		{"no schema number", "note", json.Number("1.50"), "1.50", nil},
+		{"no schema bool", "note", true, "true", nil},
+		{"integer", "floors", json.Number("12"), "12", nil},
+		{"integer from string", "floors", "12", "12", nil},
+		{"integer with a fraction", "floors", json.Number("1.5"), "", ErrBadParams},
+		{"number", "area", json.Number("1.50"), "1.5", nil},
+		{"number from float", "area", 2.25, "2.25", nil},
+		{"not a number", "area", "big", "", ErrBadParams},
+		{"boolean", "green", "TRUE", "true", nil},
+		{"not a boolean", "green", "yes", "", ErrBadParams},
+		{"date", "built", "2021-06-01", "2021-06-01", nil},
+		{"date with a time", "built", "2021-06-01T10:00:00Z", "", ErrBadParams},
+		{"invalid date", "built", "2021-02-30", "", ErrBadParams},
+		{"allowed value", "heating", "gas", "gas", nil},
+		{"value not allowed", "heating", "oil", "", ErrBadParams},
+		{"allowed values compared once normalized", "levels", json.Number("2"), "2", nil},
+		{"empty key", "", "x", "", ErrBadParams},
+		{"object", "note", map[string]interface{}{"a": 1}, "", ErrBadParams},
+		{"array", "note", []interface{}{1}, "", ErrBadParams},
+		{"longest value", "note", strings.Repeat("x", 255), strings.Repeat("x", 255), nil},
+		{"value too long", "note", strings.Repeat("x", 256), "", ErrBadParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildingDetailString(tt.key, tt.value)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("buildingDetailString(%q, %v) error = %v, want %v", tt.key, tt.value, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("buildingDetailString(%q, %v) = %q, want %q", tt.key, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypedBuildingDetail(t *testing.T) {
+	useBuildingDetailSchema(t, map[string]*BuildingDetailKey{
+		"floors": {Type: "integer"},
+		"area":   {Type: "number"},
+		"green":  {Type: "boolean"},
+		"built":  {Type: "date"},
+	})
+
+	tests := []struct {
+		key   string
+		value null.String
+		json  string
+	}{
+		{"note", null.StringFrom("12"), `"12"`},
+		{"floors", null.StringFrom("12"), `12`},
+		{"area", null.StringFrom("1.5"), `1.5`},
+		{"green", null.StringFrom("true"), `true`},
+		{"built", null.StringFrom("2021-06-01"), `"2021-06-01"`},
+		// a value stored before the schema declared its type is returned as it is
+		{"floors", null.StringFrom("many"), `"many"`},
+		{"built", null.StringFrom("June"), `"June"`},
+		{"floors", null.String{}, `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+" "+tt.value.String, func(t *testing.T) {
+			value := typedBuildingDetail(tt.key, tt.value)
+			data, err := json.Marshal(value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.json {
+				t.Errorf("typedBuildingDetail(%q, %q) = %s, want %s", tt.key, tt.value.String, data, tt.json)
+			}
+		})
+	}
+
+	if d, ok := typedBuildingDetail("built", null.StringFrom("2021-06-01")).(BuildingDetailDate); !ok || d.Year() != 2021 || d.Month() != 6 || d.Day() != 1 {
+		t.Errorf("typedBuildingDetail(built) = %v, want the BuildingDetailDate 2021-06-01", d)
+	}
+}
+
+func TestLoadBuildingDetailSchema(t *testing.T) {
+	saved := BuildingDetailSchema
+	t.Cleanup(func() { BuildingDetailSchema = saved })
+
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{"valid", `{"floors": {"type": "integer", "required": true}, "heating": {"allowed_values": ["gas"]}}`, ""},
+		{"unknown type", `{"floors": {"type": "float"}}`, `building detail "floors" has unknown type "float"`},
+		{"not json", `floors: integer`, "invalid character"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, "schema.json")
+			if err := ioutil.WriteFile(file, []byte(tt.schema), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			BuildingDetailSchema = map[string]*BuildingDetailKey{}
+			err := LoadBuildingDetailSchema(file)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("LoadBuildingDetailSchema() error = %v, want %q", err, tt.err)
+				}
+				if len(BuildingDetailSchema) != 0 {
+					t.Errorf("LoadBuildingDetailSchema() set the schema of an invalid file")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadBuildingDetailSchema() error = %v", err)
+			}
+			if spec := BuildingDetailSchema["heating"]; spec == nil || spec.Type != "string" {
+				t.Errorf("heating = %+v, want the default string type", spec)
+			}
+		})
+	}
+}
+
+// auditActions returns the actions recorded in the audit log for table, in order
+func auditActions(t *testing.T, table string) []string {
+	t.Helper()
+
+	var entries []*AuditEntry
+	if err := DB.Where("table_name = ?", table).Order("id").Find(&entries).Error; err != nil {
+		t.Fatal(err)
+	}
+	actions := []string{}
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+	}
+	return actions
+}
+
+func TestBuildingDetails(t *testing.T) {
+	useTestTables(t, "buildings", "building_details")
+	useBuildingDetailSchema(t, map[string]*BuildingDetailKey{
+		"floors": {Type: "integer", Required: true},
+		"built":  {Type: "date"},
+	})
+	if err := DB.Create(&model.Buildings_{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	steps := []struct {
+		name  string
+		run   func() (BuildingDetails, error)
+		want  string
+		err   error
+		audit []string
+	}{
+		{"empty", func() (BuildingDetails, error) { return GetBuildingDetails(ctx, 1) }, `{}`, nil, []string{}},
+		{"replace", func() (BuildingDetails, error) {
+			return ReplaceBuildingDetails(ctx, 1, BuildingDetails{"floors": json.Number("3"), "built": "2021-06-01", "heating": "gas", "skipped": nil})
+		}, `{"built":"2021-06-01","floors":3,"heating":"gas"}`, nil, []string{AuditCreate, AuditCreate, AuditCreate}},
+		{"merge", func() (BuildingDetails, error) {
+			return MergeBuildingDetails(ctx, 1, BuildingDetails{"heating": nil, "floors": json.Number("4"), "cooling": "central"})
+		}, `{"built":"2021-06-01","cooling":"central","floors":4}`, nil, []string{AuditDelete, AuditDelete, AuditCreate, AuditCreate}},
+		{"replace without a required key", func() (BuildingDetails, error) {
+			return ReplaceBuildingDetails(ctx, 1, BuildingDetails{"built": "2021-06-01"})
+		}, "", ErrBadParams, nil},
+		{"merge removing a required key", func() (BuildingDetails, error) {
+			return MergeBuildingDetails(ctx, 1, BuildingDetails{"floors": nil})
+		}, "", ErrBadParams, nil},
+		{"merge an invalid value", func() (BuildingDetails, error) {
+			return MergeBuildingDetails(ctx, 1, BuildingDetails{"built": "yesterday"})
+		}, "", ErrBadParams, nil},
+		{"unknown building", func() (BuildingDetails, error) {
+			return MergeBuildingDetails(ctx, 2, BuildingDetails{"cooling": "none"})
+		}, "", ErrNotFound, nil},
+		{"unchanged by the failures", func() (BuildingDetails, error) { return GetBuildingDetails(ctx, 1) },
+			`{"built":"2021-06-01","cooling":"central","floors":4}`, nil, []string{}},
+		{"replace every key", func() (BuildingDetails, error) {
+			return ReplaceBuildingDetails(ctx, 1, BuildingDetails{"floors": json.Number("5")})
+		}, `{"floors":5}`, nil, []string{AuditDelete, AuditDelete, AuditDelete, AuditCreate}},
+	}
+
+	audited := 0
+	for _, step := range steps {
+		details, err := step.run()
+		if !errors.Is(err, step.err) {
+			t.Fatalf("%s: error = %v, want %v", step.name, err, step.err)
+		}
+
+		actions := auditActions(t, "building_details")
+		if step.audit != nil && fmt.Sprint(actions[audited:]) != fmt.Sprint(step.audit) {
+			t.Errorf("%s: audited %v, want %v", step.name, actions[audited:], step.audit)
+		}
+		audited = len(actions)
+		if err != nil {
+			continue
+		}
+
+		data, err := json.Marshal(details)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != step.want {
+			t.Errorf("%s: details = %s, want %s", step.name, data, step.want)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// MaxBulkOperations is the largest number of operations accepted in a single bulk request
+var MaxBulkOperations = 1000
+
+// BulkOperation is the create, update or delete of a single record in a bulk request
+type BulkOperation struct {
+	// Op one of create, update or delete
+	Op string `json:"op" example:"create"`
+
+	// ID primary key of the record to update or delete
+	ID interface{} `json:"id,omitempty" swaggertype:"string" example:"12"`
+
+	// Record the record to create, or the full replacement of the record to update
+	Record json.RawMessage `json:"record,omitempty" swaggertype:"object"`
+}
+
+// BulkResult is the outcome of a single operation of a bulk request
+type BulkResult struct {
+	Index int         `json:"index"`
+	Op    string      `json:"op"`
+	ID    interface{} `json:"id,omitempty" swaggertype:"string"`
+	OK    bool        `json:"ok"`
+	Error string      `json:"error,omitempty"`
+
+	// Record the created or updated record
+	Record interface{} `json:"record,omitempty" swaggertype:"object"`
+}
+
+// BulkResults is the outcome of a bulk request, results are in the order of the operations
+type BulkResults struct {
+	Committed bool          `json:"committed"`
+	Succeeded int           `json:"succeeded"`
+	Failed    int           `json:"failed"`
+	Results   []*BulkResult `json:"results"`
+}
+
+var (
+	errBulkRolledBack = errors.New("rolled back")
+	errBulkSkipped    = errors.New("not run, an earlier operation failed")
+)
+
+// Bulk runs operations on records of table in a single transaction. The first failed operation rolls back the whole
+// transaction unless continueOnError is set, every operation then runs in a savepoint of its own and only failed
+// operations are rolled back. prepare is invoked with every record before it is created or updated.
+// error - ErrBadParams, no operations or more than MaxBulkOperations
+// error - ErrUpdateFailed, db transaction failed
+func Bulk(ctx context.Context, table *model.TableInfo, operations []*BulkOperation, continueOnError bool, prepare func(record model.Model, action model.Action) error) (results *BulkResults, err error) {
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("%w: no operations", ErrBadParams)
+	}
+	if len(operations) > MaxBulkOperations {
+		return nil, fmt.Errorf("%w: more than %d operations", ErrBadParams, MaxBulkOperations)
+	}
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	results = &BulkResults{}
+	for i, operation := range operations {
+		result := &BulkResult{Index: i, Op: operation.Op, ID: operation.ID}
+		results.Results = append(results.Results, result)
+
+		if continueOnError {
+			if err = tx.Exec(savepointSQL(tx, "bulk_operation")).Error; err != nil {
+				return nil, ErrUpdateFailed
+			}
+		}
+
+		opErr := runBulkOperation(ctx, tx, table, operation, prepare, result)
+		if opErr == nil {
+			result.OK = true
+			results.Succeeded++
+			continue
+		}
+
+		result.Error = opErr.Error()
+		results.Failed++
+		if !continueOnError {
+			for _, done := range results.Results[:i] {
+				done.OK, done.Error, done.Record = false, errBulkRolledBack.Error(), nil
+				if done.Op == "create" {
+					done.ID = nil
+				}
+			}
+			for j, skipped := range operations[i+1:] {
+				results.Results = append(results.Results, &BulkResult{Index: i + 1 + j, Op: skipped.Op, ID: skipped.ID, Error: errBulkSkipped.Error()})
+			}
+			results.Succeeded, results.Failed = 0, len(operations)
+			return results, nil
+		}
+
+		if err = tx.Exec(rollbackToSavepointSQL(tx, "bulk_operation")).Error; err != nil {
+			return nil, ErrUpdateFailed
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, ErrUpdateFailed
+	}
+
+	results.Committed = true
+	return results, nil
+}
+
+// runBulkOperation runs a single operation of a bulk request in tx and records the id and record in result, the change
+// is recorded in the audit log along with the principal and request id of ctx
+func runBulkOperation(ctx context.Context, tx *gorm.DB, table *model.TableInfo, operation *BulkOperation, prepare func(record model.Model, action model.Action) error, result *BulkResult) error {
+	pk := table.PrimaryKey()
+
+	switch operation.Op {
+	case "create":
+		record, err := decodeBulkRecord(table, operation, model.Create, prepare)
+		if err != nil {
+			return err
+		}
+		if err = setCreated(record, timestampNow()); err != nil {
+			return ErrInsertFailed
+		}
+		if err = checkReferences(tx, record, nil); err != nil {
+			return err
+		}
+		if err = tx.Create(record).Error; err != nil {
+			return dbError(err, ErrInsertFailed)
+		}
+		if err = writeAudit(ctx, tx, AuditCreate, record, nil); err != nil {
+			return ErrInsertFailed
+		}
+		result.ID = reflect.Indirect(reflect.ValueOf(record)).FieldByName(pk.GoFieldName).Interface()
+		result.Record = record
+		return nil
+
+	case "update":
+		existing, err := findBulkRecord(tx, table, operation)
+		if err != nil {
+			return err
+		}
+		record, err := decodeBulkRecord(table, operation, model.Update, prepare)
+		if err != nil {
+			return err
+		}
+		before, err := auditValues(existing)
+		if err != nil {
+			return ErrUpdateFailed
+		}
+		if err = Replace(existing, record); err != nil {
+			return ErrUpdateFailed
+		}
+		if err = setUpdated(existing, timestampNow()); err != nil {
+			return ErrUpdateFailed
+		}
+		if err = checkReferences(tx, existing, before); err != nil {
+			return err
+		}
+		if err = tx.Save(existing).Error; err != nil {
+			return dbError(err, ErrUpdateFailed)
+		}
+		if err = writeAudit(ctx, tx, AuditUpdate, existing, before); err != nil {
+			return ErrUpdateFailed
+		}
+		result.Record = existing
+		return nil
+
+	case "delete":
+		existing, err := findBulkRecord(tx, table, operation)
+		if err != nil {
+			return err
+		}
+		if err = deleteReferences(ctx, tx, existing); err != nil {
+			return err
+		}
+		if err = tx.Delete(existing).Error; err != nil {
+			return dbError(err, ErrDeleteFailed)
+		}
+		if err = writeAudit(ctx, tx, AuditDelete, existing, nil); err != nil {
+			return ErrDeleteFailed
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("%w: unknown op %q, expected create, update or delete", ErrBadParams, operation.Op)
+	}
+}
+
+// decodeBulkRecord decodes the record of a create or update operation and prepares it for action
+func decodeBulkRecord(table *model.TableInfo, operation *BulkOperation, action model.Action, prepare func(record model.Model, action model.Action) error) (model.Model, error) {
+	if len(operation.Record) == 0 {
+		return nil, fmt.Errorf("%w: record is required", ErrBadParams)
+	}
+
+	record, ok := model.NewModel(table.Name)
+	if !ok {
+		return nil, fmt.Errorf("no model for table %s", table.Name)
+	}
+
+	if err := json.Unmarshal(operation.Record, record); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadParams, err)
+	}
+
+	if err := prepare(record, action); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// findBulkRecord locks and reads the record an update or delete operation applies to
+func findBulkRecord(tx *gorm.DB, table *model.TableInfo, operation *BulkOperation) (model.Model, error) {
+	pk := table.PrimaryKey()
+	if operation.ID == nil {
+		return nil, fmt.Errorf("%w: id is required", ErrBadParams)
+	}
+
+	id, err := parseColumnValue(pk, columnKind(pk), fmt.Sprint(operation.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	record, ok := model.NewModel(table.Name)
+	if !ok {
+		return nil, fmt.Errorf("no model for table %s", table.Name)
+	}
+
+	if err = tx.Set("gorm:query_option", forUpdate(tx)).Where(tx.Dialect().Quote(pk.Name)+" = ?", id).First(record).Error; err != nil {
+		return nil, readError(err)
+	}
+	return record, nil
+}
+
+// savepointSQL returns the statement creating a savepoint named name in the dialect of db
+func savepointSQL(db *gorm.DB, name string) string {
+	if db.Dialect().GetName() == "mssql" {
+		return "SAVE TRANSACTION " + name
+	}
+	return "SAVEPOINT " + name
+}
+
+// rollbackToSavepointSQL returns the statement rolling back to the savepoint named name in the dialect of db
+func rollbackToSavepointSQL(db *gorm.DB, name string) string {
+	if db.Dialect().GetName() == "mssql" {
+		return "ROLLBACK TRANSACTION " + name
+	}
+	return "ROLLBACK TO SAVEPOINT " + name
+}
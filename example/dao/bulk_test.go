@@ -0,0 +1,213 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// prepareBulkRecord prepares and validates the records of the bulk tests as the api does
+func prepareBulkRecord(record model.Model, action model.Action) error {
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+	record.Prepare()
+	return record.Validate(action)
+}
+
+// useBulkTables sets DB to a database holding column 1 and the elevators 1 and 2 of column 1
+func useBulkTables(t *testing.T) *model.TableInfo {
+	t.Helper()
+
+	useTestTables(t, "columns", "elevators", "interventions")
+	for _, record := range []interface{}{
+		&model.Columns_{},
+		&model.Elevators_{ColumnID: null.IntFrom(1), Status: null.StringFrom("Active")},
+		&model.Elevators_{ColumnID: null.IntFrom(1), Status: null.StringFrom("Active")},
+	} {
+		if err := DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	table, _ := model.GetTableInfo("elevators")
+	return table
+}
+
+// storedElevators returns the id and status of every elevator stored, in id order
+func storedElevators(t *testing.T) string {
+	t.Helper()
+
+	var elevators []*model.Elevators_
+	if err := DB.Order("id").Find(&elevators).Error; err != nil {
+		t.Fatal(err)
+	}
+	var stored []string
+	for _, e := range elevators {
+		stored = append(stored, fmt.Sprintf("%d:%s", e.ID, e.Status.String))
+	}
+	return strings.Join(stored, " ")
+}
+
+// bulkOperations decodes the operations of a bulk request body
+func bulkOperations(t *testing.T, operations string) []*BulkOperation {
+	t.Helper()
+
+	var decoded []*BulkOperation
+	if err := json.Unmarshal([]byte(operations), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+// bulkOutcome summarizes results as the ok or error of every operation
+func bulkOutcome(results *BulkResults) string {
+	var outcome []string
+	for _, result := range results.Results {
+		if result.OK {
+			outcome = append(outcome, "ok")
+			continue
+		}
+		outcome = append(outcome, result.Error)
+	}
+	return strings.Join(outcome, "; ")
+}
+
+func TestBulk(t *testing.T) {
+	tests := []struct {
+		name            string
+		operations      string
+		continueOnError bool
+		committed       bool
+		succeeded       int
+		failed          int
+		outcome         string
+		stored          string
+		audited         []string
+	}{
+		{"all succeed", `[
+			{"op": "create", "record": {"column_id": 1, "status": "Inactive"}},
+			{"op": "update", "id": 1, "record": {"column_id": 1, "status": "Intervention"}},
+			{"op": "delete", "id": "2"}
+		]`, false, true, 3, 0, "ok; ok; ok", "1:Intervention 3:Inactive", []string{AuditCreate, AuditUpdate, AuditDelete}},
+
+		{"a failure rolls back every operation", `[
+			{"op": "create", "record": {"column_id": 1, "status": "Inactive"}},
+			{"op": "update", "id": 9, "record": {"status": "Inactive"}},
+			{"op": "delete", "id": 2}
+		]`, false, false, 0, 3, "rolled back; " + ErrNotFound.Error() + "; " + errBulkSkipped.Error(), "1:Active 2:Active", []string{}},
+
+		{"continue on error rolls back the failed operations", `[
+			{"op": "create", "record": {"column_id": 1, "status": "Inactive"}},
+			{"op": "update", "id": 9, "record": {"status": "Inactive"}},
+			{"op": "update", "id": 1, "record": {"column_id": 1, "status": "Intervention"}},
+			{"op": "delete", "id": 2},
+			{"op": "create", "record": {"column_id": 7}}
+		]`, true, true, 3, 2, "ok; " + ErrNotFound.Error() + "; ok; ok; validation failed: column_id: references columns 7 which does not exist",
+			"1:Intervention 3:Inactive", []string{AuditCreate, AuditUpdate, AuditDelete}},
+
+		{"continue on error with every operation failing", `[
+			{"op": "upsert", "id": 1},
+			{"op": "update", "record": {"status": "Inactive"}},
+			{"op": "update", "id": "one", "record": {"status": "Inactive"}},
+			{"op": "create"},
+			{"op": "create", "record": {"serial_number": "twelve"}}
+		]`, true, true, 0, 5, `bad params error: unknown op "upsert", expected create, update or delete; ` +
+			"bad params error: id is required; bad params error: id must be an integer; bad params error: record is required; " +
+			`bad params error: strconv.ParseInt: parsing "twelve": invalid syntax`,
+			"1:Active 2:Active", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := useBulkTables(t)
+
+			results, err := Bulk(context.Background(), table, bulkOperations(t, tt.operations), tt.continueOnError, prepareBulkRecord)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if results.Committed != tt.committed || results.Succeeded != tt.succeeded || results.Failed != tt.failed {
+				t.Errorf("committed %v, %d succeeded and %d failed, want %v, %d and %d", results.Committed, results.Succeeded,
+					results.Failed, tt.committed, tt.succeeded, tt.failed)
+			}
+			if outcome := bulkOutcome(results); outcome != tt.outcome {
+				t.Errorf("outcome = %s\nwant %s", outcome, tt.outcome)
+			}
+			for i, result := range results.Results {
+				if result.Index != i {
+					t.Errorf("result %d has index %d", i, result.Index)
+				}
+			}
+			if stored := storedElevators(t); stored != tt.stored {
+				t.Errorf("stored elevators = %s, want %s", stored, tt.stored)
+			}
+			if audited := auditActions(t, "elevators"); fmt.Sprint(audited) != fmt.Sprint(tt.audited) {
+				t.Errorf("audited %v, want %v", audited, tt.audited)
+			}
+		})
+	}
+}
+
+func TestBulkCreateResult(t *testing.T) {
+	table := useBulkTables(t)
+
+	results, err := Bulk(context.Background(), table, bulkOperations(t, `[{"op": "create", "record": {"status": "Inactive"}}]`), false, prepareBulkRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := results.Results[0]
+	if result.ID != int64(3) {
+		t.Errorf("created id = %v, want 3", result.ID)
+	}
+	if record, ok := result.Record.(*model.Elevators_); !ok || record.ID != 3 || record.CreatedAt.IsZero() {
+		t.Errorf("created record = %+v, want elevator 3 with its created_at", result.Record)
+	}
+
+	// the id sent with a created record is not used, the existing record is left as it is
+	results, err = Bulk(context.Background(), table, bulkOperations(t, `[{"op": "create", "record": {"id": 1, "status": "Inactive"}}]`), false, prepareBulkRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := results.Results[0]; !result.OK || result.ID != int64(4) {
+		t.Errorf("create with id 1 = ok %v id %v, want elevator 4", result.OK, result.ID)
+	}
+	if stored := storedElevators(t); stored != "1:Active 2:Active 3:Inactive 4:Inactive" {
+		t.Errorf("stored elevators = %s, want 1:Active 2:Active 3:Inactive 4:Inactive", stored)
+	}
+
+	// the id of a rolled back create is not reported
+	results, err = Bulk(context.Background(), table, bulkOperations(t, `[
+		{"op": "create", "record": {"status": "Inactive"}},
+		{"op": "delete", "id": 9}
+	]`), false, prepareBulkRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := results.Results[0]; result.ID != nil || result.Record != nil {
+		t.Errorf("rolled back create reported id %v and record %v", result.ID, result.Record)
+	}
+}
+
+func TestBulkLimits(t *testing.T) {
+	table := useBulkTables(t)
+	saved := MaxBulkOperations
+	t.Cleanup(func() { MaxBulkOperations = saved })
+	MaxBulkOperations = 2
+
+	for _, operations := range []string{`[]`, `[{"op": "delete", "id": 1}, {"op": "delete", "id": 2}, {"op": "delete", "id": 3}]`} {
+		if _, err := Bulk(context.Background(), table, bulkOperations(t, operations), true, prepareBulkRecord); !errors.Is(err, ErrBadParams) {
+			t.Errorf("Bulk(%s) error = %v, want %v", operations, err, ErrBadParams)
+		}
+	}
+	if stored := storedElevators(t); stored != "1:Active 2:Active" {
+		t.Errorf("stored elevators = %s after refused requests", stored)
+	}
+}
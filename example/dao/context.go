@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	// OperationTimeout bounds every dao function call along with the deadline of its ctx, 0 for no bound
+	OperationTimeout time.Duration
+
+	// ErrTimeout error when a db call is cancelled by the deadline of the request or the OperationTimeout
+	ErrTimeout = fmt.Errorf("db operation timed out")
+
+	// ErrCanceled error when a db call is cancelled because the request was cancelled, e.g. the client disconnected
+	ErrCanceled = fmt.Errorf("request canceled")
+)
+
+// session returns DB running its statements with ctx, bounded by the OperationTimeout, so a cancelled request stops its
+// queries. done must be deferred with the error result of the calling function, it releases the operation and
+// replaces a failure caused by the cancellation with ErrTimeout or ErrCanceled. The session is a clone of DB, its log
+// mode, logger, settings and the rest are kept.
+func session(ctx context.Context) (db *gorm.DB, done func(err *error)) {
+	ctx, cancel := operationContext(ctx)
+
+	sqlDB, ok := DB.CommonDB().(*sql.DB)
+	if !ok {
+		// DB is already bound to a connection of its own, e.g. a transaction, statements run without ctx
+		return DB, func(err *error) { cancel() }
+	}
+
+	// gorm v1 has no context support, a clone of DB whose statements run on the pool wrapped with ctx runs every
+	// statement with it
+	db = withConn(DB.New(), &ctxConn{ctx: ctx, db: sqlDB})
+	logQueries(ctx, db, sqlDB)
+	return db, doneFunc(ctx, cancel)
+}
+
+// begin starts a transaction bound to ctx, bounded by the OperationTimeout, the transaction is rolled back once ctx is
+// done and its next statement fails. gorm runs the statements of a transaction without ctx, a statement running when
+// ctx ends, e.g. a long update, runs to completion before the rollback. A failure to begin is returned in the Error of
+// the transaction. done must be deferred with the error result of the calling function, after the deferred
+// RollbackUnlessCommitted of the transaction.
+func begin(ctx context.Context) (tx *gorm.DB, done func(err *error)) {
+	ctx, cancel := operationContext(ctx)
+
+	tx = DB.BeginTx(ctx, nil)
+	if sqlDB, ok := DB.CommonDB().(*sql.DB); ok {
+		logQueries(ctx, tx, sqlDB)
+	}
+	return tx, doneFunc(ctx, cancel)
+}
+
+// connField is the unexported field of gorm.DB holding the connection its statements run on, gorm v1 only sets it in
+// Open and BeginTx
+var connField = func() reflect.StructField {
+	field, ok := reflect.TypeOf(gorm.DB{}).FieldByName("db")
+	if !ok || field.Type != reflect.TypeOf((*gorm.SQLCommon)(nil)).Elem() {
+		panic("gorm.DB has no db field of type gorm.SQLCommon")
+	}
+	return field
+}()
+
+// withConn returns db, a clone of its own, with its statements running on conn
+func withConn(db *gorm.DB, conn gorm.SQLCommon) *gorm.DB {
+	field := reflect.ValueOf(db).Elem().FieldByIndex(connField.Index)
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(&conn).Elem())
+	return db
+}
+
+// operationContext returns ctx bounded by the OperationTimeout
+func operationContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if OperationTimeout > 0 {
+		return context.WithTimeout(ctx, OperationTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// doneFunc returns the done function of an operation running with ctx
+func doneFunc(ctx context.Context, cancel context.CancelFunc) func(err *error) {
+	return func(err *error) {
+		if *err != nil {
+			*err = contextError(ctx, *err)
+		}
+		cancel()
+	}
+}
+
+// contextError returns ErrTimeout or ErrCanceled when err was caused by the end of ctx, err otherwise
+func contextError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return &DBError{Err: ErrTimeout, Cause: err}
+	case errors.Is(ctx.Err(), context.Canceled):
+		return &DBError{Err: ErrCanceled, Cause: err}
+	default:
+		return err
+	}
+}
+
+// ctxConn runs the statements of a gorm.DB on a connection pool with a context
+type ctxConn struct {
+	ctx context.Context
+	db  *sql.DB
+}
+
+func (c *ctxConn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.db.ExecContext(c.ctx, query, args...)
+}
+
+func (c *ctxConn) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c *ctxConn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.db.QueryContext(c.ctx, query, args...)
+}
+
+func (c *ctxConn) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.db.QueryRowContext(c.ctx, query, args...)
+}
+
+// Begin starts a transaction with the context, gorm runs the statements of the transaction without it
+func (c *ctxConn) Begin() (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, nil)
+}
+
+// BeginTx starts a transaction with ctx, gorm runs the statements of the transaction without it
+func (c *ctxConn) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.db.BeginTx(ctx, opts)
+}
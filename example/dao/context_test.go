@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionContext(t *testing.T) {
+	saved := DB
+	t.Cleanup(func() { DB = saved })
+	DB = openTestDB(t, &testItem{ID: 1, Name: "a"})
+
+	count := func(ctx context.Context) (n int, err error) {
+		db, done := session(ctx)
+		defer done(&err)
+		err = db.Model(&testItem{}).Count(&n).Error
+		return n, err
+	}
+
+	if n, err := count(context.Background()); err != nil || n != 1 {
+		t.Fatalf("count() = %d, %v, want 1, nil", n, err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := count(canceled); !errors.Is(err, ErrCanceled) {
+		t.Errorf("count(canceled) error = %v, want ErrCanceled", err)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err := count(expired); !errors.Is(err, ErrTimeout) {
+		t.Errorf("count(expired) error = %v, want ErrTimeout", err)
+	}
+}
+
+// noteItem is a model without a TableName, its table name follows SingularTable
+type noteItem struct {
+	ID int64
+}
+
+// printCount counts the entries gorm logs
+type printCount struct {
+	n int
+}
+
+func (p *printCount) Print(values ...interface{}) {
+	p.n++
+}
+
+func TestSessionKeepsSettings(t *testing.T) {
+	saved, savedLogger := DB, Logger
+	t.Cleanup(func() { DB, Logger = saved, savedLogger })
+	Logger = nil
+
+	logged := &printCount{}
+	db := openTestDB(t, &testItem{ID: 1, Name: "a"})
+	db.SetLogger(logged)
+	db.SingularTable(true)
+	DB = db.BlockGlobalUpdate(true).LogMode(true).Set("test:option", "kept")
+
+	db, done := session(context.Background())
+	var err error
+	defer done(&err)
+
+	if value, ok := db.Get("test:option"); !ok || value != "kept" {
+		t.Errorf("setting test:option = %v, want kept", value)
+	}
+	if !db.HasBlockGlobalUpdate() {
+		t.Error("global updates are not blocked")
+	}
+	if err = db.Model(&testItem{}).Update("name", "b").Error; err == nil || !strings.Contains(err.Error(), "missing WHERE clause") {
+		t.Errorf("update without a where clause error = %v, want the missing WHERE clause error", err)
+	}
+	err = nil
+	if name := db.NewScope(&noteItem{}).TableName(); name != "note_item" {
+		t.Errorf("table name of noteItem = %q, want the singular note_item", name)
+	}
+
+	logged.n = 0
+	var n int
+	if err = db.Model(&testItem{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if logged.n == 0 {
+		t.Error("the statement is not logged by the logger of DB in log mode")
+	}
+}
+
+func TestBeginContext(t *testing.T) {
+	saved := DB
+	t.Cleanup(func() { DB = saved })
+	DB = openTestDB(t)
+
+	create := func(ctx context.Context, item *testItem) (err error) {
+		tx, done := begin(ctx)
+		defer done(&err)
+		if err = tx.Error; err != nil {
+			return err
+		}
+		defer tx.RollbackUnlessCommitted()
+
+		if err = tx.Create(item).Error; err != nil {
+			return err
+		}
+		return tx.Commit().Error
+	}
+
+	if err := create(context.Background(), &testItem{ID: 1, Name: "a"}); err != nil {
+		t.Fatalf("create() error = %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := create(canceled, &testItem{ID: 2, Name: "b"}); !errors.Is(err, ErrCanceled) {
+		t.Errorf("create(canceled) error = %v, want ErrCanceled", err)
+	}
+
+	var n int
+	if err := DB.Model(&testItem{}).Count(&n).Error; err != nil || n != 1 {
+		t.Errorf("count = %d, %v, want 1, nil", n, err)
+	}
+}
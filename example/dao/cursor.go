@@ -0,0 +1,238 @@
+package dao
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Cursor is the decoded position of a page boundary row in a sort order
+type Cursor struct {
+	// Values of the sort columns of the boundary row, nil for null
+	Values []*string `json:"v"`
+
+	// Sort the sort order the cursor was created for
+	Sort string `json:"s"`
+
+	// Before pages backward from the boundary row
+	Before bool `json:"b,omitempty"`
+
+	values []interface{}
+}
+
+// PageCursors are the opaque cursors of the pages around a page of records, empty when there is no such page
+type PageCursors struct {
+	Next string
+	Prev string
+}
+
+// ParseCursor decodes a cursor returned in a previous page, the cursor must have been created for the same sort
+// error - ErrBadParams, cursor is malformed or was created for a different sort
+func ParseCursor(keys []*SortKey, value string) (*Cursor, error) {
+	errBadCursor := fmt.Errorf("%w: invalid cursor", ErrBadParams)
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errBadCursor
+	}
+
+	cursor := &Cursor{}
+	if err = json.Unmarshal(data, cursor); err != nil || len(cursor.Values) != len(keys) {
+		return nil, errBadCursor
+	}
+
+	if cursor.Sort != sortSignature(keys) {
+		return nil, fmt.Errorf("%w: cursor was created for a different sort", ErrBadParams)
+	}
+
+	for i, key := range keys {
+		if cursor.Values[i] == nil {
+			cursor.values = append(cursor.values, nil)
+			continue
+		}
+
+		v, err := parseColumnValue(key.Column, columnKind(key.Column), *cursor.Values[i])
+		if err != nil {
+			return nil, errBadCursor
+		}
+		cursor.values = append(cursor.values, v)
+	}
+
+	return cursor, nil
+}
+
+// applyPage restricts db to a page of the query and orders it, a page holds up to pagesize records plus one more used
+// by pageCursors to detect a following page
+func applyPage(db *gorm.DB, page, pagesize int64, query *ListQuery) *gorm.DB {
+	if query.Cursor != nil {
+		keys := query.Sort
+		if query.Cursor.Before {
+			keys = reverseSort(keys)
+		}
+
+		condition, args := cursorCondition(db, keys, query.Cursor.values)
+		return applySort(db.Where(condition, args...), keys).Limit(pagesize + 1)
+	}
+
+	if page > 0 {
+		db = db.Offset((page - 1) * pagesize)
+	}
+	return applySort(db, query.Sort).Limit(pagesize + 1)
+}
+
+// pageCursors trims the extra record fetched by applyPage from the slice results points to, restores the sort order
+// of a backward page and returns the cursors of the next and previous pages
+func pageCursors(page int64, pagesize int64, query *ListQuery, results interface{}) (cursors *PageCursors, err error) {
+	rows := reflect.ValueOf(results).Elem()
+	more := int64(rows.Len()) > pagesize
+	if more {
+		rows.Set(rows.Slice(0, int(pagesize)))
+	}
+
+	before := query.Cursor != nil && query.Cursor.Before
+	if before {
+		for i, j := 0, rows.Len()-1; i < j; i, j = i+1, j-1 {
+			a, b := rows.Index(i).Interface(), rows.Index(j).Interface()
+			rows.Index(i).Set(reflect.ValueOf(b))
+			rows.Index(j).Set(reflect.ValueOf(a))
+		}
+	}
+
+	cursors = &PageCursors{}
+	if rows.Len() == 0 {
+		return cursors, nil
+	}
+
+	hasNext, hasPrev := more, page > 1
+	if query.Cursor != nil {
+		hasNext, hasPrev = more || before, more || !before
+	}
+
+	if hasNext {
+		if cursors.Next, err = encodeCursor(query.Sort, rows.Index(rows.Len()-1), false); err != nil {
+			return nil, err
+		}
+	}
+	if hasPrev {
+		if cursors.Prev, err = encodeCursor(query.Sort, rows.Index(0), true); err != nil {
+			return nil, err
+		}
+	}
+	return cursors, nil
+}
+
+// encodeCursor builds the opaque cursor for the sort column values of row
+func encodeCursor(keys []*SortKey, row reflect.Value, before bool) (string, error) {
+	cursor := &Cursor{Sort: sortSignature(keys), Before: before}
+
+	record := reflect.Indirect(row)
+	for _, key := range keys {
+		field := record.FieldByName(key.Column.GoFieldName)
+		if !field.IsValid() {
+			return "", fmt.Errorf("sort column %s has no field %s", key.Column.Name, key.Column.GoFieldName)
+		}
+		cursor.Values = append(cursor.Values, cursorValue(field.Interface()))
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// cursorValue formats a field value the way parseColumnValue reads it back, nil for null
+func cursorValue(v interface{}) *string {
+	if valuer, ok := v.(driver.Valuer); ok {
+		v, _ = valuer.Value()
+	}
+
+	var s string
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		s = t.Format(time.RFC3339Nano)
+	case float64:
+		s = strconv.FormatFloat(t, 'g', -1, 64)
+	case []byte:
+		s = string(t)
+	default:
+		s = fmt.Sprint(t)
+	}
+	return &s
+}
+
+// cursorCondition returns the where clause selecting the rows strictly after values in the order of keys. Like mysql
+// and sqlite nulls sort before every other value.
+func cursorCondition(db *gorm.DB, keys []*SortKey, values []interface{}) (string, []interface{}) {
+	var condition string
+	var args []interface{}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		key, v := keys[i], values[i]
+		column := db.Dialect().Quote(key.Column.Name)
+
+		var after string
+		var afterArgs []interface{}
+		switch {
+		case v == nil && key.Desc:
+		case v == nil:
+			after = column + " IS NOT NULL"
+		case key.Desc && key.Column.Nullable:
+			after, afterArgs = "("+column+" < ? OR "+column+" IS NULL)", []interface{}{v}
+		case key.Desc:
+			after, afterArgs = column+" < ?", []interface{}{v}
+		default:
+			after, afterArgs = column+" > ?", []interface{}{v}
+		}
+
+		if condition == "" {
+			condition, args = after, afterArgs
+			continue
+		}
+
+		equal, equalArgs := column+" = ?", []interface{}{v}
+		if v == nil {
+			equal, equalArgs = column+" IS NULL", nil
+		}
+
+		next := "(" + equal + " AND " + condition + ")"
+		nextArgs := append(equalArgs, args...)
+		if after != "" {
+			next = "(" + after + " OR " + next + ")"
+			nextArgs = append(afterArgs, nextArgs...)
+		}
+		condition, args = next, nextArgs
+	}
+
+	return condition, args
+}
+
+// reverseSort returns keys with every direction flipped, used to page backward
+func reverseSort(keys []*SortKey) []*SortKey {
+	reversed := make([]*SortKey, len(keys))
+	for i, key := range keys {
+		reversed[i] = &SortKey{Column: key.Column, Desc: !key.Desc}
+	}
+	return reversed
+}
+
+// sortSignature identifies a sort order inside of a cursor
+func sortSignature(keys []*SortKey) string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Column.Name
+		if key.Desc {
+			names[i] = "-" + names[i]
+		}
+	}
+	return strings.Join(names, ",")
+}
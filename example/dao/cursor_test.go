@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/jinzhu/gorm"
+)
+
+// cursorTestItems are items with repeated and null sort values, so pages break inside groups of equal values
+func cursorTestItems() []*testItem {
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	return []*testItem{
+		{ID: 1, Name: "b", Score: null.FloatFrom(2), CreatedAt: day},
+		{ID: 2, Name: "a", Score: null.Float{}, CreatedAt: day},
+		{ID: 3, Name: "a", Score: null.FloatFrom(2), CreatedAt: day.Add(time.Hour)},
+		{ID: 4, Name: "c", Score: null.FloatFrom(1), CreatedAt: day.Add(time.Hour)},
+		{ID: 5, Name: "a", Score: null.Float{}, CreatedAt: day},
+		{ID: 6, Name: "b", Score: null.FloatFrom(2), CreatedAt: day.Add(2 * time.Hour)},
+		{ID: 7, Name: "c", Score: null.Float{}, CreatedAt: day.Add(time.Hour)},
+		{ID: 8, Name: "a", Score: null.FloatFrom(1), CreatedAt: day.Add(2 * time.Hour)},
+	}
+}
+
+func TestCursorPagination(t *testing.T) {
+	db := openTestDB(t, cursorTestItems()...)
+
+	tests := []struct {
+		sort string
+		ids  []int64
+	}{
+		{"", []int64{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"-id", []int64{8, 7, 6, 5, 4, 3, 2, 1}},
+		// nulls sort first ascending and last descending
+		{"score", []int64{2, 5, 7, 4, 8, 1, 3, 6}},
+		{"-score", []int64{1, 3, 6, 4, 8, 2, 5, 7}},
+		{"-score,name", []int64{3, 1, 6, 8, 4, 2, 5, 7}},
+		{"name,-score", []int64{3, 8, 2, 5, 1, 6, 4, 7}},
+		{"-name,-score,-id", []int64{4, 7, 6, 1, 3, 8, 5, 2}},
+		{"-created_at,score", []int64{8, 6, 7, 4, 3, 2, 5, 1}},
+	}
+
+	for _, tt := range tests {
+		for _, pagesize := range []int64{1, 2, 3, 8, 10} {
+			t.Run(fmt.Sprintf("%s/%d", tt.sort, pagesize), func(t *testing.T) {
+				keys, err := ParseSort(testItemsTable, tt.sort)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// forward from the first page along the next cursors
+				var forward []int64
+				var last *PageCursors
+				query := &ListQuery{Sort: keys}
+				for pages := 0; ; pages++ {
+					items, cursors := cursorPage(t, db, pagesize, query)
+					forward = append(forward, itemIDs(items)...)
+					last = cursors
+					if cursors.Next == "" || pages > len(tt.ids) {
+						break
+					}
+					if query.Cursor, err = ParseCursor(keys, cursors.Next); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if fmt.Sprint(forward) != fmt.Sprint(tt.ids) {
+					t.Fatalf("forward pages = %v, want %v", forward, tt.ids)
+				}
+
+				// backward from the last page along the prev cursors
+				backward := itemIDs(nil)
+				for pages := 0; last.Prev != "" && pages <= len(tt.ids); pages++ {
+					if query.Cursor, err = ParseCursor(keys, last.Prev); err != nil {
+						t.Fatal(err)
+					}
+					var items []*testItem
+					items, last = cursorPage(t, db, pagesize, query)
+					backward = append(itemIDs(items), backward...)
+				}
+				lastPage := (int64(len(tt.ids)) - 1) / pagesize * pagesize
+				if fmt.Sprint(backward) != fmt.Sprint(tt.ids[:lastPage]) {
+					t.Errorf("backward pages = %v, want %v", backward, tt.ids[:lastPage])
+				}
+			})
+		}
+	}
+}
+
+// cursorPage reads the page of items of query and returns them with the cursors of the pages around it
+func cursorPage(t *testing.T, db *gorm.DB, pagesize int64, query *ListQuery) ([]*testItem, *PageCursors) {
+	t.Helper()
+
+	var items []*testItem
+	if err := applyPage(db.Model(&testItem{}), 0, pagesize, query).Find(&items).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	cursors, err := pageCursors(0, pagesize, query, &items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return items, cursors
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	keys, err := ParseSort(testItemsTable, "-score,created_at,name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2021, 6, 1, 10, 30, 0, 123456789, time.UTC)
+	tests := []struct {
+		name   string
+		item   *testItem
+		values []interface{}
+	}{
+		{"values", &testItem{ID: 7, Name: "it's, \"quoted\"", Score: null.FloatFrom(0.1), CreatedAt: created},
+			[]interface{}{0.1, created, "it's, \"quoted\"", int64(7)}},
+		{"null", &testItem{ID: 8, Name: "", Score: null.Float{}, CreatedAt: created},
+			[]interface{}{nil, created, "", int64(8)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, before := range []bool{false, true} {
+				encoded, err := encodeCursor(keys, reflect.ValueOf(tt.item), before)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				cursor, err := ParseCursor(keys, encoded)
+				if err != nil {
+					t.Fatalf("ParseCursor(%q) failed: %v", encoded, err)
+				}
+				if cursor.Before != before || !reflect.DeepEqual(cursor.values, tt.values) {
+					t.Errorf("ParseCursor(%q) = %#v before %v, want %#v before %v", encoded, cursor.values, cursor.Before, tt.values, before)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCursorTampered(t *testing.T) {
+	keys, err := ParseSort(testItemsTable, "-score,name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "not a cursor!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"v":["1","a","1"],"s":"-score,name,id"}`))},
+		{"not json", encode(`v=1`)},
+		{"missing values", encode(`{"v":["1","a"],"s":"-score,name,id"}`)},
+		{"extra values", encode(`{"v":["1","a","1","2"],"s":"-score,name,id"}`)},
+		{"other sort", encode(`{"v":["1","a","1"],"s":"score,name,id"}`)},
+		{"sort injection", encode(`{"v":["1","a","1"],"s":"-score,name,id; DROP TABLE items"}`)},
+		{"value of the wrong type", encode(`{"v":["high","a","1"],"s":"-score,name,id"}`)},
+		{"primary key not an integer", encode(`{"v":["1","a","1 OR 1=1"],"s":"-score,name,id"}`)},
+		{"value not a string", encode(`{"v":[1,"a","1"],"s":"-score,name,id"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCursor(keys, tt.cursor); !errors.Is(err, ErrBadParams) {
+				t.Errorf("ParseCursor(%q) error = %v, want %v", tt.cursor, err, ErrBadParams)
+			}
+		})
+	}
+}
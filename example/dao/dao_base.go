@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"reflect"
 
+	"restapi-golang-gin-gen/model"
+
 	"github.com/jinzhu/gorm"
 )
 
@@ -13,25 +15,26 @@ import (
 type BuildInfo struct {
 
 	// BuildDate date string of when build was performed filled in by -X compile flag
-	BuildDate string
+	BuildDate string `json:"build_date"`
 
 	// LatestCommit date string of when build was performed filled in by -X compile flag
-	LatestCommit string
+	LatestCommit string `json:"latest_commit"`
 
 	// BuildNumber date string of when build was performed filled in by -X compile flag
-	BuildNumber string
+	BuildNumber string `json:"build_number"`
 
 	// BuiltOnIP date string of when build was performed filled in by -X compile flag
-	BuiltOnIP string
+	BuiltOnIP string `json:"built_on_ip"`
 
 	// BuiltOnOs date string of when build was performed filled in by -X compile flag
-	BuiltOnOs string
+	BuiltOnOs string `json:"built_on_os"`
 
 	// RuntimeVer date string of when build was performed filled in by -X compile flag
-	RuntimeVer string
+	RuntimeVer string `json:"runtime_ver"`
 }
 
-type LogSql func(ctx context.Context, sql string)
+// LogSql function receiving the entry of every statement run by the dao along with the context of its request
+type LogSql func(ctx context.Context, entry *QueryLog)
 
 var (
 	// ErrNotFound error when record not found
@@ -40,6 +43,9 @@ var (
 	// ErrUnableToMarshalJSON error when json payload corrupt
 	ErrUnableToMarshalJSON = fmt.Errorf("json payload corrupt")
 
+	// ErrReadFailed error when a read fails for another reason than a missing record
+	ErrReadFailed = fmt.Errorf("db read error")
+
 	// ErrUpdateFailed error when update fails
 	ErrUpdateFailed = fmt.Errorf("db update error")
 
@@ -52,13 +58,25 @@ var (
 	// ErrBadParams error when bad params passed in
 	ErrBadParams = fmt.Errorf("bad params error")
 
+	// ErrConflict error when a change conflicts with an existing record, e.g. a duplicate value of a unique index
+	ErrConflict = fmt.Errorf("conflict with an existing record")
+
+	// ErrPreconditionFailed error when the record changed since the entity tag of an If-Match precondition was read
+	ErrPreconditionFailed = fmt.Errorf("precondition failed, the record was changed")
+
+	// ErrPreconditionWeak error when an If-Match precondition holds weak entity tags only, which never match
+	ErrPreconditionWeak = fmt.Errorf("precondition failed, If-Match holds weak etags only, as sent with responses reduced by fields or include, send the etag of a GET of the whole record")
+
+	// ErrPreconditionRequired error when an update or delete is missing a required If-Match precondition
+	ErrPreconditionRequired = fmt.Errorf("precondition required, send the etag of the record in If-Match")
+
 	// DB reference to database
 	DB *gorm.DB
 
 	// AppBuildInfo reference to build info
 	AppBuildInfo *BuildInfo
 
-	// Logger function that will be invoked before executing sql
+	// Logger function that will be invoked after executing sql, nil to log nothing
 	Logger LogSql
 )
 
@@ -85,6 +103,32 @@ func Copy(dst interface{}, src interface{}) error {
 	return nil
 }
 
+// Replace copies every column of a src record into a dst record of the same table, zero values and nulls included,
+// except for the primary key and the created_at, updated_at and deleted_at columns managed by the server. Fields
+// holding related records are left unchanged.
+func Replace(dst model.Model, src model.Model) error {
+	dstV := reflect.Indirect(reflect.ValueOf(dst))
+	srcV := reflect.Indirect(reflect.ValueOf(src))
+
+	if srcV.Type() != dstV.Type() {
+		return errors.New("different types can be copied")
+	}
+
+	for _, col := range dst.TableInfo().Columns {
+		if !col.IsDBColumn() || col.IsPrimaryKey || isTimestampColumn(col) {
+			continue
+		}
+
+		f := dstV.FieldByName(col.GoFieldName)
+		if !f.IsValid() {
+			return fmt.Errorf("column %s has no field %s", col.Name, col.GoFieldName)
+		}
+		f.Set(srcV.FieldByName(col.GoFieldName))
+	}
+
+	return nil
+}
+
 func isZeroOfUnderlyingType(x interface{}) bool {
 	return x == nil || reflect.DeepEqual(x, reflect.Zero(reflect.TypeOf(x)).Interface())
 }
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// DBError is a failed db call, it reads as the error reported to the client while Cause holds the driver error, which
+// is logged but never returned to the client
+type DBError struct {
+	// Err error reported to the client, e.g. ErrInsertFailed
+	Err error
+
+	// Cause error returned by the db driver
+	Cause error
+}
+
+func (e *DBError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error reported to the client so errors.Is matches it
+func (e *DBError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// uniqueViolations match the unique constraint violations of the supported dialects, the first group is the name of
+	// the index or column when the driver reports it
+	uniqueViolations = []*regexp.Regexp{
+		regexp.MustCompile(`Duplicate entry .* for key '([^']+)'`),                         // mysql 1062
+		regexp.MustCompile(`duplicate key value violates unique constraint "([^"]+)"`),     // postgres 23505
+		regexp.MustCompile(`UNIQUE constraint failed: ([^\s]+)`),                           // sqlite
+		regexp.MustCompile(`duplicate key row in object .* with unique index '([^']+)'`),   // mssql 2601
+		regexp.MustCompile(`Violation of (?:UNIQUE KEY|PRIMARY KEY) constraint '([^']+)'`), // mssql 2627
+	}
+
+	// referenceViolations match the foreign key violations of the supported dialects, the first group is the name of
+	// the constraint when the driver reports it
+	referenceViolations = []*regexp.Regexp{
+		regexp.MustCompile(`a foreign key constraint fails .*CONSTRAINT .([^\s` + "`" + `]+)`),   // mysql 1451, 1452
+		regexp.MustCompile(`violates foreign key constraint "([^"]+)"`),                          // postgres 23503
+		regexp.MustCompile(`(FOREIGN KEY) constraint failed`),                                    // sqlite
+		regexp.MustCompile(`conflicted with the (?:REFERENCE|FOREIGN KEY) constraint "([^"]+)"`), // mssql 547
+	}
+)
+
+// dbError returns the error of a failed db save or delete call, ErrConflict when err violates a unique index or a
+// foreign key, failed otherwise. The driver error is kept as the Cause of the DBError returned.
+func dbError(err error, failed error) error {
+	if name, ok := matchViolation(err, uniqueViolations); ok {
+		return &DBError{Err: fmt.Errorf("%w: duplicate value for %s", ErrConflict, name), Cause: err}
+	}
+
+	if name, ok := matchViolation(err, referenceViolations); ok {
+		return &DBError{Err: fmt.Errorf("%w: the record references or is referenced by another record (%s)", ErrConflict, name), Cause: err}
+	}
+
+	return &DBError{Err: failed, Cause: err}
+}
+
+// readError returns the error of a failed db read call, ErrNotFound when no record matched a First, ErrReadFailed
+// otherwise. A Find of a slice matching no record does not fail. The driver error is kept as the Cause of the DBError
+// returned.
+func readError(err error) error {
+	if gorm.IsRecordNotFoundError(err) {
+		return ErrNotFound
+	}
+	return &DBError{Err: ErrReadFailed, Cause: err}
+}
+
+// matchViolation returns the name of the index or constraint of err when err matches one of violations
+func matchViolation(err error, violations []*regexp.Regexp) (string, bool) {
+	for _, violation := range violations {
+		if match := violation.FindStringSubmatch(err.Error()); match != nil {
+			return strings.Trim(match[1], "`'\""), true
+		}
+	}
+	return "", false
+}
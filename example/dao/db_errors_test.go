@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestReadError(t *testing.T) {
+	useTestTables(t, "elevators")
+	ctx := context.Background()
+
+	// a list matching nothing is empty, not missing
+	elevators, _, _, err := GetAllElevators_(ctx, 0, 20, &ListQuery{})
+	if err != nil || len(elevators) != 0 {
+		t.Errorf("GetAllElevators_() of an empty table = %d records, %v, want none and no error", len(elevators), err)
+	}
+	if _, err = GetElevators_(ctx, 1, nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetElevators_() of a missing record error = %v, want %v", err, ErrNotFound)
+	}
+
+	// any other failure is a read error keeping the driver error
+	if err = DB.Exec("DROP TABLE elevators").Error; err != nil {
+		t.Fatal(err)
+	}
+	for name, err := range map[string]error{
+		"GetAllElevators_": func() error { _, _, _, err := GetAllElevators_(ctx, 0, 20, &ListQuery{}); return err }(),
+		"GetElevators_":    func() error { _, err := GetElevators_(ctx, 1, nil); return err }(),
+		"DeleteElevators_": func() error { _, err := DeleteElevators_(ctx, 1); return err }(),
+	} {
+		var dbErr *DBError
+		if !errors.Is(err, ErrReadFailed) || errors.Is(err, ErrNotFound) || !errors.As(err, &dbErr) || dbErr.Cause == nil {
+			t.Errorf("%s() of a missing table error = %v, want %v with the driver error", name, err, ErrReadFailed)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+type ifMatchKey struct{}
+
+// WithIfMatch returns ctx carrying the entity tags of an If-Match precondition, updates and deletes of a record fail
+// with ErrPreconditionFailed unless the record matches one of etags. The tag * matches every record.
+func WithIfMatch(ctx context.Context, etags []string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etags)
+}
+
+// RecordETag returns the strong entity tag of the column values of record, it changes whenever a column changes
+func RecordETag(record model.Model) string {
+	v := reflect.Indirect(reflect.ValueOf(record))
+
+	hash := sha256.New()
+	for _, col := range record.TableInfo().Columns {
+		if !col.IsDBColumn() {
+			continue
+		}
+
+		hash.Write([]byte(col.Name))
+		field := v.FieldByName(col.GoFieldName)
+		if !field.IsValid() {
+			continue
+		}
+		if value := cursorValue(field.Interface()); value != nil {
+			hash.Write([]byte{1})
+			hash.Write([]byte(*value))
+		}
+		hash.Write([]byte{0})
+	}
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// checkIfMatch returns ErrPreconditionFailed when ctx carries an If-Match precondition record does not match
+func checkIfMatch(ctx context.Context, record model.Model) error {
+	etags, ok := ctx.Value(ifMatchKey{}).([]string)
+	if !ok {
+		return nil
+	}
+
+	current := RecordETag(record)
+	for _, etag := range etags {
+		if etag == "*" || etag == current {
+			return nil
+		}
+	}
+	return ErrPreconditionFailed
+}
+
+// RecordUpdatedAt returns the updated_at column of record, ok is false when the table has no updated_at column or it
+// is null
+func RecordUpdatedAt(record model.Model) (updatedAt time.Time, ok bool) {
+	col, ok := record.TableInfo().Column("updated_at")
+	if !ok {
+		return time.Time{}, false
+	}
+
+	field := reflect.Indirect(reflect.ValueOf(record)).FieldByName(col.GoFieldName)
+	if !field.IsValid() {
+		return time.Time{}, false
+	}
+
+	switch t := field.Interface().(type) {
+	case time.Time:
+		return t, true
+	case null.Time:
+		return t.Time, t.Valid
+	default:
+		return time.Time{}, false
+	}
+}
+
+// ListVersion returns the latest updated_at and the number of the records of table matching the filters and search of
+// query, together they change whenever a matching record is added, updated or removed. ok is false when the table has
+// no updated_at column.
+// error - db query failed
+func ListVersion(ctx context.Context, table *model.TableInfo, query *ListQuery) (updatedAt time.Time, count int, ok bool, err error) {
+	col, ok := table.Column("updated_at")
+	if !ok {
+		return time.Time{}, 0, false, nil
+	}
+
+	conn, done := session(ctx)
+	defer done(&err)
+
+	var latest interface{}
+	db := applyQuery(conn.Table(table.Name), query)
+	if query.Deleted == ExcludeDeleted {
+		db = notDeleted(db, table)
+	}
+
+	row := db.Select("MAX(" + db.Dialect().Quote(col.Name) + "), COUNT(*)").Row()
+	if err = row.Scan(&latest, &count); err != nil {
+		return time.Time{}, 0, false, err
+	}
+
+	switch t := latest.(type) {
+	case nil:
+		return time.Time{}, count, true, nil
+	case time.Time:
+		return t, count, true, nil
+	case []byte:
+		latest = string(t)
+	}
+
+	v, err := parseColumnValue(col, "time", fmt.Sprint(latest))
+	if err != nil {
+		return time.Time{}, 0, false, err
+	}
+	return v.(time.Time), count, true, nil
+}
@@ -0,0 +1,231 @@
+package dao
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Filter is a validated condition on a column used to narrow the records returned by the GetAll functions
+type Filter struct {
+	// Column the condition applies to
+	Column *model.ColumnInfo
+
+	// Op one of the FilterOps
+	Op string
+
+	// Values converted to the go type of the column, in and between hold several values, is_null holds a bool
+	Values []interface{}
+}
+
+// FilterOps operators accepted by ParseFilter
+var FilterOps = []string{"eq", "ne", "lt", "gt", "in", "like", "is_null", "between"}
+
+// timeLayouts layouts accepted for date and datetime filter values
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05.999999999Z07:00", "2006-01-02 15:04:05", "2006-01-02"}
+
+// ParseFilter validates a filter on the column name (db column or json field) of table and converts value to the
+// column type, in values are comma separated and between takes two comma separated bounds, a value holding a comma is
+// double quoted with any double quote inside it doubled, e.g. "Smith, Jr.",Doe or "say ""hi""".
+// error - ErrBadParams, unknown column, operator not supported by the column type or value not of the column type
+func ParseFilter(table *model.TableInfo, name, op, value string) (*Filter, error) {
+	col, ok := table.Column(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown filter column %q", ErrBadParams, name)
+	}
+
+	kind := columnKind(col)
+	filter := &Filter{Column: col, Op: op}
+
+	switch op {
+	case "eq", "ne":
+		v, err := parseColumnValue(col, kind, value)
+		if err != nil {
+			return nil, err
+		}
+		filter.Values = []interface{}{v}
+
+	case "lt", "gt":
+		if kind == "bytes" {
+			return nil, fmt.Errorf("%w: %s does not support %s", ErrBadParams, name, op)
+		}
+		v, err := parseColumnValue(col, kind, value)
+		if err != nil {
+			return nil, err
+		}
+		filter.Values = []interface{}{v}
+
+	case "like":
+		if kind != "string" {
+			return nil, fmt.Errorf("%w: %s does not support like", ErrBadParams, name)
+		}
+		filter.Values = []interface{}{value}
+
+	case "is_null":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s[is_null] must be true or false", ErrBadParams, name)
+		}
+		filter.Values = []interface{}{b}
+
+	case "in", "between":
+		parts, err := splitFilterValues(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s[%s] %v", ErrBadParams, name, op, err)
+		}
+		if op == "between" && len(parts) != 2 {
+			return nil, fmt.Errorf("%w: %s[between] takes two comma separated values", ErrBadParams, name)
+		}
+		if op == "between" && kind == "bytes" {
+			return nil, fmt.Errorf("%w: %s does not support between", ErrBadParams, name)
+		}
+		for _, part := range parts {
+			v, err := parseColumnValue(col, kind, part)
+			if err != nil {
+				return nil, err
+			}
+			filter.Values = append(filter.Values, v)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: unknown filter operator %q, expected one of %s", ErrBadParams, op, strings.Join(FilterOps, ", "))
+	}
+
+	return filter, nil
+}
+
+// splitFilterValues splits the comma separated values of in and between, a value starting with a double quote runs to
+// the closing quote and holds commas and doubled double quotes, a double quote inside an unquoted value is kept as it is
+func splitFilterValues(value string) ([]string, error) {
+	var values []string
+	for {
+		if !strings.HasPrefix(value, `"`) {
+			i := strings.IndexByte(value, ',')
+			if i < 0 {
+				return append(values, value), nil
+			}
+			values = append(values, value[:i])
+			value = value[i+1:]
+			continue
+		}
+
+		var b strings.Builder
+		i := 1
+		for {
+			j := strings.IndexByte(value[i:], '"')
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated quoted value")
+			}
+			b.WriteString(value[i : i+j])
+			i += j + 1
+			if !strings.HasPrefix(value[i:], `"`) {
+				break
+			}
+			b.WriteByte('"')
+			i++
+		}
+		values = append(values, b.String())
+
+		switch {
+		case i == len(value):
+			return values, nil
+		case value[i] != ',':
+			return nil, fmt.Errorf("text after the closing quote")
+		}
+		value = value[i+1:]
+	}
+}
+
+// applyFilters adds a parameterized where clause for each filter, column names come from the TableInfo so they are
+// safe to quote into the sql
+func applyFilters(db *gorm.DB, filters []*Filter) *gorm.DB {
+	for _, filter := range filters {
+		column := db.Dialect().Quote(filter.Column.Name)
+
+		switch filter.Op {
+		case "eq":
+			db = db.Where(column+" = ?", filter.Values[0])
+		case "ne":
+			db = db.Where(column+" <> ?", filter.Values[0])
+		case "lt":
+			db = db.Where(column+" < ?", filter.Values[0])
+		case "gt":
+			db = db.Where(column+" > ?", filter.Values[0])
+		case "in":
+			db = db.Where(column+" IN (?)", filter.Values)
+		case "like":
+			db = db.Where(column+" LIKE ?", filter.Values[0])
+		case "is_null":
+			if filter.Values[0].(bool) {
+				db = db.Where(column + " IS NULL")
+			} else {
+				db = db.Where(column + " IS NOT NULL")
+			}
+		case "between":
+			db = db.Where(column+" BETWEEN ? AND ?", filter.Values[0], filter.Values[1])
+		}
+	}
+	return db
+}
+
+// columnKind groups the go type of a column into int, float, time, bytes or string
+func columnKind(col *model.ColumnInfo) string {
+	switch col.GoFieldType {
+	case "int", "int32", "int64", "null.Int":
+		return "int"
+	case "float32", "float64", "null.Float":
+		return "float"
+	case "time.Time", "null.Time":
+		return "time"
+	case "[]byte":
+		return "bytes"
+	default:
+		return "string"
+	}
+}
+
+// parseColumnValue converts the query string value to the go type of kind
+func parseColumnValue(col *model.ColumnInfo, kind, value string) (interface{}, error) {
+	switch kind {
+	case "int":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be an integer", ErrBadParams, col.JSONFieldName)
+		}
+		return i, nil
+
+	case "float":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be a number", ErrBadParams, col.JSONFieldName)
+		}
+		return f, nil
+
+	case "time":
+		if t, ok := parseTime(value); ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("%w: %s must be a date or RFC 3339 time", ErrBadParams, col.JSONFieldName)
+
+	case "bytes":
+		return []byte(value), nil
+
+	default:
+		return value, nil
+	}
+}
+
+// parseTime parses value in one of the timeLayouts
+func parseTime(value string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+func TestParseFilter(t *testing.T) {
+	created := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		column string
+		op     string
+		value  string
+		values []interface{}
+		err    error
+	}{
+		{"int eq", "id", "eq", "12", []interface{}{int64(12)}, nil},
+		{"json field name", "parent", "ne", "3", []interface{}{int64(3)}, nil},
+		{"db column name of json field", "parent_id", "gt", "3", []interface{}{int64(3)}, nil},
+		{"float lt", "score", "lt", "2.5", []interface{}{2.5}, nil},
+		{"date", "created_at", "gt", "2021-06-01", []interface{}{created}, nil},
+		{"rfc 3339 time", "created_at", "lt", "2021-06-01T00:00:00Z", []interface{}{created}, nil},
+		{"string keeps the value", "name", "eq", "1 OR 1=1", []interface{}{"1 OR 1=1"}, nil},
+		{"like", "name", "like", "%smith%", []interface{}{"%smith%"}, nil},
+		{"in", "id", "in", "1,2,3", []interface{}{int64(1), int64(2), int64(3)}, nil},
+		{"in with a quoted comma", "name", "in", `"Smith, Jr.",Doe`, []interface{}{"Smith, Jr.", "Doe"}, nil},
+		{"between quoted dates", "created_at", "between", `"2021-06-01","2021-06-01"`, []interface{}{created, created}, nil},
+		{"between", "score", "between", "1,2", []interface{}{1.0, 2.0}, nil},
+		{"is_null", "parent", "is_null", "true", []interface{}{true}, nil},
+		{"bytes eq", "data", "eq", "abc", []interface{}{[]byte("abc")}, nil},
+
+		{"unknown column", "missing", "eq", "1", nil, ErrBadParams},
+		{"relation is not a column", "children", "eq", "1", nil, ErrBadParams},
+		{"column name injection", "id = 1 OR 1", "eq", "1", nil, ErrBadParams},
+		{"quoted column name injection", "`id`", "eq", "1", nil, ErrBadParams},
+		{"column name with comment", "id--", "eq", "1", nil, ErrBadParams},
+		{"unknown operator", "id", "gte", "1", nil, ErrBadParams},
+		{"int not a number", "id", "eq", "1 OR 1=1", nil, ErrBadParams},
+		{"int overflow", "id", "eq", "99999999999999999999", nil, ErrBadParams},
+		{"float not a number", "score", "gt", "high", nil, ErrBadParams},
+		{"time not a date", "created_at", "gt", "yesterday", nil, ErrBadParams},
+		{"like on int", "id", "like", "1%", nil, ErrBadParams},
+		{"lt on bytes", "data", "lt", "a", nil, ErrBadParams},
+		{"between on bytes", "data", "between", "a,b", nil, ErrBadParams},
+		{"between one value", "id", "between", "1", nil, ErrBadParams},
+		{"between three values", "id", "between", "1,2,3", nil, ErrBadParams},
+		{"in with a bad value", "id", "in", "1,x", nil, ErrBadParams},
+		{"in with an unterminated quote", "name", "in", `"a,b`, nil, ErrBadParams},
+		{"between with a quoted comma", "score", "between", `"1,2"`, nil, ErrBadParams},
+		{"is_null not a bool", "parent", "is_null", "maybe", nil, ErrBadParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseFilter(testItemsTable, tt.column, tt.op, tt.value)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseFilter(%q, %q, %q) error = %v, want %v", tt.column, tt.op, tt.value, err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if fmt.Sprintf("%#v", filter.Values) != fmt.Sprintf("%#v", tt.values) {
+				t.Errorf("ParseFilter(%q, %q, %q) values = %#v, want %#v", tt.column, tt.op, tt.value, filter.Values, tt.values)
+			}
+		})
+	}
+}
+
+func TestApplyFilters(t *testing.T) {
+	db := openTestDB(t,
+		&testItem{ID: 1, Name: "alice", Score: null.FloatFrom(1), ParentID: null.Int{}},
+		&testItem{ID: 2, Name: "bob", Score: null.FloatFrom(2), ParentID: null.IntFrom(1)},
+		&testItem{ID: 3, Name: "it's", Score: null.Float{}, ParentID: null.IntFrom(1)},
+	)
+
+	tests := []struct {
+		name    string
+		filters [][3]string
+		ids     []int64
+	}{
+		{"eq", [][3]string{{"name", "eq", "bob"}}, []int64{2}},
+		{"value is bound not spliced", [][3]string{{"name", "eq", "x' OR '1'='1"}}, []int64{}},
+		{"quote in value", [][3]string{{"name", "eq", "it's"}}, []int64{3}},
+		{"like", [][3]string{{"name", "like", "%o%"}}, []int64{2}},
+		{"in", [][3]string{{"id", "in", "1,3"}}, []int64{1, 3}},
+		{"in quoted", [][3]string{{"name", "in", `"bob","it's"`}}, []int64{2, 3}},
+		{"between", [][3]string{{"score", "between", "1.5,2"}}, []int64{2}},
+		{"is null", [][3]string{{"parent", "is_null", "true"}}, []int64{1}},
+		{"is not null", [][3]string{{"score", "is_null", "false"}}, []int64{1, 2}},
+		{"several filters", [][3]string{{"parent", "eq", "1"}, {"score", "lt", "5"}}, []int64{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filters []*Filter
+			for _, f := range tt.filters {
+				filter, err := ParseFilter(testItemsTable, f[0], f[1], f[2])
+				if err != nil {
+					t.Fatal(err)
+				}
+				filters = append(filters, filter)
+			}
+
+			var items []*testItem
+			if err := applyFilters(db, filters).Order("id").Find(&items).Error; err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(itemIDs(items)) != fmt.Sprint(tt.ids) {
+				t.Errorf("filters %v = ids %v, want %v", tt.filters, itemIDs(items), tt.ids)
+			}
+		})
+	}
+}
+
+func TestSplitFilterValues(t *testing.T) {
+	tests := []struct {
+		value  string
+		values []string
+		err    bool
+	}{
+		{"", []string{""}, false},
+		{"a", []string{"a"}, false},
+		{"a,b", []string{"a", "b"}, false},
+		{",", []string{"", ""}, false},
+		{"a, b", []string{"a", " b"}, false},
+		{`"a,b",c`, []string{"a,b", "c"}, false},
+		{`c,"a,b"`, []string{"c", "a,b"}, false},
+		{`"say ""hi"""`, []string{`say "hi"`}, false},
+		{`""`, []string{""}, false},
+		{`"",""`, []string{"", ""}, false},
+		{`it"s,x`, []string{`it"s`, "x"}, false},
+		{"a\nb", []string{"a\nb"}, false},
+		{"1\n2,3", []string{"1\n2", "3"}, false},
+
+		{`"abc`, nil, true},
+		{`"a""`, nil, true},
+		{`"a"b`, nil, true},
+		{`x,"a" ,b`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			values, err := splitFilterValues(tt.value)
+			if (err != nil) != tt.err {
+				t.Fatalf("splitFilterValues(%q) error = %v, want error %v", tt.value, err, tt.err)
+			}
+			if fmt.Sprintf("%q", values) != fmt.Sprintf("%q", tt.values) {
+				t.Errorf("splitFilterValues(%q) = %q, want %q", tt.value, values, tt.values)
+			}
+		})
+	}
+}
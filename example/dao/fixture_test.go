@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+	"github.com/jinzhu/gorm"
+)
+
+// testItem is the model of the items table of the tests, a column of every kind ParseFilter knows
+type testItem struct {
+	ID        int64      `gorm:"column:id;primary_key"`
+	Name      string     `gorm:"column:name"`
+	Score     null.Float `gorm:"column:score"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	Data      []byte     `gorm:"column:data"`
+	ParentID  null.Int   `gorm:"column:parent_id"`
+}
+
+func (testItem) TableName() string {
+	return "items"
+}
+
+var testItemsTable = &model.TableInfo{
+	Name: "items",
+	Columns: []*model.ColumnInfo{
+		{Name: "id", DatabaseTypeName: "bigint", IsPrimaryKey: true, GoFieldName: "ID", GoFieldType: "int64", JSONFieldName: "id"},
+		{Name: "name", DatabaseTypeName: "varchar", GoFieldName: "Name", GoFieldType: "string", JSONFieldName: "name"},
+		{Name: "score", DatabaseTypeName: "double", Nullable: true, GoFieldName: "Score", GoFieldType: "null.Float", JSONFieldName: "score"},
+		{Name: "created_at", DatabaseTypeName: "datetime", GoFieldName: "CreatedAt", GoFieldType: "time.Time", JSONFieldName: "created_at"},
+		{Name: "data", DatabaseTypeName: "blob", Nullable: true, GoFieldName: "Data", GoFieldType: "[]byte", JSONFieldName: "data"},
+		{Name: "parent_id", DatabaseTypeName: "bigint", Nullable: true, GoFieldName: "ParentID", GoFieldType: "null.Int", JSONFieldName: "parent"},
+		{Name: "children", GoFieldName: "Children", GoFieldType: "[]testItem", JSONFieldName: "children"},
+	},
+}
+
+// openTestDB returns a gorm DB on a new sqlite database holding the items table filled with items
+func openTestDB(t *testing.T, items ...*testItem) *gorm.DB {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "dao")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	db, err := gorm.Open("sqlite3", filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err = db.CreateTable(&testItem{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range items {
+		if err = db.Create(item).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+// itemIDs returns the ids of items in order
+func itemIDs(items []*testItem) []int64 {
+	ids := []int64{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+// useTestTables sets DB to a new sqlite database holding tables, created from their TableInfo, and the audit log until
+// the test ends. It returns the file of the database.
+func useTestTables(t *testing.T, tables ...string) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "dao")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	file := filepath.Join(dir, "test.db")
+	db, err := gorm.Open("sqlite3", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for _, name := range tables {
+		table, ok := model.GetTableInfo(name)
+		if !ok {
+			t.Fatalf("no table %s", name)
+		}
+
+		var columns []string
+		for _, col := range table.Columns {
+			switch {
+			case col.IsAutoIncrement:
+				columns = append(columns, fmt.Sprintf("%q INTEGER PRIMARY KEY AUTOINCREMENT", col.Name))
+			case col.IsPrimaryKey:
+				columns = append(columns, fmt.Sprintf("%q %s PRIMARY KEY", col.Name, col.DatabaseTypeName))
+			default:
+				columns = append(columns, fmt.Sprintf("%q %s", col.Name, col.DatabaseTypeName))
+			}
+		}
+		if err = db.Exec(fmt.Sprintf("CREATE TABLE %q (%s)", name, strings.Join(columns, ", "))).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = db.Exec(`CREATE TABLE audit_logs (id INTEGER PRIMARY KEY AUTOINCREMENT, table_name varchar, record_id varchar,
+		action varchar, principal varchar, request_id varchar, changes text, created_at datetime)`).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	saved := DB
+	t.Cleanup(func() { DB = saved })
+	DB = db
+	return file
+}
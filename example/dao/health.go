@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"restapi-golang-gin-gen/model"
+)
+
+// Ping checks the database is reachable, bounded by the OperationTimeout
+// error - ErrTimeout, ErrCanceled, the ping did not complete before ctx ended
+func Ping(ctx context.Context) (err error) {
+	if DB == nil {
+		return fmt.Errorf("database is not connected")
+	}
+
+	sqlDB, ok := DB.CommonDB().(*sql.DB)
+	if !ok {
+		// DB is bound to a connection of its own, e.g. a transaction, which is reachable while it lasts
+		return nil
+	}
+
+	ctx, cancel := operationContext(ctx)
+	defer cancel()
+
+	if err = sqlDB.PingContext(ctx); err != nil {
+		return contextError(ctx, err)
+	}
+	return nil
+}
+
+// PendingMigrationCount returns the number of migrations in dir that are not applied yet, bounded by the
+// OperationTimeout. It only reads the schema_migrations table, every migration is pending while the table does not
+// exist.
+// error - ErrTimeout, ErrCanceled, the count did not complete before ctx ended
+func PendingMigrationCount(ctx context.Context, dir string) (pending int, err error) {
+	ctx, cancel := operationContext(ctx)
+	defer cancel()
+
+	db, done := session(ctx)
+	defer done(&err)
+
+	migrations, err := LoadMigrations(dir, db.Dialect().GetName())
+	if err != nil || len(migrations) == 0 {
+		return 0, err
+	}
+
+	versions := make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		versions = append(versions, migration.Version)
+	}
+
+	applied := 0
+	if err = db.Model(&model.SchemaMigrations_{}).Where("version IN (?)", versions).Count(&applied).Error; err != nil {
+		// HasTable reports no table when its own query fails, it is only asked once ctx is known to be running
+		if ctx.Err() == nil && !db.HasTable(&model.SchemaMigrations_{}) {
+			return len(migrations), nil
+		}
+		return 0, err
+	}
+	return len(migrations) - applied, nil
+}
@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// MaxIncludeRecords the referencing records an include of a one to many relation may load for a page of records, a
+// page referenced by more is refused with ErrBadParams rather than read whole
+var MaxIncludeRecords = 1000
+
+// ParseInclude validates a comma separated list of relation names of table, e.g. customer,address
+// error - ErrBadParams, unknown relation
+func ParseInclude(table *model.TableInfo, value string) (relations []*model.Relation, err error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		relation, ok := table.Relation(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown include %q", ErrBadParams, name)
+		}
+		if !seen[relation.Name] {
+			seen[relation.Name] = true
+			relations = append(relations, relation)
+		}
+	}
+	return relations, nil
+}
+
+// RelationFields returns fields plus the key columns relations are loaded by, every column is read when fields is
+// empty
+func RelationFields(fields []*model.ColumnInfo, relations []*model.Relation) []*model.ColumnInfo {
+	if len(fields) == 0 {
+		return nil
+	}
+	return append(append([]*model.ColumnInfo{}, fields...), relationColumns(relations)...)
+}
+
+// LoadRelations reads the related records of a record, or of a slice of records, with a single query per relation.
+// The related records of the i-th record are returned by relation name in the i-th map, the referenced record or nil
+// for a foreign key and a slice of the referencing records otherwise.
+// error - ErrBadParams, the records are referenced by more than MaxIncludeRecords records of a one to many relation
+func LoadRelations(ctx context.Context, records interface{}, relations []*model.Relation) (related []map[string]interface{}, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	rows := reflect.ValueOf(records)
+	if rows.Kind() == reflect.Ptr && rows.Elem().Kind() == reflect.Struct {
+		rows = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rows.Type()), 0, 1), rows)
+	}
+	rows = reflect.Indirect(rows)
+
+	related = make([]map[string]interface{}, rows.Len())
+	for i := range related {
+		related[i] = map[string]interface{}{}
+	}
+
+	for _, relation := range relations {
+		keys := make([]*string, rows.Len())
+		var args []interface{}
+		seen := map[string]bool{}
+		for i := range keys {
+			field := reflect.Indirect(rows.Index(i)).FieldByName(relation.Column.GoFieldName)
+			if !field.IsValid() {
+				return nil, fmt.Errorf("include %s: column %s has no field %s", relation.Name, relation.Column.Name, relation.Column.GoFieldName)
+			}
+			keys[i] = cursorValue(field.Interface())
+			if keys[i] != nil && !seen[*keys[i]] {
+				seen[*keys[i]] = true
+				args = append(args, field.Interface())
+			}
+		}
+
+		byKey, err := loadRelated(db, relation, args)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, key := range keys {
+			var matches []interface{}
+			if key != nil {
+				matches = byKey[*key]
+			}
+
+			switch {
+			case relation.Many && matches == nil:
+				related[i][relation.Name] = []interface{}{}
+			case relation.Many:
+				related[i][relation.Name] = matches
+			case len(matches) > 0:
+				related[i][relation.Name] = matches[0]
+			default:
+				related[i][relation.Name] = nil
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// loadRelated reads the records of the related table of relation matching any of keys from db, grouped by key, up to
+// MaxIncludeRecords for a one to many relation
+func loadRelated(db *gorm.DB, relation *model.Relation, keys []interface{}) (map[string][]interface{}, error) {
+	byKey := map[string][]interface{}{}
+	if len(keys) == 0 {
+		return byKey, nil
+	}
+
+	record, ok := model.NewModel(relation.Table.Name)
+	if !ok {
+		return nil, fmt.Errorf("include %s: no model for table %s", relation.Name, relation.Table.Name)
+	}
+
+	results := reflect.New(reflect.SliceOf(reflect.TypeOf(record)))
+	db = db.Where(db.Dialect().Quote(relation.RefColumn.Name)+" IN (?)", keys)
+	if pk := relation.Table.PrimaryKey(); pk != nil {
+		db = db.Order(db.Dialect().Quote(pk.Name))
+	}
+	if relation.Many {
+		db = db.Limit(MaxIncludeRecords + 1)
+	}
+	if err := db.Find(results.Interface()).Error; err != nil {
+		return nil, err
+	}
+
+	rows := results.Elem()
+	if relation.Many && rows.Len() > MaxIncludeRecords {
+		return nil, fmt.Errorf("%w: include %s matches more than %d records, request a smaller pagesize", ErrBadParams, relation.Name, MaxIncludeRecords)
+	}
+	for i := 0; i < rows.Len(); i++ {
+		row := rows.Index(i)
+		field := row.Elem().FieldByName(relation.RefColumn.GoFieldName)
+		if !field.IsValid() {
+			return nil, fmt.Errorf("include %s: column %s has no field %s", relation.Name, relation.RefColumn.Name, relation.RefColumn.GoFieldName)
+		}
+		key := cursorValue(field.Interface())
+		if key != nil {
+			byKey[*key] = append(byKey[*key], row.Interface())
+		}
+	}
+	return byKey, nil
+}
+
+// relationColumns returns the key columns of the table relations are loaded by
+func relationColumns(relations []*model.Relation) []*model.ColumnInfo {
+	columns := make([]*model.ColumnInfo, len(relations))
+	for i, relation := range relations {
+		columns[i] = relation.Column
+	}
+	return columns
+}
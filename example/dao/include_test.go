@@ -0,0 +1,194 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+func TestParseInclude(t *testing.T) {
+	buildings, _ := model.GetTableInfo("buildings")
+
+	tests := []struct {
+		name  string
+		value string
+		names []string
+		err   error
+	}{
+		{"empty", "", nil, nil},
+		{"foreign key", "customer", []string{"customer"}, nil},
+		{"referencing table", "batteries", []string{"batteries"}, nil},
+		{"several with spaces", "customer, batteries", []string{"customer", "batteries"}, nil},
+		{"repeated", "batteries,batteries", []string{"batteries"}, nil},
+
+		{"unknown", "elevators", nil, ErrBadParams},
+		{"column name", "customer_id", nil, ErrBadParams},
+		{"empty item", "customer,", nil, ErrBadParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relations, err := ParseInclude(buildings, tt.value)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseInclude(%q) error = %v, want %v", tt.value, err, tt.err)
+			}
+			var names []string
+			for _, relation := range relations {
+				names = append(names, relation.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(tt.names) {
+				t.Errorf("ParseInclude(%q) = %v, want %v", tt.value, names, tt.names)
+			}
+		})
+	}
+}
+
+func TestRelationFields(t *testing.T) {
+	buildings, _ := model.GetTableInfo("buildings")
+	relations, err := ParseInclude(buildings, "customer,batteries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := buildings.Column("id")
+
+	if fields := RelationFields(nil, relations); fields != nil {
+		t.Errorf("RelationFields() of every column = %v, want nil", fields)
+	}
+
+	var names []string
+	for _, col := range RelationFields([]*model.ColumnInfo{id}, relations) {
+		names = append(names, col.Name)
+	}
+	if fmt.Sprint(names) != "[id customer_id id]" {
+		t.Errorf("RelationFields(id) = %v, want [id customer_id id]", names)
+	}
+}
+
+// batteryIDs returns the ids of the batteries included as related
+func batteryIDs(related interface{}) []int64 {
+	ids := []int64{}
+	for _, battery := range related.([]interface{}) {
+		ids = append(ids, battery.(*model.Batteries_).ID)
+	}
+	return ids
+}
+
+func TestLoadRelations(t *testing.T) {
+	useTestTables(t, "buildings", "batteries", "customers")
+	for _, record := range []interface{}{
+		&model.Customers_{CompanyName: null.StringFrom("acme")},
+		&model.Buildings_{CustomerID: null.IntFrom(1)},
+		&model.Buildings_{},
+		&model.Buildings_{CustomerID: null.IntFrom(9)},
+		&model.Batteries_{BuildingID: null.IntFrom(1)},
+		&model.Batteries_{BuildingID: null.IntFrom(1)},
+		&model.Batteries_{BuildingID: null.IntFrom(1), DeletedAt: null.TimeFrom(time.Now())},
+		&model.Batteries_{BuildingID: null.IntFrom(3)},
+	} {
+		if err := DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buildings, _ := model.GetTableInfo("buildings")
+	relations, err := ParseInclude(buildings, "customer,batteries")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records []*model.Buildings_
+	if err = DB.Order("id").Find(&records).Error; err != nil {
+		t.Fatal(err)
+	}
+	related, err := LoadRelations(context.Background(), &records, relations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		customer  interface{}
+		batteries []int64
+	}{
+		{int64(1), []int64{1, 2}},
+		{nil, []int64{}},
+		// a foreign key to a missing record includes null
+		{nil, []int64{4}},
+	}
+	for i, tt := range tests {
+		var customer interface{}
+		if c, ok := related[i]["customer"].(*model.Customers_); ok {
+			customer = c.ID
+		} else if related[i]["customer"] != nil {
+			t.Fatalf("building %d customer = %T", i+1, related[i]["customer"])
+		}
+		if customer != tt.customer {
+			t.Errorf("building %d customer = %v, want %v", i+1, customer, tt.customer)
+		}
+		if ids := batteryIDs(related[i]["batteries"]); fmt.Sprint(ids) != fmt.Sprint(tt.batteries) {
+			t.Errorf("building %d batteries = %v, want %v", i+1, ids, tt.batteries)
+		}
+	}
+
+	// a single record
+	related, err = LoadRelations(context.Background(), records[0], relations[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := batteryIDs(related[0]["batteries"]); fmt.Sprint(ids) != "[1 2]" {
+		t.Errorf("building 1 batteries = %v, want [1 2]", ids)
+	}
+}
+
+func TestLoadRelationsMaxIncludeRecords(t *testing.T) {
+	useTestTables(t, "buildings", "batteries")
+	saved := MaxIncludeRecords
+	t.Cleanup(func() { MaxIncludeRecords = saved })
+
+	for _, record := range []interface{}{
+		&model.Buildings_{},
+		&model.Buildings_{},
+		&model.Batteries_{BuildingID: null.IntFrom(1)},
+		&model.Batteries_{BuildingID: null.IntFrom(1)},
+		&model.Batteries_{BuildingID: null.IntFrom(2)},
+	} {
+		if err := DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buildings, _ := model.GetTableInfo("buildings")
+	relations, err := ParseInclude(buildings, "batteries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []*model.Buildings_
+	if err = DB.Order("id").Find(&records).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		max int
+		err error
+	}{
+		{3, nil},
+		{2, ErrBadParams},
+	}
+	for _, tt := range tests {
+		MaxIncludeRecords = tt.max
+		if _, err := LoadRelations(context.Background(), &records, relations); !errors.Is(err, tt.err) {
+			t.Errorf("LoadRelations() of 3 batteries with MaxIncludeRecords %d error = %v, want %v", tt.max, err, tt.err)
+		}
+	}
+
+	// the cap applies to the records of a page, a page of one building is loaded
+	MaxIncludeRecords = 2
+	if _, err := LoadRelations(context.Background(), records[0], relations); err != nil {
+		t.Errorf("LoadRelations() of 2 batteries with MaxIncludeRecords 2 error = %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListQuery describes the records requested from a GetAll function
+type ListQuery struct {
+	// Sort columns to order by, ending with the primary key
+	Sort []*SortKey
+
+	// Filters column conditions narrowing the records
+	Filters []*Filter
+
+	// Cursor continues from the boundary row of a previous page instead of using an offset, nil for offset pagination
+	Cursor *Cursor
+
+	// Count requests the total number of records matching the filters
+	Count bool
+
+	// Fields columns to read, every column when empty
+	Fields []*model.ColumnInfo
+
+	// Search full text search narrowing the records, nil when not searching
+	Search *Search
+
+	// Include relations whose related records are returned with the records
+	Include []*model.Relation
+
+	// Deleted soft deleted records returned, only for tables with soft delete
+	Deleted DeletedRows
+}
+
+// applyQuery narrows db to the records matching the filters, search and deleted selection of query
+func applyQuery(db *gorm.DB, query *ListQuery) *gorm.DB {
+	return applySearch(applyFilters(applyDeleted(db, query.Deleted), query.Filters), query.Search)
+}
+
+// ParseFields validates a comma separated list of column names (db column or json field) of table, an empty value
+// selects every column
+// error - ErrBadParams, unknown column
+func ParseFields(table *model.TableInfo, value string) (fields []*model.ColumnInfo, err error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		col, ok := table.Column(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrBadParams, name)
+		}
+		if !seen[col.Name] {
+			seen[col.Name] = true
+			fields = append(fields, col)
+		}
+	}
+	return fields, nil
+}
+
+// selectFields restricts the columns read by db to fields plus the extra columns a query depends on, every column is
+// read when fields is empty
+func selectFields(db *gorm.DB, fields []*model.ColumnInfo, extra ...*model.ColumnInfo) *gorm.DB {
+	if len(fields) == 0 {
+		return db
+	}
+
+	seen := map[string]bool{}
+	var columns []string
+	for _, col := range append(fields, extra...) {
+		if !seen[col.Name] {
+			seen[col.Name] = true
+			columns = append(columns, db.Dialect().Quote(col.Name))
+		}
+	}
+	return db.Select(columns)
+}
+
+// queryColumns returns the columns read along with the requested fields, the sort columns so page cursors can be built
+// and the key columns of the included relations
+func queryColumns(query *ListQuery) []*model.ColumnInfo {
+	return append(sortColumns(query.Sort), relationColumns(query.Include)...)
+}
+
+// sortColumns returns the columns of keys, read along with the requested fields so page cursors can be built
+func sortColumns(keys []*SortKey) []*model.ColumnInfo {
+	columns := make([]*model.ColumnInfo, len(keys))
+	for i, key := range keys {
+		columns[i] = key.Column
+	}
+	return columns
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"restapi-golang-gin-gen/model"
+)
+
+// MergePatch applies a json merge patch (RFC 7396) to record. A field set to null clears its column, fields missing
+// from the patch are left unchanged.
+// error - ErrBadParams, patch is not a json object, holds an unknown field, a null for a column that is not nullable,
+// an object or array (columns hold scalars, a nested merge does not apply) or a value of the wrong type
+func MergePatch(record model.Model, patch []byte) error {
+	var changes map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return fmt.Errorf("%w: merge patch must be a json object", ErrBadParams)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	var merged map[string]json.RawMessage
+	if err = json.Unmarshal(data, &merged); err != nil {
+		return err
+	}
+
+	table := record.TableInfo()
+	for name, value := range changes {
+		col, ok := table.Column(name)
+		if !ok || col.JSONFieldName != name {
+			return fmt.Errorf("%w: unknown field %q", ErrBadParams, name)
+		}
+
+		if string(value) == "null" {
+			if !col.Nullable {
+				return fmt.Errorf("%w: field %q can not be null", ErrBadParams, name)
+			}
+			delete(merged, name)
+			continue
+		}
+		// the null types would read an object such as {"Valid": false} as null
+		if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+			return fmt.Errorf("%w: field %q must be a json string, number or boolean", ErrBadParams, name)
+		}
+		merged[name] = value
+	}
+
+	if data, err = json.Marshal(merged); err != nil {
+		return err
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(record))
+	v.Set(reflect.Zero(v.Type()))
+	if err = json.Unmarshal(data, record); err != nil {
+		return fmt.Errorf("%w: %v", ErrBadParams, err)
+	}
+	return nil
+}
+
+// patchRecord applies patch to a copy of record, prepares and validates the copy for an update and copies its columns
+// back to record, the primary key and timestamps of record are kept
+// error - ErrBadParams, the patch is not a json object or changes an unknown field
+// error - *model.ValidationError, the patched record is invalid
+func patchRecord(record model.Model, patch []byte) error {
+	patched := reflect.New(reflect.Indirect(reflect.ValueOf(record)).Type())
+	patched.Elem().Set(reflect.Indirect(reflect.ValueOf(record)))
+	updated := patched.Interface().(model.Model)
+
+	if err := MergePatch(updated, patch); err != nil {
+		return err
+	}
+
+	if err := updated.BeforeSave(); err != nil {
+		return validationError(err)
+	}
+	updated.Prepare()
+	if err := updated.Validate(model.Update); err != nil {
+		return validationError(err)
+	}
+
+	return Replace(record, updated)
+}
+
+// validationError returns err as a *model.ValidationError listing its message when it is not one already
+func validationError(err error) error {
+	var validation *model.ValidationError
+	if errors.As(err, &validation) {
+		return validation
+	}
+	return &model.ValidationError{Errors: []*model.FieldError{{Message: err.Error()}}}
+}
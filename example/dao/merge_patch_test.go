@@ -0,0 +1,206 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// testElevator returns the elevator the merge patch tests start from
+func testElevator() *model.Elevators_ {
+	return &model.Elevators_{
+		ID:           7,
+		SerialNumber: null.IntFrom(5),
+		Model:        null.StringFrom("Gold"),
+		Status:       null.StringFrom("Active"),
+		Notes:        null.StringFrom("checked"),
+		CreatedAt:    time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// jsonOf returns record written to json
+func jsonOf(t *testing.T, record interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		patch  string
+		change func(e *model.Elevators_)
+		err    error
+	}{
+		{"empty patch", `{}`, func(e *model.Elevators_) {}, nil},
+		{"one field", `{"status": "Inactive"}`, func(e *model.Elevators_) { e.Status = null.StringFrom("Inactive") }, nil},
+		{"null removes a field", `{"notes": null}`, func(e *model.Elevators_) { e.Notes = null.String{} }, nil},
+		{"null for a field already null", `{"information": null}`, func(e *model.Elevators_) {}, nil},
+		{"several fields", `{"serial_number": 12, "notes": null, "type": "Residential"}`, func(e *model.Elevators_) {
+			e.SerialNumber = null.IntFrom(12)
+			e.Notes = null.String{}
+			e.Type = null.StringFrom("Residential")
+		}, nil},
+		{"last duplicate wins", `{"status": "Inactive", "status": "Intervention"}`, func(e *model.Elevators_) { e.Status = null.StringFrom("Intervention") }, nil},
+
+		{"unknown field", `{"colour": "red"}`, nil, ErrBadParams},
+		{"db column name", `{"SerialNumber": 12}`, nil, ErrBadParams},
+		{"relation", `{"column": {"id": 1}}`, nil, ErrBadParams},
+		{"nested object for a column", `{"model": {"name": "Gold"}}`, nil, ErrBadParams},
+		{"array for a column", `{"model": ["Gold"]}`, nil, ErrBadParams},
+		{"wrong type", `{"serial_number": "twelve"}`, nil, ErrBadParams},
+		{"null patch", `null`, nil, ErrBadParams},
+		{"array patch", `[{"status": "Inactive"}]`, nil, ErrBadParams},
+		{"string patch", `"status"`, nil, ErrBadParams},
+		{"invalid json", `{"status": `, nil, ErrBadParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := testElevator()
+			err := MergePatch(record, []byte(tt.patch))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MergePatch(%s) error = %v, want %v", tt.patch, err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			want := testElevator()
+			tt.change(want)
+			if got := jsonOf(t, record); got != jsonOf(t, want) {
+				t.Errorf("MergePatch(%s) = %s, want %s", tt.patch, got, jsonOf(t, want))
+			}
+		})
+	}
+}
+
+func TestMergePatchNotNullable(t *testing.T) {
+	user := &model.Users_{ID: 1, Email: "a@b.co"}
+	if err := MergePatch(user, []byte(`{"email": null}`)); !errors.Is(err, ErrBadParams) {
+		t.Errorf("MergePatch() of a null email error = %v, want %v", err, ErrBadParams)
+	}
+}
+
+func TestPatchRecord(t *testing.T) {
+	record := testElevator()
+	if err := patchRecord(record, []byte(`{"id": 9, "created_at": "2000-01-01T00:00:00Z", "status": "Inactive"}`)); err != nil {
+		t.Fatal(err)
+	}
+	want := testElevator()
+	want.Status = null.StringFrom("Inactive")
+	if got := jsonOf(t, record); got != jsonOf(t, want) {
+		t.Errorf("patchRecord() = %s, want the primary key and created_at kept: %s", got, jsonOf(t, want))
+	}
+
+	// an invalid patched record leaves record unchanged
+	user := &model.Users_{ID: 1, Email: "a@b.co"}
+	var validation *model.ValidationError
+	if err := patchRecord(user, []byte(`{"email": "not an email"}`)); !errors.As(err, &validation) {
+		t.Errorf("patchRecord() of an invalid email error = %v, want a *model.ValidationError", err)
+	}
+	if user.Email != "a@b.co" {
+		t.Errorf("email = %q after a failed patch, want a@b.co", user.Email)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	record := testElevator()
+	record.UpdatedAt = time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+	if err := Replace(record, &model.Elevators_{ID: 9, Status: null.StringFrom("Inactive"), CreatedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &model.Elevators_{
+		ID:        7,
+		Status:    null.StringFrom("Inactive"),
+		CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if got := jsonOf(t, record); got != jsonOf(t, want) {
+		t.Errorf("Replace() = %s, want every field but the key and timestamps replaced: %s", got, jsonOf(t, want))
+	}
+
+	if err := Replace(record, &model.Users_{}); err == nil {
+		t.Error("Replace() of a record of another table succeeded")
+	}
+}
+
+func TestUpdateAndPatch(t *testing.T) {
+	useTestTables(t, "elevators")
+	if err := DB.Create(testElevator()).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	steps := []struct {
+		name string
+		run  func() (*model.Elevators_, int64, error)
+		want func(e *model.Elevators_)
+		err  error
+	}{
+		{"put replaces every field", func() (*model.Elevators_, int64, error) {
+			return UpdateElevators_(ctx, 7, &model.Elevators_{Status: null.StringFrom("Inactive")})
+		}, func(e *model.Elevators_) {
+			e.Status = null.StringFrom("Inactive")
+			e.SerialNumber, e.Model, e.Notes = null.Int{}, null.String{}, null.String{}
+		}, nil},
+		{"patch changes only its fields", func() (*model.Elevators_, int64, error) {
+			return PatchElevators_(ctx, 7, []byte(`{"model": "Platinum", "status": null}`))
+		}, func(e *model.Elevators_) {
+			e.Model = null.StringFrom("Platinum")
+			e.Status = null.String{}
+			e.SerialNumber, e.Notes = null.Int{}, null.String{}
+		}, nil},
+		{"patch an unknown field", func() (*model.Elevators_, int64, error) {
+			return PatchElevators_(ctx, 7, []byte(`{"colour": "red"}`))
+		}, nil, ErrBadParams},
+		{"put an unknown record", func() (*model.Elevators_, int64, error) {
+			return UpdateElevators_(ctx, 8, &model.Elevators_{})
+		}, nil, ErrNotFound},
+		{"patch an unknown record", func() (*model.Elevators_, int64, error) {
+			return PatchElevators_(ctx, 8, []byte(`{}`))
+		}, nil, ErrNotFound},
+	}
+
+	for _, step := range steps {
+		_, _, err := step.run()
+		if !errors.Is(err, step.err) {
+			t.Fatalf("%s: error = %v, want %v", step.name, err, step.err)
+		}
+		if err != nil {
+			continue
+		}
+
+		stored := &model.Elevators_{}
+		if err = DB.First(stored, 7).Error; err != nil {
+			t.Fatal(err)
+		}
+		want := testElevator()
+		step.want(want)
+		for _, field := range []struct{ got, want interface{} }{
+			{stored.SerialNumber, want.SerialNumber},
+			{stored.Model, want.Model},
+			{stored.Status, want.Status},
+			{stored.Notes, want.Notes},
+		} {
+			if jsonOf(t, field.got) != jsonOf(t, field.want) {
+				t.Errorf("%s: stored %s, want %s", step.name, jsonOf(t, stored), jsonOf(t, want))
+				break
+			}
+		}
+		if !stored.UpdatedAt.After(stored.CreatedAt) {
+			t.Errorf("%s: updated_at %v not set past created_at %v", step.name, stored.UpdatedAt, stored.CreatedAt)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Migration is a versioned schema change read from a pair of <version>_<name>.up.sql and
+// <version>_<name>.down.sql files
+type Migration struct {
+	// Version of the migration, stored in the schema_migrations table once applied
+	Version string `json:"version"`
+
+	// Name of the migration taken from the file name
+	Name string `json:"name"`
+
+	// UpFile sql file applying the migration
+	UpFile string `json:"up_file,omitempty"`
+
+	// DownFile sql file reverting the migration, empty when the migration can not be rolled back
+	DownFile string `json:"down_file,omitempty"`
+
+	// Applied is true when the version is recorded in the schema_migrations table
+	Applied bool `json:"applied"`
+
+	// Missing is true for applied versions without a migration file, e.g. versions recorded by rails
+	Missing bool `json:"missing"`
+}
+
+var (
+	// ErrIrreversibleMigration error when rolling back a migration without a down file
+	ErrIrreversibleMigration = fmt.Errorf("migration has no down file")
+
+	// ErrUnsupportedDialect error when a migration has no file for the dialect of the database
+	ErrUnsupportedDialect = fmt.Errorf("migration not available for the database dialect")
+
+	migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)(?:\.(mysql|postgres|sqlite3|mssql))?\.(up|down)\.sql$`)
+)
+
+// LoadMigrations reads the migration files of dialect in dir sorted by version, every migration must have an up file.
+// A <version>_<name>.<dialect>.up.sql file is used in place of <version>_<name>.up.sql on its dialect only, a migration
+// whose files are all for other dialects can not be applied. A missing dir holds no migrations.
+func LoadMigrations(dir string, dialect string) (migrations []*Migration, err error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[string]*Migration{}
+
+	// files of every migration keyed by dialect (empty for the generic file) and direction, e.g. "sqlite3.up"
+	files := map[string]map[string]string{}
+	for _, file := range entries {
+		match := migrationFileRegexp.FindStringSubmatch(file.Name())
+		if file.IsDir() || match == nil {
+			continue
+		}
+		version, name, fileDialect, direction := match[1], match[2], match[3], match[4]
+
+		migration := byVersion[version]
+		if migration == nil {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+			files[version] = map[string]string{}
+			migrations = append(migrations, migration)
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("migration version %s is used by %s and %s", version, migration.Name, name)
+		}
+
+		files[version][fileDialect+"."+direction] = filepath.Join(dir, file.Name())
+	}
+
+	for _, migration := range migrations {
+		byDirection := files[migration.Version]
+
+		// the files of the dialect replace the generic files of the migration, the down file included
+		prefix := ""
+		if byDirection[dialect+".up"] != "" {
+			prefix = dialect
+		}
+		migration.UpFile = byDirection[prefix+".up"]
+		migration.DownFile = byDirection[prefix+".down"]
+
+		if migration.UpFile != "" {
+			continue
+		}
+
+		var available []string
+		for key := range byDirection {
+			if strings.HasSuffix(key, ".up") && key != ".up" {
+				available = append(available, strings.TrimSuffix(key, ".up"))
+			}
+		}
+		if len(available) > 0 {
+			sort.Strings(available)
+			return nil, fmt.Errorf("%w: %s_%s is only available for %s, add %s_%s.%s.up.sql", ErrUnsupportedDialect,
+				migration.Version, migration.Name, strings.Join(available, ", "), migration.Version, migration.Name, dialect)
+		}
+		return nil, fmt.Errorf("migration %s_%s has no up file", migration.Version, migration.Name)
+	}
+
+	sortMigrations(migrations)
+	return migrations, nil
+}
+
+// MigrationStatus returns the migrations in dir flagged as applied or pending, followed by applied versions without a
+// migration file
+func MigrationStatus(ctx context.Context, dir string) (migrations []*Migration, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	migrations, err = LoadMigrations(dir, db.Dialect().GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedMigrationVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		migration.Applied = applied[migration.Version]
+		delete(applied, migration.Version)
+	}
+
+	var missing []*Migration
+	for version := range applied {
+		missing = append(missing, &Migration{Version: version, Applied: true, Missing: true})
+	}
+	sortMigrations(missing)
+
+	return append(migrations, missing...), nil
+}
+
+// Migrate applies the pending migrations in dir in version order and returns the migrations applied. Every
+// migration runs in its own transaction together with the insert of its version, the run stops at the first failure.
+func Migrate(ctx context.Context, dir string) (applied []*Migration, err error) {
+	if !DB.HasTable(&model.SchemaMigrations_{}) {
+		if err = DB.CreateTable(&model.SchemaMigrations_{}).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	migrations, err := MigrationStatus(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		if migration.Applied {
+			continue
+		}
+
+		if err = runMigration(migration.UpFile, func(tx *gorm.DB) error {
+			return tx.Create(&model.SchemaMigrations_{Version: migration.Version}).Error
+		}); err != nil {
+			return applied, fmt.Errorf("migration %s_%s failed: %v", migration.Version, migration.Name, err)
+		}
+
+		migration.Applied = true
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Rollback reverts the last steps applied migrations in dir, newest first, and returns the migrations rolled back
+// error - ErrIrreversibleMigration, an applied migration has no down file
+func Rollback(ctx context.Context, dir string, steps int) (rolledBack []*Migration, err error) {
+	migrations, err := MigrationStatus(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []*Migration
+	for _, migration := range migrations {
+		if migration.Applied && !migration.Missing {
+			applied = append(applied, migration)
+		}
+	}
+
+	for i := len(applied) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+		migration := applied[i]
+		if migration.DownFile == "" {
+			return rolledBack, fmt.Errorf("%w: %s_%s", ErrIrreversibleMigration, migration.Version, migration.Name)
+		}
+
+		if err = runMigration(migration.DownFile, func(tx *gorm.DB) error {
+			return tx.Delete(&model.SchemaMigrations_{Version: migration.Version}).Error
+		}); err != nil {
+			return rolledBack, fmt.Errorf("rollback of %s_%s failed: %v", migration.Version, migration.Name, err)
+		}
+
+		migration.Applied = false
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
+
+// runMigration executes the statements of filename followed by record in a single transaction. Note mysql commits
+// DDL statements implicitly, so a failed migration may leave earlier statements of the file applied.
+func runMigration(filename string, record func(tx *gorm.DB) error) error {
+	// the statements may add or drop a FULLTEXT index, whether or not they all succeed
+	defer fullTextIndexes.Range(func(table, _ interface{}) bool {
+		fullTextIndexes.Delete(table)
+		return true
+	})
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	statements, err := splitSQL(string(data), DB.Dialect().GetName())
+	if err != nil {
+		return err
+	}
+
+	tx := DB.Begin()
+	if err = tx.Error; err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	for _, statement := range statements {
+		if err = tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	if err = record(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// appliedMigrationVersions returns the versions in the schema_migrations table read with db, none when the table is
+// missing
+func appliedMigrationVersions(db *gorm.DB) (map[string]bool, error) {
+	applied := map[string]bool{}
+	if !db.HasTable(&model.SchemaMigrations_{}) {
+		return applied, nil
+	}
+
+	var records []*model.SchemaMigrations_
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		applied[record.Version] = true
+	}
+	return applied, nil
+}
+
+// sortMigrations orders migrations by numeric version, versions are compared by length first so they need not be
+// zero padded
+func sortMigrations(migrations []*Migration) {
+	sort.Slice(migrations, func(i, j int) bool {
+		a, b := strings.TrimLeft(migrations[i].Version, "0"), strings.TrimLeft(migrations[j].Version, "0")
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,170 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		"1_generic.up.sql", "1_generic.down.sql",
+		"2_specific.up.sql", "2_specific.down.sql", "2_specific.sqlite3.up.sql",
+		"3_mysql_only.mysql.up.sql", "3_mysql_only.mysql.down.sql", "3_mysql_only.sqlite3.up.sql",
+		"readme.txt",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		dialect string
+		files   [][2]string
+		err     error
+	}{
+		{"mysql", [][2]string{
+			{"1_generic.up.sql", "1_generic.down.sql"},
+			{"2_specific.up.sql", "2_specific.down.sql"},
+			{"3_mysql_only.mysql.up.sql", "3_mysql_only.mysql.down.sql"},
+		}, nil},
+		// the sqlite3 up file of 2 replaces the generic files, the generic down file does not revert it
+		{"sqlite3", [][2]string{
+			{"1_generic.up.sql", "1_generic.down.sql"},
+			{"2_specific.sqlite3.up.sql", ""},
+			{"3_mysql_only.sqlite3.up.sql", ""},
+		}, nil},
+		{"postgres", nil, ErrUnsupportedDialect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			migrations, err := LoadMigrations(dir, tt.dialect)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("LoadMigrations(%s) error = %v, want %v", tt.dialect, err, tt.err)
+			}
+			if len(migrations) != len(tt.files) {
+				t.Fatalf("LoadMigrations(%s) = %d migrations, want %d", tt.dialect, len(migrations), len(tt.files))
+			}
+			for i, migration := range migrations {
+				up, down := filepath.Base(migration.UpFile), ""
+				if migration.DownFile != "" {
+					down = filepath.Base(migration.DownFile)
+				}
+				if up != tt.files[i][0] || down != tt.files[i][1] {
+					t.Errorf("migration %s files = %q %q, want %q %q", migration.Version, up, down, tt.files[i][0], tt.files[i][1])
+				}
+			}
+		})
+	}
+}
+
+func TestPendingMigrationCount(t *testing.T) {
+	saved := DB
+	t.Cleanup(func() { DB = saved })
+	DB = openTestDB(t)
+
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"1_first.up.sql", "2_second.up.sql", "3_third.up.sql"} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending := func(ctx context.Context) int {
+		t.Helper()
+		n, err := PendingMigrationCount(ctx, dir)
+		if err != nil {
+			t.Fatalf("PendingMigrationCount() error = %v", err)
+		}
+		return n
+	}
+
+	// every migration is pending until the table exists, which is not created by the count
+	if n := pending(context.Background()); n != 3 {
+		t.Errorf("PendingMigrationCount() without the table = %d, want 3", n)
+	}
+	if DB.HasTable(&model.SchemaMigrations_{}) {
+		t.Error("PendingMigrationCount() created the schema_migrations table")
+	}
+
+	if err = DB.CreateTable(&model.SchemaMigrations_{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, version := range []string{"1", "3", "20210101"} {
+		if err = DB.Create(&model.SchemaMigrations_{Version: version}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := pending(context.Background()); n != 1 {
+		t.Errorf("PendingMigrationCount() = %d, want 1", n)
+	}
+
+	if n, err := PendingMigrationCount(context.Background(), filepath.Join(dir, "missing")); err != nil || n != 0 {
+		t.Errorf("PendingMigrationCount(missing dir) = %d, %v, want 0, nil", n, err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = PendingMigrationCount(canceled, dir); !errors.Is(err, ErrCanceled) {
+		t.Errorf("PendingMigrationCount(canceled) error = %v, want ErrCanceled", err)
+	}
+}
+
+func TestRollbackIrreversible(t *testing.T) {
+	useTestTables(t)
+
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, statement := range map[string]string{
+		"1_notes.up.sql":   "CREATE TABLE notes (id integer);",
+		"1_notes.down.sql": "DROP TABLE notes;",
+		"2_tags.up.sql":    "CREATE TABLE tags (id integer);",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(statement), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	if _, err = Migrate(ctx, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	rolledBack, err := Rollback(ctx, dir, 2)
+	if !errors.Is(err, ErrIrreversibleMigration) {
+		t.Fatalf("Rollback() error = %v, want %v", err, ErrIrreversibleMigration)
+	}
+	if len(rolledBack) != 0 {
+		t.Errorf("Rollback() reverted %d migrations before the irreversible one, want 0", len(rolledBack))
+	}
+
+	migrations, err := MigrationStatus(ctx, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, migration := range migrations {
+		if !migration.Applied {
+			t.Errorf("migration %s is not applied after the failed rollback", migration.Version)
+		}
+	}
+}
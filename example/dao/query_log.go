@@ -0,0 +1,482 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// LevelDebug log level logging every statement, regardless of the QueryLogSampleRate
+	LevelDebug = "debug"
+
+	// LevelInfo level of the entry of a statement
+	LevelInfo = "info"
+
+	// LevelWarn level of the entry of a statement slower than the SlowQueryThreshold
+	LevelWarn = "warn"
+
+	// LevelError level of the entry of a failed statement
+	LevelError = "error"
+
+	// redacted replaces the values of the SensitiveColumns in a logged statement or audit entry
+	redacted = "[REDACTED]"
+
+	// explainTimeout time the EXPLAIN of a slow statement may run
+	explainTimeout = 5 * time.Second
+
+	// maxExplains number of EXPLAINs of slow statements running at once
+	maxExplains = 2
+)
+
+var (
+	// SlowQueryThreshold duration above which a statement is logged at warn level along with its EXPLAIN plan, 0 to
+	// never warn
+	SlowQueryThreshold = time.Second
+
+	// QueryLogSampleRate fraction of the statements logged at info level, warnings and errors are always logged
+	QueryLogSampleRate = 1.0
+
+	// queryLogLevel minimum level of the entries logged, changed while serving by SetQueryLogLevel
+	queryLogLevel atomic.Value
+
+	// logLevels the log levels from the most to the least verbose
+	logLevels = []string{LevelDebug, LevelInfo, LevelWarn, LevelError}
+
+	// SensitiveColumns the values bound to a column whose name holds any of these are redacted from the log and the
+	// audit log
+	SensitiveColumns = []string{"password", "token", "secret"}
+
+	// explainSlots holds a value for every EXPLAIN running, a slow select arriving while it is full is logged without
+	// its plan
+	explainSlots = make(chan struct{}, maxExplains)
+
+	// tablePattern finds the table a statement reads or writes
+	tablePattern = regexp.MustCompile("(?i)\\b(?:FROM|INTO|UPDATE|JOIN)\\s+[`\"\\[]?(\\w+)")
+)
+
+// QueryLog is the entry logged for a statement run by the dao, or for a failure reported by gorm
+type QueryLog struct {
+	Time  time.Time `json:"time"`
+	Level string    `json:"level"`
+
+	SQL        string        `json:"sql,omitempty"`
+	Args       []interface{} `json:"args,omitempty"`
+	DurationMs float64       `json:"duration_ms"`
+	Rows       int64         `json:"rows"`
+	Table      string        `json:"table,omitempty"`
+
+	RequestID string `json:"request_id,omitempty"`
+	Principal string `json:"principal,omitempty"`
+
+	Error string `json:"error,omitempty"`
+
+	// Plan rows of the EXPLAIN of a slow select
+	Plan []map[string]interface{} `json:"plan,omitempty"`
+}
+
+func init() {
+	queryLogLevel.Store(LevelInfo)
+}
+
+// SetQueryLogLevel sets the minimum level of the entries logged, one of debug, info, warn or error. At debug level
+// every statement is logged regardless of the QueryLogSampleRate.
+// error - ErrBadParams, unknown level
+func SetQueryLogLevel(level string) error {
+	if logLevelRank(level) < 0 {
+		return fmt.Errorf("%w: unknown log level %q, expected one of %s", ErrBadParams, level, strings.Join(logLevels, ", "))
+	}
+	queryLogLevel.Store(level)
+	return nil
+}
+
+// QueryLogLevel returns the minimum level of the entries logged
+func QueryLogLevel() string {
+	return queryLogLevel.Load().(string)
+}
+
+// logLevelRank returns the position of level from the most to the least verbose, -1 for an unknown level
+func logLevelRank(level string) int {
+	for i, l := range logLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// JSONLogger returns a Logger writing every entry to w as a line of json
+func JSONLogger(w io.Writer) LogSql {
+	var mu sync.Mutex
+	return func(ctx context.Context, entry *QueryLog) {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write(append(line, '\n'))
+	}
+}
+
+// LogQueries logs the statements run on db outside of the dao functions, e.g. by the migrations, with the Logger
+func LogQueries(db *gorm.DB) {
+	if pool, ok := db.CommonDB().(*sql.DB); ok {
+		logQueries(context.Background(), db, pool)
+	}
+}
+
+// logQueries logs the statements run on db with the Logger along with the request info of ctx, slow selects are
+// explained on pool. Nothing changes when no Logger is set.
+func logQueries(ctx context.Context, db *gorm.DB, pool *sql.DB) {
+	if Logger == nil {
+		return
+	}
+	db.SetLogger(&queryLogger{ctx: ctx, pool: pool, dialect: db.Dialect().GetName()})
+	db.LogMode(true)
+}
+
+// queryLogger receives the statements of a gorm.DB in detailed log mode
+type queryLogger struct {
+	ctx     context.Context
+	pool    *sql.DB
+	dialect string
+
+	mu sync.Mutex
+
+	// failed error reported by gorm ahead of the statement which failed with it
+	failed error
+}
+
+// Print is called by gorm with "sql", the caller, the duration, the statement, its args and the rows affected for a
+// statement, or with "log", the caller and the error for a failure. gorm reports the failure of a statement before the
+// statement itself, the error is held until the statement and logged along with it.
+func (l *queryLogger) Print(values ...interface{}) {
+	logger := Logger
+	if logger == nil || len(values) < 3 {
+		return
+	}
+
+	entry := l.newEntry()
+	var args []interface{}
+	switch values[0] {
+	case "sql":
+		if len(values) < 6 {
+			return
+		}
+		duration, _ := values[2].(time.Duration)
+		entry.SQL, _ = values[3].(string)
+		args, _ = values[4].([]interface{})
+		entry.Rows, _ = values[5].(int64)
+		entry.DurationMs = float64(duration) / float64(time.Millisecond)
+		entry.Args = redactArgs(entry.SQL, l.dialect, args)
+		if match := tablePattern.FindStringSubmatch(entry.SQL); match != nil {
+			entry.Table = match[1]
+		}
+
+		switch failed := l.holdFailure(nil); {
+		case failed != nil:
+			entry.Level = LevelError
+			entry.Error = failed.Error()
+		case SlowQueryThreshold > 0 && duration >= SlowQueryThreshold:
+			entry.Level = LevelWarn
+		case QueryLogLevel() != LevelDebug && QueryLogSampleRate < 1 && rand.Float64() >= QueryLogSampleRate:
+			return
+		}
+
+	case "log":
+		var failed error
+		for _, value := range values[2:] {
+			if err, ok := value.(error); ok {
+				failed = err
+			}
+		}
+		// an error held since the previous report was not the failure of a statement
+		if held := l.holdFailure(failed); held != nil {
+			failure := l.newEntry()
+			failure.Level = LevelError
+			failure.Error = held.Error()
+			logger(l.ctx, failure)
+		}
+		return
+
+	default:
+		return
+	}
+
+	if logLevelRank(entry.Level) < logLevelRank(QueryLogLevel()) {
+		return
+	}
+	if entry.Level == LevelWarn && l.explainLater(entry, args, logger) {
+		return
+	}
+	logger(l.ctx, entry)
+}
+
+// holdFailure holds failed until the next statement is reported and returns the failure held before
+func (l *queryLogger) holdFailure(failed error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	held := l.failed
+	l.failed = failed
+	return held
+}
+
+// explainLater logs the entry of a slow select along with its plan once explained, the EXPLAIN runs in the background
+// so the statement and the other statements of its logger are not held up. It returns false when the statement can not
+// be explained or every explainSlots is taken, the entry is then left to the caller to log without a plan.
+func (l *queryLogger) explainLater(entry *QueryLog, args []interface{}, logger LogSql) bool {
+	if l.pool == nil || explainPrefix(l.dialect, entry.SQL) == "" {
+		return false
+	}
+
+	select {
+	case explainSlots <- struct{}{}:
+	default:
+		return false
+	}
+
+	go func() {
+		defer func() { <-explainSlots }()
+		entry.Plan = l.explain(entry.SQL, args)
+		logger(l.ctx, entry)
+	}()
+	return true
+}
+
+// newEntry returns an entry carrying the request info of the logger
+func (l *queryLogger) newEntry() *QueryLog {
+	return &QueryLog{
+		Time:      time.Now().UTC(),
+		Level:     LevelInfo,
+		RequestID: RequestID(l.ctx),
+		Principal: Principal(l.ctx),
+	}
+}
+
+// explain returns the plan of a slow select, nil when it can not be explained
+func (l *queryLogger) explain(statement string, args []interface{}) []map[string]interface{} {
+	prefix := explainPrefix(l.dialect, statement)
+	if l.pool == nil || prefix == "" {
+		return nil
+	}
+
+	// the statement may have been cancelled along with its request, the plan is still worth reading
+	ctx, cancel := context.WithTimeout(context.Background(), explainTimeout)
+	defer cancel()
+
+	rows, err := l.pool.QueryContext(ctx, prefix+statement, args...)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil
+	}
+
+	var plan []map[string]interface{}
+	for rows.Next() {
+		values, err := scanRow(rows, len(columns))
+		if err != nil {
+			return nil
+		}
+		step := make(map[string]interface{}, len(columns))
+		for i, name := range columns {
+			step[name] = values[i]
+		}
+		plan = append(plan, step)
+	}
+	return plan
+}
+
+// explainPrefix returns the prefix explaining statement on dialect, empty when statement is not a select or the
+// dialect is not supported
+func explainPrefix(dialect string, statement string) string {
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(statement)), "SELECT") {
+		return ""
+	}
+
+	switch dialect {
+	case "sqlite3":
+		return "EXPLAIN QUERY PLAN "
+	case "mysql", "postgres":
+		return "EXPLAIN "
+	default:
+		return ""
+	}
+}
+
+// redactArgs returns the args of statement of dialect as logged, the values bound to a SensitiveColumns column are
+// redacted
+func redactArgs(statement string, dialect string, args []interface{}) []interface{} {
+	if len(args) == 0 {
+		return nil
+	}
+
+	columns := placeholderColumns(statement, dialect)
+	logged := make([]interface{}, len(args))
+	for i, arg := range args {
+		if i < len(columns) && sensitiveColumn(columns[i]) {
+			logged[i] = redacted
+			continue
+		}
+		if valuer, ok := arg.(driver.Valuer); ok {
+			if value, err := valuer.Value(); err == nil {
+				arg = value
+			}
+		}
+		if b, ok := arg.([]byte); ok {
+			arg = string(b)
+		}
+		if s, ok := arg.(string); ok {
+			arg = redactJSON(s)
+		}
+		logged[i] = arg
+	}
+	return logged
+}
+
+// redactJSON returns value with the SensitiveColumns fields redacted when it is a json object, e.g. the changes of an
+// audit log entry, value otherwise
+func redactJSON(value string) string {
+	if !strings.HasPrefix(value, "{") {
+		return value
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return value
+	}
+
+	changed := false
+	for name := range fields {
+		if sensitiveColumn(name) {
+			fields[name] = json.RawMessage(`"` + redacted + `"`)
+			changed = true
+		}
+	}
+	if !changed {
+		return value
+	}
+
+	result, err := json.Marshal(fields)
+	if err != nil {
+		return redacted
+	}
+	return string(result)
+}
+
+// placeholderColumns returns the column each placeholder of statement of dialect is bound to, in order, empty when it
+// is not bound to a column, e.g. a limit. The columns of an insert are taken from its column list, otherwise a
+// placeholder is bound to the column compared or assigned before it.
+func placeholderColumns(statement string, dialect string) []string {
+	tokens, err := lexSQLTokens(statement, dialect, true)
+	if err != nil {
+		return nil
+	}
+
+	var columns, insertColumns []string
+	column := ""
+	for i, token := range tokens {
+		switch {
+		case token.kind == sqlPunct && (token.text == "?" || token.text == "$" && i+1 < len(tokens) && tokens[i+1].kind == sqlNumber):
+			if insertColumns != nil {
+				columns = append(columns, insertColumns[len(columns)%len(insertColumns)])
+			} else {
+				columns = append(columns, column)
+			}
+
+		case token.kind == sqlWord && i == 0 && token.upper() == "INSERT":
+			insertColumns = insertColumnList(tokens)
+
+		case token.kind == sqlWord && isClauseKeyword(token.upper()):
+			column = ""
+
+		case isIdentifier(token) && i+1 < len(tokens) && isComparison(tokens[i+1]):
+			column = unquoteIdentifier(token.text)
+		}
+	}
+	return columns
+}
+
+// insertColumnList returns the columns of the parenthesized column list of an insert, nil when it has none
+func insertColumnList(tokens []sqlToken) []string {
+	var columns []string
+	for i, token := range tokens {
+		if token.kind == sqlWord && token.upper() == "VALUES" {
+			break
+		}
+		if token.kind == sqlPunct && token.text == "(" {
+			for _, t := range tokens[i+1:] {
+				if t.kind == sqlPunct && t.text == ")" {
+					break
+				}
+				if isIdentifier(t) {
+					columns = append(columns, unquoteIdentifier(t.text))
+				}
+			}
+			break
+		}
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	return columns
+}
+
+// isClauseKeyword returns true for the keywords ending the conditions or assignments of a column
+func isClauseKeyword(word string) bool {
+	switch word {
+	case "AND", "OR", "WHERE", "SET", "LIMIT", "OFFSET", "ORDER", "GROUP", "HAVING", "SELECT", "FROM", "VALUES":
+		return true
+	}
+	return false
+}
+
+// isIdentifier returns true for a word or quoted identifier token, gorm quotes identifiers with " for most dialects
+func isIdentifier(token sqlToken) bool {
+	return token.kind == sqlWord || token.kind == sqlQuotedIdentifier ||
+		token.kind == sqlString && strings.HasPrefix(token.text, `"`)
+}
+
+// isComparison returns true for a token comparing or assigning the column before it
+func isComparison(token sqlToken) bool {
+	if token.kind == sqlPunct {
+		return strings.ContainsAny(token.text, "=<>!")
+	}
+	switch token.upper() {
+	case "IN", "LIKE", "IS", "NOT", "BETWEEN":
+		return token.kind == sqlWord
+	}
+	return false
+}
+
+func unquoteIdentifier(text string) string {
+	return strings.Trim(text, "`\"[]")
+}
+
+// sensitiveColumn returns true when the values of column must not be logged
+func sensitiveColumn(column string) bool {
+	column = strings.ToLower(column)
+	for _, sensitive := range SensitiveColumns {
+		if sensitive != "" && strings.Contains(column, strings.ToLower(sensitive)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+func TestPlaceholderColumns(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		columns   []string
+	}{
+		{"insert", `INSERT INTO "users" ("email","encrypted_password","created_at") VALUES (?,?,?)`,
+			[]string{"email", "encrypted_password", "created_at"}},
+		{"insert of several rows", `INSERT INTO "users" ("email","reset_password_token") VALUES (?,?),(?,?)`,
+			[]string{"email", "reset_password_token", "email", "reset_password_token"}},
+		{"update", `UPDATE "users" SET "email" = ?, "encrypted_password" = ? WHERE "users"."id" = ?`,
+			[]string{"email", "encrypted_password", "id"}},
+		{"limit and offset", `SELECT * FROM "users" WHERE (api_token = ?) AND "deleted_at" IS NULL LIMIT ? OFFSET ?`,
+			[]string{"api_token", "", ""}},
+		{"in and like", `SELECT * FROM users WHERE id IN (?,?) AND secret_key LIKE ?`,
+			[]string{"id", "id", "secret_key"}},
+		{"numbered placeholders", `UPDATE users SET password = $1 WHERE id = $2`, []string{"password", "id"}},
+		{"backtick identifiers", "SELECT * FROM `users` WHERE `users`.`password` <> ? ORDER BY id", []string{"password"}},
+		{"placeholder in a string", `SELECT * FROM users WHERE name = '?' AND token = ?`, []string{"token"}},
+		{"no placeholder", `SELECT 1`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := placeholderColumns(tt.statement, "mysql"); fmt.Sprint(got) != fmt.Sprint(tt.columns) {
+				t.Errorf("placeholderColumns() = %q, want %q", got, tt.columns)
+			}
+		})
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		args      []interface{}
+		logged    []interface{}
+	}{
+		{"no args", `SELECT 1`, nil, nil},
+		{"insert", `INSERT INTO "users" ("email","encrypted_password") VALUES (?,?)`,
+			[]interface{}{"a@b.co", "hash"}, []interface{}{"a@b.co", redacted}},
+		{"update", `UPDATE "users" SET "reset_password_token" = ? WHERE "users"."id" = ?`,
+			[]interface{}{"abc", 1}, []interface{}{redacted, 1}},
+		{"case insensitive", `SELECT * FROM users WHERE API_TOKEN = ?`, []interface{}{"abc"}, []interface{}{redacted}},
+		{"limit", `SELECT * FROM users WHERE id = ? LIMIT ?`, []interface{}{1, 10}, []interface{}{1, 10}},
+		{"valuer", `UPDATE users SET name = ?, secret = ?`,
+			[]interface{}{null.StringFrom("bob"), null.StringFrom("s")}, []interface{}{"bob", redacted}},
+		{"null valuer", `UPDATE users SET name = ?`, []interface{}{null.String{}}, []interface{}{nil}},
+		{"bytes", `UPDATE users SET data = ?`, []interface{}{[]byte("abc")}, []interface{}{"abc"}},
+		{"json", `INSERT INTO audit_logs (changes) VALUES (?)`,
+			[]interface{}{`{"email":"a@b.co","password":"x"}`}, []interface{}{`{"email":"a@b.co","password":"` + redacted + `"}`}},
+		{"json without sensitive field", `INSERT INTO audit_logs (changes) VALUES (?)`,
+			[]interface{}{`{"b":1, "a":2}`}, []interface{}{`{"b":1, "a":2}`}},
+		{"more args than placeholders", `SELECT * FROM users WHERE token = ?`,
+			[]interface{}{"a", "b"}, []interface{}{redacted, "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactArgs(tt.statement, "mysql", tt.args); fmt.Sprintf("%#v", got) != fmt.Sprintf("%#v", tt.logged) {
+				t.Errorf("redactArgs() = %#v, want %#v", got, tt.logged)
+			}
+		})
+	}
+}
+
+func TestRedactArgsSensitiveColumns(t *testing.T) {
+	saved := SensitiveColumns
+	t.Cleanup(func() { SensitiveColumns = saved })
+	SensitiveColumns = append(SensitiveColumns, "SSN")
+
+	got := redactArgs(`UPDATE users SET ssn_last4 = ?, name = ?`, "mysql", []interface{}{"1234", "bob"})
+	if want := []interface{}{redacted, "bob"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("redactArgs() = %v, want %v", got, want)
+	}
+}
+
+// captureQueryLog sets the Logger to one collecting the entries logged, restored along with the settings of the query
+// log when the test ends
+func captureQueryLog(t *testing.T) chan *QueryLog {
+	t.Helper()
+
+	logger, threshold, rate, level := Logger, SlowQueryThreshold, QueryLogSampleRate, QueryLogLevel()
+	t.Cleanup(func() {
+		Logger, SlowQueryThreshold, QueryLogSampleRate = logger, threshold, rate
+		queryLogLevel.Store(level)
+	})
+
+	entries := make(chan *QueryLog, 100)
+	Logger = func(ctx context.Context, entry *QueryLog) { entries <- entry }
+	return entries
+}
+
+// levels returns the levels of the entries logged so far
+func levels(entries chan *QueryLog) []string {
+	var logged []string
+	for len(entries) > 0 {
+		logged = append(logged, (<-entries).Level)
+	}
+	return logged
+}
+
+func TestQueryLogSampling(t *testing.T) {
+	entries := captureQueryLog(t)
+	SlowQueryThreshold = time.Second
+
+	statement := func(l *queryLogger, duration time.Duration) {
+		l.Print("sql", "caller", duration, "UPDATE items SET name = ?", []interface{}{"a"}, int64(1))
+	}
+	failure := func(l *queryLogger) {
+		l.Print("log", "caller", fmt.Errorf("no such table"))
+		statement(l, time.Millisecond)
+	}
+
+	tests := []struct {
+		name   string
+		rate   float64
+		level  string
+		run    func(l *queryLogger)
+		levels []string
+	}{
+		{"every statement", 1, LevelInfo, func(l *queryLogger) { statement(l, 0); statement(l, 0) }, []string{LevelInfo, LevelInfo}},
+		{"no info statement", 0, LevelInfo, func(l *queryLogger) { statement(l, 0); statement(l, 0) }, nil},
+		{"debug ignores the rate", 0, LevelDebug, func(l *queryLogger) { statement(l, 0) }, []string{LevelInfo}},
+		{"slow statement", 0, LevelInfo, func(l *queryLogger) { statement(l, time.Second) }, []string{LevelWarn}},
+		{"failed statement", 0, LevelInfo, failure, []string{LevelError}},
+		{"warn level", 1, LevelWarn, func(l *queryLogger) { statement(l, 0); statement(l, time.Second); failure(l) },
+			[]string{LevelWarn, LevelError}},
+		{"error level", 1, LevelError, func(l *queryLogger) { statement(l, time.Second); failure(l) }, []string{LevelError}},
+		{"failure of no statement", 1, LevelInfo, func(l *queryLogger) {
+			l.Print("log", "caller", fmt.Errorf("a"))
+			l.Print("log", "caller", fmt.Errorf("b"))
+			statement(l, 0)
+		}, []string{LevelError, LevelError}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			QueryLogSampleRate = tt.rate
+			if err := SetQueryLogLevel(tt.level); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.run(&queryLogger{ctx: context.Background(), dialect: "sqlite3"})
+			if got := levels(entries); fmt.Sprint(got) != fmt.Sprint(tt.levels) {
+				t.Errorf("levels = %v, want %v", got, tt.levels)
+			}
+		})
+	}
+}
+
+func TestQueryLogSamplingRate(t *testing.T) {
+	entries := captureQueryLog(t)
+	QueryLogSampleRate = 0.5
+
+	l := &queryLogger{ctx: context.Background(), dialect: "sqlite3"}
+	for i := 0; i < 100; i++ {
+		l.Print("sql", "caller", time.Duration(0), "SELECT 1", []interface{}{}, int64(1))
+	}
+	if n := len(levels(entries)); n == 0 || n == 100 {
+		t.Errorf("%d of 100 statements logged at a rate of 0.5", n)
+	}
+}
+
+func TestSlowQueryExplain(t *testing.T) {
+	entries := captureQueryLog(t)
+	SlowQueryThreshold = time.Millisecond
+	db := openTestDB(t, &testItem{ID: 1, Name: "a"})
+
+	l := &queryLogger{ctx: context.Background(), pool: db.DB(), dialect: "sqlite3"}
+	l.Print("sql", "caller", time.Second, `SELECT * FROM "items" WHERE "id" = ?`, []interface{}{1}, int64(1))
+
+	select {
+	case entry := <-entries:
+		if entry.Level != LevelWarn || len(entry.Plan) == 0 {
+			t.Errorf("entry level %s with plan %v, want a warn entry with a plan", entry.Level, entry.Plan)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the slow select was not logged")
+	}
+}
+
+func TestSlowQueryExplainBusy(t *testing.T) {
+	entries := captureQueryLog(t)
+	SlowQueryThreshold = time.Millisecond
+	db := openTestDB(t)
+
+	// every slot is taken, the slow select is logged right away without its plan
+	for i := 0; i < cap(explainSlots); i++ {
+		explainSlots <- struct{}{}
+	}
+	defer func() {
+		for i := 0; i < cap(explainSlots); i++ {
+			<-explainSlots
+		}
+	}()
+
+	l := &queryLogger{ctx: context.Background(), pool: db.DB(), dialect: "sqlite3"}
+	l.Print("sql", "caller", time.Second, `SELECT * FROM "items"`, []interface{}{}, int64(0))
+
+	if len(entries) != 1 {
+		t.Fatalf("%d entries logged, want 1", len(entries))
+	}
+	if entry := <-entries; entry.Level != LevelWarn || entry.Plan != nil {
+		t.Errorf("entry level %s with plan %v, want a warn entry without a plan", entry.Level, entry.Plan)
+	}
+}
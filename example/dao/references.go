@@ -0,0 +1,304 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+type cascadeKey struct{}
+
+// WithCascade returns ctx whose deletes cascade along the foreign keys with the restrict policy, the referencing records
+// are deleted along with the record instead of failing the delete
+func WithCascade(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cascadeKey{}, true)
+}
+
+// cascading returns true when the deletes of ctx cascade
+func cascading(ctx context.Context) bool {
+	cascade, _ := ctx.Value(cascadeKey{}).(bool)
+	return cascade
+}
+
+// DeleteEffect is the change the delete of a record makes to the records of a table referencing it along a foreign key
+type DeleteEffect struct {
+	Table  string `json:"table" example:"interventions"`
+	Column string `json:"column" example:"elevator_id"`
+
+	// Action delete, nullify or restrict, a restrict effect fails the delete
+	Action string `json:"action" example:"delete"`
+
+	Rows int `json:"rows" example:"3"`
+}
+
+// DeletePreview is the outcome of the dry run of a delete, every record reached along the foreign keys is counted,
+// records of cascaded deletes included
+type DeletePreview struct {
+	Table string `json:"table" example:"elevators"`
+	ID    string `json:"id" example:"12"`
+
+	// Allowed is false when a referencing record restricts the delete
+	Allowed bool `json:"allowed"`
+
+	Effects []*DeleteEffect `json:"effects"`
+}
+
+// PreviewDelete is a function to get the changes deleting a record of table would make to the records referencing it,
+// following the delete policies of the foreign keys and the cascade of ctx. Nothing is changed.
+// error - ErrNotFound, db record for id not found
+// error - ErrReadFailed, db Find error
+func PreviewDelete(ctx context.Context, table *model.TableInfo, argID interface{}) (preview *DeletePreview, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	record, ok := model.NewModel(table.Name)
+	if !ok {
+		return nil, fmt.Errorf("no model for table %s", table.Name)
+	}
+
+	pk := table.PrimaryKey()
+	if pk == nil {
+		return nil, fmt.Errorf("%w: table %s has no primary key", ErrBadParams, table.Name)
+	}
+
+	if err = db.Where(db.Dialect().Quote(pk.Name)+" = ?", argID).First(record).Error; err != nil {
+		return nil, readError(err)
+	}
+
+	walk := &referenceWalk{ctx: ctx, tx: db, cascade: cascading(ctx), seen: map[string]bool{}}
+	if err = walk.visit(record); err != nil {
+		return nil, err
+	}
+
+	preview = &DeletePreview{Table: table.Name, ID: fmt.Sprint(argID), Allowed: true, Effects: walk.effects}
+	if preview.Effects == nil {
+		preview.Effects = []*DeleteEffect{}
+	}
+	for _, effect := range preview.Effects {
+		if effect.Action == model.DeleteRestrict {
+			preview.Allowed = false
+		}
+	}
+	return preview, nil
+}
+
+// deleteReferences applies the delete policies of the foreign keys referencing record, about to be deleted in tx. The
+// changes are recorded in the audit log along with the principal and request id of ctx.
+// error - ErrConflict, record is referenced along a foreign key with the restrict policy and ctx does not cascade, or
+// a soft deleted record would cascade to a table without soft delete
+func deleteReferences(ctx context.Context, tx *gorm.DB, record model.Model) error {
+	walk := &referenceWalk{ctx: ctx, tx: tx, cascade: cascading(ctx), apply: true, seen: map[string]bool{}}
+	return walk.visit(record)
+}
+
+// referenceWalk follows the foreign keys referencing a deleted record, and those referencing the records a cascade
+// deletes in turn
+type referenceWalk struct {
+	ctx     context.Context
+	tx      *gorm.DB
+	cascade bool
+
+	// apply changes the referencing records, otherwise the effects are only recorded
+	apply bool
+
+	// seen records visited keyed by table and primary key, records referencing each other are visited once
+	seen map[string]bool
+
+	effects []*DeleteEffect
+}
+
+// visit applies the delete policies of the foreign keys referencing record
+func (w *referenceWalk) visit(record model.Model) error {
+	table := record.TableInfo()
+	recordV := reflect.Indirect(reflect.ValueOf(record))
+
+	if pk := table.PrimaryKey(); pk != nil {
+		if id := cursorValue(recordV.FieldByName(pk.GoFieldName).Interface()); id != nil {
+			if w.seen[table.Name+"/"+*id] {
+				return nil
+			}
+			w.seen[table.Name+"/"+*id] = true
+		}
+	}
+
+	for _, relation := range table.Relations() {
+		if !relation.Many {
+			continue
+		}
+
+		field := recordV.FieldByName(relation.Column.GoFieldName)
+		if !field.IsValid() {
+			return fmt.Errorf("column %s has no field %s", relation.Column.Name, relation.Column.GoFieldName)
+		}
+		if cursorValue(field.Interface()) == nil {
+			continue
+		}
+
+		children, err := w.referencing(relation, field.Interface())
+		if err != nil {
+			return err
+		}
+		if len(children) == 0 {
+			continue
+		}
+
+		policy := relation.ForeignKey.DeletePolicy()
+		if policy == model.DeleteRestrict && w.cascade {
+			policy = model.DeleteCascade
+		}
+
+		// a soft deleted record can be restored, the records of a table without soft delete could not be
+		if policy == model.DeleteCascade && table.SoftDelete() && !relation.Table.SoftDelete() {
+			w.record(relation, model.DeleteRestrict, len(children))
+			if w.apply {
+				return fmt.Errorf("%w: %d %s records reference the record along %s and can not be soft deleted with it, delete them first",
+					ErrConflict, len(children), relation.Table.Name, relation.RefColumn.Name)
+			}
+			continue
+		}
+
+		switch policy {
+		case model.DeleteRestrict:
+			w.record(relation, model.DeleteRestrict, len(children))
+			if w.apply {
+				return fmt.Errorf("%w: %d %s records reference the record along %s, delete them first or delete with cascade=true",
+					ErrConflict, len(children), relation.Table.Name, relation.RefColumn.Name)
+			}
+
+		case model.DeleteNullify:
+			w.record(relation, model.DeleteNullify, len(children))
+			if w.apply {
+				for _, child := range children {
+					if err = w.nullify(child, relation.RefColumn); err != nil {
+						return err
+					}
+				}
+			}
+
+		case model.DeleteCascade:
+			w.record(relation, "delete", len(children))
+			for _, child := range children {
+				if err = w.visit(child); err != nil {
+					return err
+				}
+				if w.apply {
+					if err = w.tx.Delete(child).Error; err != nil {
+						return dbError(err, ErrDeleteFailed)
+					}
+					if err = writeAudit(w.ctx, w.tx, AuditDelete, child, nil); err != nil {
+						return ErrDeleteFailed
+					}
+				}
+			}
+
+		default:
+			return fmt.Errorf("foreign key %s has unknown delete policy %q", relation.ForeignKey.Name, policy)
+		}
+	}
+
+	return nil
+}
+
+// referencing reads the records of the table of relation referencing key, soft deleted records are left out
+func (w *referenceWalk) referencing(relation *model.Relation, key interface{}) ([]model.Model, error) {
+	record, ok := model.NewModel(relation.Table.Name)
+	if !ok {
+		return nil, fmt.Errorf("no model for table %s", relation.Table.Name)
+	}
+
+	results := reflect.New(reflect.SliceOf(reflect.TypeOf(record)))
+	if err := w.tx.Where(w.tx.Dialect().Quote(relation.RefColumn.Name)+" = ?", key).Find(results.Interface()).Error; err != nil {
+		return nil, err
+	}
+
+	rows := results.Elem()
+	children := make([]model.Model, rows.Len())
+	for i := range children {
+		children[i] = rows.Index(i).Interface().(model.Model)
+	}
+	return children, nil
+}
+
+// nullify sets col of child to null
+func (w *referenceWalk) nullify(child model.Model, col *model.ColumnInfo) error {
+	before, err := auditValues(child)
+	if err != nil {
+		return ErrDeleteFailed
+	}
+
+	field := reflect.Indirect(reflect.ValueOf(child)).FieldByName(col.GoFieldName)
+	if !field.IsValid() {
+		return fmt.Errorf("column %s has no field %s", col.Name, col.GoFieldName)
+	}
+	field.Set(reflect.Zero(field.Type()))
+
+	if err = setUpdated(child, timestampNow()); err != nil {
+		return ErrDeleteFailed
+	}
+	if err = w.tx.Save(child).Error; err != nil {
+		return dbError(err, ErrDeleteFailed)
+	}
+	if err = writeAudit(w.ctx, w.tx, AuditUpdate, child, before); err != nil {
+		return ErrDeleteFailed
+	}
+	return nil
+}
+
+// record adds rows referencing records along relation to the effects of the walk
+func (w *referenceWalk) record(relation *model.Relation, action string, rows int) {
+	for _, effect := range w.effects {
+		if effect.Table == relation.Table.Name && effect.Column == relation.RefColumn.Name && effect.Action == action {
+			effect.Rows += rows
+			return
+		}
+	}
+	w.effects = append(w.effects, &DeleteEffect{Table: relation.Table.Name, Column: relation.RefColumn.Name, Action: action, Rows: rows})
+}
+
+// checkReferences checks the records referenced along the foreign keys of record, about to be saved in tx, exist and
+// are not soft deleted. before holds the auditValues of the record read before an update and is nil otherwise, columns
+// left unchanged by the update are not checked.
+// error - *model.ValidationError listing every column referencing a missing record
+func checkReferences(tx *gorm.DB, record model.Model, before map[string]json.RawMessage) error {
+	recordV := reflect.Indirect(reflect.ValueOf(record))
+	result := &model.ValidationError{}
+
+	for _, relation := range record.TableInfo().Relations() {
+		if relation.Many {
+			continue
+		}
+
+		field := recordV.FieldByName(relation.Column.GoFieldName)
+		if !field.IsValid() {
+			return fmt.Errorf("column %s has no field %s", relation.Column.Name, relation.Column.GoFieldName)
+		}
+
+		key := cursorValue(field.Interface())
+		if key == nil {
+			continue
+		}
+
+		if before != nil {
+			if value, err := json.Marshal(field.Interface()); err == nil && bytes.Equal(value, before[relation.Column.JSONFieldName]) {
+				continue
+			}
+		}
+
+		var count int
+		db := notDeleted(tx.Table(relation.Table.Name), relation.Table)
+		if err := db.Where(tx.Dialect().Quote(relation.RefColumn.Name)+" = ?", field.Interface()).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			result.Add(relation.Column.JSONFieldName, fmt.Sprintf("references %s %s which does not exist", relation.Table.Name, *key))
+		}
+	}
+
+	return result.Err()
+}
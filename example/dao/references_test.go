@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// useDeletePolicy sets the delete policy of the foreign key of column of table until the test ends
+func useDeletePolicy(t *testing.T, table, column, policy string) {
+	t.Helper()
+
+	tableInfo, _ := model.GetTableInfo(table)
+	for _, fk := range tableInfo.ForeignKeys {
+		if fk.Column == column {
+			fk, saved := fk, fk.OnDelete
+			t.Cleanup(func() { fk.OnDelete = saved })
+		}
+	}
+	if err := model.SetDeletePolicy(table, column, policy); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// useReferenceTables sets DB to a database holding column 1 with elevators 1 and 2 and intervention 1 on elevator 1
+func useReferenceTables(t *testing.T) {
+	t.Helper()
+
+	useTestTables(t, "columns", "elevators", "interventions")
+	for _, record := range []interface{}{
+		&model.Columns_{},
+		&model.Elevators_{ColumnID: null.IntFrom(1)},
+		&model.Elevators_{ColumnID: null.IntFrom(1)},
+		&model.Interventions_{ElevatorID: null.IntFrom(1)},
+	} {
+		if err := DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// storedInterventions returns the stored interventions as id:elevator_id with deleted ones marked
+func storedInterventions(t *testing.T) string {
+	t.Helper()
+
+	var interventions []*model.Interventions_
+	if err := DB.Unscoped().Order("id").Find(&interventions).Error; err != nil {
+		t.Fatal(err)
+	}
+	var stored []string
+	for _, intervention := range interventions {
+		entry := fmt.Sprintf("%d:%s", intervention.ID, jsonOf(t, intervention.ElevatorID))
+		if intervention.DeletedAt.Valid {
+			entry += ":deleted"
+		}
+		stored = append(stored, entry)
+	}
+	return strings.Join(stored, " ")
+}
+
+func TestDeleteReferences(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        string
+		cascade       bool
+		err           error
+		interventions string
+		audited       string
+	}{
+		{"restrict", model.DeleteRestrict, false, ErrConflict, "1:1", "[]"},
+		{"restrict with cascade", model.DeleteRestrict, true, nil, "1:1:deleted", "[delete]"},
+		{"cascade", model.DeleteCascade, false, nil, "1:1:deleted", "[delete]"},
+		{"nullify", model.DeleteNullify, false, nil, "1:null", "[update]"},
+		{"nullify with cascade", model.DeleteNullify, true, nil, "1:null", "[update]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useReferenceTables(t)
+			useDeletePolicy(t, "interventions", "elevator_id", tt.policy)
+			ctx := context.Background()
+			if tt.cascade {
+				ctx = WithCascade(ctx)
+			}
+
+			if _, err := DeleteElevators_(ctx, 1); !errors.Is(err, tt.err) {
+				t.Fatalf("DeleteElevators_() error = %v, want %v", err, tt.err)
+			}
+			if got := storedInterventions(t); got != tt.interventions {
+				t.Errorf("interventions = %s, want %s", got, tt.interventions)
+			}
+			if got := fmt.Sprint(auditActions(t, "interventions")); got != tt.audited {
+				t.Errorf("audited interventions %s, want %s", got, tt.audited)
+			}
+
+			want := "[2]"
+			if tt.err != nil {
+				want = "[1 2]"
+			}
+			if got := listElevators(t, ExcludeDeleted); got != want {
+				t.Errorf("elevators = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDeleteReferencesCascadesAlongChildren(t *testing.T) {
+	useReferenceTables(t)
+	ctx := WithCascade(context.Background())
+
+	if _, err := DeleteColumns_(context.Background(), 1); !errors.Is(err, ErrConflict) {
+		t.Fatalf("DeleteColumns_() without cascade error = %v, want %v", err, ErrConflict)
+	}
+
+	if _, err := DeleteColumns_(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := listElevators(t, OnlyDeleted); got != "[1 2]" {
+		t.Errorf("deleted elevators = %s, want [1 2]", got)
+	}
+	if got := storedInterventions(t); got != "1:1:deleted" {
+		t.Errorf("interventions = %s, want 1:1:deleted", got)
+	}
+}
+
+func TestPreviewDelete(t *testing.T) {
+	useReferenceTables(t)
+	columns, _ := model.GetTableInfo("columns")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		allowed bool
+		effects string
+	}{
+		{"restrict", context.Background(), false, `[{"table":"elevators","column":"column_id","action":"restrict","rows":2}]`},
+		{"cascade", WithCascade(context.Background()), true,
+			`[{"table":"elevators","column":"column_id","action":"delete","rows":2},{"table":"interventions","column":"elevator_id","action":"delete","rows":1}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview, err := PreviewDelete(tt.ctx, columns, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if preview.Allowed != tt.allowed || jsonOf(t, preview.Effects) != tt.effects {
+				t.Errorf("PreviewDelete() = allowed %v %s, want %v %s", preview.Allowed, jsonOf(t, preview.Effects), tt.allowed, tt.effects)
+			}
+		})
+	}
+
+	// a preview changes nothing
+	if got := listElevators(t, ExcludeDeleted); got != "[1 2]" {
+		t.Errorf("elevators after the previews = %s, want [1 2]", got)
+	}
+}
+
+func TestDeleteReferencesSoftDeleteToHardDelete(t *testing.T) {
+	useTestTables(t, "buildings", "building_details", "batteries", "interventions")
+	for _, record := range []interface{}{&model.Buildings_{}, &model.BuildingDetails_{BuildingID: null.IntFrom(1)}} {
+		if err := DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	useDeletePolicy(t, "building_details", "building_id", model.DeleteCascade)
+	buildings, _ := model.GetTableInfo("buildings")
+
+	// building details have no soft delete, they could not be restored along with the building
+	for _, ctx := range []context.Context{context.Background(), WithCascade(context.Background())} {
+		_, err := DeleteBuildings_(ctx, 1)
+		if !errors.Is(err, ErrConflict) || !strings.Contains(err.Error(), "can not be soft deleted with it") {
+			t.Errorf("DeleteBuildings_() error = %v, want %v refusing to cascade", err, ErrConflict)
+		}
+	}
+
+	preview, err := PreviewDelete(context.Background(), buildings, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"table":"building_details","column":"building_id","action":"restrict","rows":1}]`; preview.Allowed || jsonOf(t, preview.Effects) != want {
+		t.Errorf("PreviewDelete() = allowed %v %s, want false %s", preview.Allowed, jsonOf(t, preview.Effects), want)
+	}
+
+	var details, remaining int
+	if err = DB.Model(&model.BuildingDetails_{}).Count(&details).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err = DB.Model(&model.Buildings_{}).Count(&remaining).Error; err != nil {
+		t.Fatal(err)
+	}
+	if details != 1 || remaining != 1 {
+		t.Errorf("%d buildings and %d details after the refused deletes, want 1 and 1", remaining, details)
+	}
+}
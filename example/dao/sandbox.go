@@ -0,0 +1,225 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SandboxConfig is used to define the connection and limits for executing user supplied sql
+type SandboxConfig struct {
+
+	// Dialect database/sql driver name of the read only connection
+	Dialect string
+
+	// DSN data source name of a connection whose user is only granted read access
+	DSN string
+
+	// MaxExecutionTime time a statement is allowed to run before it is cancelled
+	MaxExecutionTime time.Duration
+
+	// MaxRows rows read from a result set before it is truncated
+	MaxRows int
+
+	// MaxResultBytes approximate json size of the rows read from a result set before it is truncated
+	MaxResultBytes int64
+}
+
+// Sandbox executes user supplied select statements against a separate read only connection
+type Sandbox struct {
+	db     *sql.DB
+	config SandboxConfig
+}
+
+// StatementResult is the result set of a statement run in the sandbox
+type StatementResult struct {
+	Columns   []string        `json:"columns"`
+	Rows      [][]interface{} `json:"rows"`
+	Truncated bool            `json:"truncated"`
+	ElapsedMs int64           `json:"elapsed_ms"`
+	Error     string          `json:"error,omitempty"`
+}
+
+var (
+	// ErrSandboxUnavailable error when no sandbox connection is configured
+	ErrSandboxUnavailable = fmt.Errorf("sql sandbox not configured")
+
+	// ErrStatementNotAllowed error when a statement is not a single read only select
+	ErrStatementNotAllowed = fmt.Errorf("only a single select statement is allowed")
+
+	// SQLSandbox reference to the sandbox used to run user supplied sql, nil when not configured
+	SQLSandbox *Sandbox
+)
+
+// forbiddenSQLWords are keywords and functions rejected anywhere in a sandboxed statement, either because they
+// modify data or because they reach outside of the database.
+var forbiddenSQLWords = map[string]bool{
+	"ALTER": true, "ANALYZE": true, "CALL": true, "CREATE": true, "DEALLOCATE": true, "DELETE": true, "DO": true,
+	"DROP": true, "EXEC": true, "EXECUTE": true, "GRANT": true, "HANDLER": true, "INSERT": true, "INTO": true,
+	"KILL": true, "LOAD": true, "LOCK": true, "MERGE": true, "OPTIMIZE": true, "PREPARE": true, "RENAME": true,
+	"REVOKE": true, "SET": true, "SHUTDOWN": true, "TRUNCATE": true, "UNLOCK": true,
+	"UPDATE": true, "LOAD_FILE": true, "GET_LOCK": true, "RELEASE_LOCK": true, "RELEASE_ALL_LOCKS": true,
+	"ATTACH": true, "DETACH": true, "PRAGMA": true, "VACUUM": true,
+}
+
+// NewSandbox opens the read only connection described by config
+func NewSandbox(config SandboxConfig) (*Sandbox, error) {
+	if config.DSN == "" {
+		return nil, ErrSandboxUnavailable
+	}
+
+	db, err := sql.Open(config.Dialect, config.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Sandbox{db: db, config: config}, nil
+}
+
+// Close closes the sandbox connection
+func (s *Sandbox) Close() error {
+	return s.db.Close()
+}
+
+// CheckReadOnlyStatement parses statement of dialect and returns ErrStatementNotAllowed unless it is a single SELECT
+// (or WITH ... SELECT) statement free of data modifying keywords.
+func CheckReadOnlyStatement(statement string, dialect string) error {
+	tokens, err := lexSQL(statement, dialect)
+	if err != nil {
+		return err
+	}
+
+	for len(tokens) > 0 && tokens[len(tokens)-1].kind == sqlPunct && tokens[len(tokens)-1].text == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	first := -1
+	for i, t := range tokens {
+		if t.kind == sqlPunct && t.text == ";" {
+			return ErrStatementNotAllowed
+		}
+		if t.kind == sqlWord && forbiddenSQLWords[t.upper()] {
+			return ErrStatementNotAllowed
+		}
+		if first < 0 && !(t.kind == sqlPunct && t.text == "(") {
+			first = i
+		}
+	}
+
+	if first < 0 || tokens[first].kind != sqlWord {
+		return ErrStatementNotAllowed
+	}
+
+	switch tokens[first].upper() {
+	case "SELECT", "WITH":
+		return nil
+	default:
+		return ErrStatementNotAllowed
+	}
+}
+
+// Query runs statement inside a read only transaction, the query is cancelled when ctx is done or the max
+// execution time elapses. Rows past the max rows or max result bytes limits are dropped and the result is marked
+// as truncated.
+func (s *Sandbox) Query(ctx context.Context, statement string) (result *StatementResult) {
+	result = &StatementResult{Columns: []string{}, Rows: [][]interface{}{}}
+	start := time.Now()
+	defer func() {
+		result.ElapsedMs = time.Since(start).Milliseconds()
+	}()
+
+	if err := CheckReadOnlyStatement(statement, s.config.Dialect); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if s.config.MaxExecutionTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.MaxExecutionTime)
+		defer cancel()
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer tx.Rollback()
+
+	if s.config.Dialect == "mysql" && s.config.MaxExecutionTime > 0 {
+		if _, err = tx.ExecContext(ctx, "SET SESSION max_execution_time = ?", s.config.MaxExecutionTime.Milliseconds()); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, statement)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer rows.Close()
+
+	if result.Columns, err = rows.Columns(); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	var size int64
+	for rows.Next() {
+		if s.config.MaxRows > 0 && len(result.Rows) >= s.config.MaxRows {
+			result.Truncated = true
+			break
+		}
+
+		row, err := scanRow(rows, len(result.Columns))
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+
+		if s.config.MaxResultBytes > 0 {
+			data, _ := json.Marshal(row)
+			size += int64(len(data))
+			if size > s.config.MaxResultBytes {
+				result.Truncated = true
+				break
+			}
+		}
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		result.Error = err.Error()
+	} else if err = ctx.Err(); err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func scanRow(rows *sql.Rows, numColumns int) ([]interface{}, error) {
+	values := make([]interface{}, numColumns)
+	dest := make([]interface{}, numColumns)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	for i, v := range values {
+		if b, ok := v.([]byte); ok {
+			values[i] = string(b)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,242 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+func TestCheckReadOnlyStatement(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		err       error
+	}{
+		{"select", "SELECT id, name FROM customers WHERE id = 1", nil},
+		{"lower case select", "select * from customers", nil},
+		{"trailing semicolons", "SELECT 1;;", nil},
+		{"parenthesized select", "(SELECT 1) UNION (SELECT 2)", nil},
+		{"with select", "WITH c AS (SELECT id FROM customers) SELECT * FROM c", nil},
+		{"keyword in string", "SELECT * FROM customers WHERE name = 'DELETE FROM customers; DROP TABLE x'", nil},
+		{"keyword in double quoted string", `SELECT "UPDATE" AS u`, nil},
+		{"keyword in backtick identifier", "SELECT `delete` FROM `update`", nil},
+		{"keyword in line comment", "SELECT 1 -- DELETE FROM customers\n", nil},
+		{"keyword in hash comment", "SELECT 1 # ; DROP TABLE customers", nil},
+		{"keyword in block comment", "SELECT /* ; DELETE */ 1", nil},
+		{"doubled quote", "SELECT 'it''s; DROP TABLE x'", nil},
+		{"escaped quote", `SELECT 'it\'s; DROP TABLE x'`, nil},
+
+		{"empty", "", ErrStatementNotAllowed},
+		{"only comment", "-- SELECT 1", ErrStatementNotAllowed},
+		{"delete", "DELETE FROM customers", ErrStatementNotAllowed},
+		{"lower case update", "update customers set name = 'x'", ErrStatementNotAllowed},
+		{"show", "SHOW TABLES", ErrStatementNotAllowed},
+		{"with delete", "WITH c AS (SELECT id FROM customers) DELETE FROM customers WHERE id IN (SELECT id FROM c)", ErrStatementNotAllowed},
+		{"with update", "WITH c AS (SELECT 1) UPDATE customers SET name = 'x'", ErrStatementNotAllowed},
+		{"stacked statements", "SELECT 1; DROP TABLE customers", ErrStatementNotAllowed},
+		{"stacked selects", "SELECT 1; SELECT 2", ErrStatementNotAllowed},
+		{"stacked after comment", "SELECT 1 /* x */; DELETE FROM customers", ErrStatementNotAllowed},
+		{"select into outfile", "SELECT * FROM customers INTO OUTFILE '/tmp/x'", ErrStatementNotAllowed},
+		{"select for update lock", "SELECT GET_LOCK('x', 10)", ErrStatementNotAllowed},
+		{"load file", "SELECT LOAD_FILE('/etc/passwd')", ErrStatementNotAllowed},
+		{"set in select", "SELECT 1 FROM customers; SET @a = 1", ErrStatementNotAllowed},
+		{"pragma", "PRAGMA writable_schema = 1", ErrStatementNotAllowed},
+		{"dashes without space are not a comment", "SELECT 1 --1; DELETE FROM customers", ErrStatementNotAllowed},
+		{"string closed early", "SELECT 'a'; DELETE FROM customers; SELECT 'b'", ErrStatementNotAllowed},
+		{"backtick does not escape", "SELECT `a\\`; DELETE FROM customers", ErrStatementNotAllowed},
+
+		{"executable comment", "SELECT /*! DELETE FROM customers */ 1", errExecutableComment},
+		{"executable comment with version", "SELECT 1 /*!50000 ; DROP TABLE customers */", errExecutableComment},
+		{"unterminated string", "SELECT 'abc", errUnterminatedSQL},
+		{"unterminated string hiding a statement", "SELECT '; DELETE FROM customers", errUnterminatedSQL},
+		{"unterminated block comment", "SELECT 1 /* DELETE", errUnterminatedSQL},
+		{"unterminated backtick", "SELECT `abc", errUnterminatedSQL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckReadOnlyStatement(tt.statement, "mysql")
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CheckReadOnlyStatement(%q) = %v, want %v", tt.statement, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckReadOnlyStatementDialects(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   string
+		statement string
+		err       error
+	}{
+		// a backslash only escapes a quote in the strings of mysql and the E'...' strings of postgres
+		{"mysql backslash escape", "mysql", `SELECT '\'; DELETE FROM users; --'`, nil},
+		{"postgres standard string", "postgres", `SELECT '\'; DELETE FROM users; --'`, ErrStatementNotAllowed},
+		{"sqlite standard string", "sqlite3", `SELECT '\'; DELETE FROM users; --'`, ErrStatementNotAllowed},
+		{"mssql standard string", "mssql", `SELECT '\'; DELETE FROM users; --'`, ErrStatementNotAllowed},
+		{"postgres escape string", "postgres", `SELECT E'\'; DELETE FROM users; --'`, nil},
+		{"postgres lower case escape string", "postgres", `SELECT e'\'; DELETE FROM users; --'`, nil},
+		{"postgres word ending with e", "postgres", `SELECT name'\'; DELETE FROM users; --'`, ErrStatementNotAllowed},
+		{"postgres escape string in mysql", "mysql", `SELECT E'\'; DELETE FROM users; --'`, nil},
+		{"sqlite backslash in double quotes", "sqlite3", `SELECT "\"; DELETE FROM users; --"`, ErrStatementNotAllowed},
+		{"mysql escaped quote", "mysql", `SELECT 'it\'s'`, nil},
+		{"postgres escaped quote", "postgres", `SELECT 'it\'s'`, errUnterminatedSQL},
+		{"postgres doubled quote", "postgres", `SELECT 'it''s; DROP TABLE x'`, nil},
+		{"mysql backslash in backticks", "mysql", "SELECT `a\\`; DELETE FROM users", ErrStatementNotAllowed},
+
+		// -- needs no whitespace and # is an operator outside of mysql
+		{"postgres dashes without space", "postgres", "SELECT 1 --1; DELETE FROM customers", nil},
+		{"postgres hash", "postgres", "SELECT 1 # 2; DELETE FROM customers", ErrStatementNotAllowed},
+		{"sqlite hash", "sqlite3", "SELECT 1 # ; DELETE FROM customers", ErrStatementNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckReadOnlyStatement(tt.statement, tt.dialect)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CheckReadOnlyStatement(%q, %s) = %v, want %v", tt.statement, tt.dialect, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSplitSQLDialects(t *testing.T) {
+	tests := []struct {
+		dialect string
+		want    []string
+	}{
+		{"mysql", []string{`INSERT INTO a VALUES ('\'); SELECT 1; --')`}},
+		{"postgres", []string{`INSERT INTO a VALUES ('\')`, "SELECT 1"}},
+		{"sqlite3", []string{`INSERT INTO a VALUES ('\')`, "SELECT 1"}},
+	}
+
+	sql := "INSERT INTO a VALUES ('\\'); SELECT 1; --')"
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			got, err := splitSQL(sql, tt.dialect)
+			if err != nil {
+				t.Fatalf("splitSQL(%s) failed: %v", tt.dialect, err)
+			}
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Errorf("splitSQL(%s) = %q, want %q", tt.dialect, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want []string
+	}{
+		{"single", "SELECT 1", []string{"SELECT 1"}},
+		{"several", "CREATE TABLE a (id int);\nINSERT INTO a VALUES (1);\n", []string{"CREATE TABLE a (id int)", "INSERT INTO a VALUES (1)"}},
+		{"semicolon in string", "INSERT INTO a VALUES ('x;y'); SELECT 1", []string{"INSERT INTO a VALUES ('x;y')", "SELECT 1"}},
+		{"semicolon in comment", "SELECT 1 -- a; b\n; SELECT 2", []string{"SELECT 1 -- a; b", "SELECT 2"}},
+		{"empty statements", ";; SELECT 1;;", []string{"SELECT 1"}},
+		{"executable comment kept", "/*!40101 SET NAMES utf8 */; SELECT 1", []string{"/*!40101 SET NAMES utf8 */", "SELECT 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitSQL(tt.sql, "mysql")
+			if err != nil {
+				t.Fatalf("splitSQL(%q) failed: %v", tt.sql, err)
+			}
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Errorf("splitSQL(%q) = %q, want %q", tt.sql, got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestSandbox returns a sandbox on a sqlite database holding a table of rows numbered from 1 to rows
+func newTestSandbox(t *testing.T, rows int, config SandboxConfig) *Sandbox {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	dsn := filepath.Join(dir, "sandbox.db")
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err = db.Exec("CREATE TABLE numbers (n integer, label text)"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= rows; i++ {
+		if _, err = db.Exec("INSERT INTO numbers VALUES (?, ?)", i, strings.Repeat("x", 10)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config.Dialect = "sqlite3"
+	config.DSN = dsn
+	sandbox, err := NewSandbox(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sandbox.Close() })
+	return sandbox
+}
+
+func TestSandboxQueryLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    SandboxConfig
+		statement string
+		rows      int
+		truncated bool
+		err       string
+	}{
+		{"no limits", SandboxConfig{}, "SELECT n, label FROM numbers", 10, false, ""},
+		{"under max rows", SandboxConfig{MaxRows: 10}, "SELECT n, label FROM numbers", 10, false, ""},
+		{"max rows", SandboxConfig{MaxRows: 3}, "SELECT n, label FROM numbers", 3, true, ""},
+		// a row is marshalled as [1,"xxxxxxxxxx"], 16 bytes
+		{"max result bytes", SandboxConfig{MaxResultBytes: 40}, "SELECT n, label FROM numbers", 2, true, ""},
+		{"rows before bytes", SandboxConfig{MaxRows: 1, MaxResultBytes: 40}, "SELECT n, label FROM numbers", 1, true, ""},
+		{"rejected statement", SandboxConfig{}, "DELETE FROM numbers", 0, false, ErrStatementNotAllowed.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sandbox := newTestSandbox(t, 10, tt.config)
+
+			result := sandbox.Query(context.Background(), tt.statement)
+			if result.Error != tt.err {
+				t.Fatalf("Query(%q) error = %q, want %q", tt.statement, result.Error, tt.err)
+			}
+			if len(result.Rows) != tt.rows || result.Truncated != tt.truncated {
+				t.Errorf("Query(%q) = %d rows truncated %v, want %d rows truncated %v", tt.statement, len(result.Rows), result.Truncated, tt.rows, tt.truncated)
+			}
+		})
+	}
+}
+
+func TestSandboxQueryCancelled(t *testing.T) {
+	sandbox := newTestSandbox(t, 1, SandboxConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := sandbox.Query(ctx, "SELECT n FROM numbers")
+	if result.Error == "" || len(result.Rows) != 0 {
+		t.Errorf("Query with a cancelled context = %d rows error %q, want an error", len(result.Rows), result.Error)
+	}
+}
@@ -0,0 +1,261 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Search is a validated full text search over the searchable columns of a table
+type Search struct {
+	// Table searched
+	Table *model.TableInfo
+
+	// Columns the searchable columns of the table, but for the SensitiveColumns
+	Columns []*model.ColumnInfo
+
+	// Terms the words of the search, matched anywhere in a column by the LIKE fallback
+	Terms []string
+
+	// Text the search as entered
+	Text string
+}
+
+// SearchHit is a record matching a search, records are keyed by json field name
+type SearchHit struct {
+	Score  float64                `json:"score"`
+	Record map[string]interface{} `json:"record"`
+}
+
+// SearchGroup is the records of a table matching a search, best match first
+type SearchGroup struct {
+	Table string       `json:"table"`
+	Hits  []*SearchHit `json:"hits"`
+}
+
+// fullTextIndexes caches per table whether a mysql FULLTEXT index covers exactly the searchable columns, cleared by
+// runMigration as a migration may add or drop the index
+var fullTextIndexes sync.Map
+
+// ParseSearch validates a search of text over the searchable columns of table, a SensitiveColumns column is not
+// searched so its values can not be guessed from the hits
+// error - ErrBadParams, the table has no searchable columns or text holds no words
+func ParseSearch(table *model.TableInfo, text string) (*Search, error) {
+	var columns []*model.ColumnInfo
+	for _, col := range table.SearchableColumns() {
+		if !sensitiveColumn(col.Name) {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("%w: %s has no searchable columns", ErrBadParams, table.Name)
+	}
+
+	terms := strings.Fields(text)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: search must contain a word", ErrBadParams)
+	}
+
+	return &Search{Table: table, Columns: columns, Terms: terms, Text: text}, nil
+}
+
+// SearchTables runs search over every table in tables and returns the tables with matches, up to limit records per
+// table ordered by relevance
+func SearchTables(ctx context.Context, text string, tables []*model.TableInfo, limit int) (groups []*SearchGroup, err error) {
+	db, done := session(ctx)
+	defer done(&err)
+
+	for _, table := range tables {
+		search, err := ParseSearch(table, text)
+		if err != nil {
+			return nil, err
+		}
+
+		hits, err := searchTable(db, search, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hits) > 0 {
+			groups = append(groups, &SearchGroup{Table: table.Name, Hits: hits})
+		}
+	}
+
+	return groups, nil
+}
+
+// searchTable returns up to limit records of the search table read from db ordered by relevance, the records hold
+// every column but the SensitiveColumns
+func searchTable(db *gorm.DB, search *Search, limit int) ([]*SearchHit, error) {
+	score, args := searchScore(db, search)
+
+	rows, err := applySearch(notDeleted(db.Table(search.Table.Name), search.Table), search).
+		Select(strings.Join(append(searchSelectColumns(db, search.Table), score+" AS search_score"), ", "), args...).
+		Order("search_score DESC").
+		Limit(limit).
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []*SearchHit
+	for rows.Next() {
+		values, err := scanRow(rows, len(columns))
+		if err != nil {
+			return nil, err
+		}
+
+		hit := &SearchHit{Record: map[string]interface{}{}}
+		for i, name := range columns {
+			if name == "search_score" {
+				hit.Score = searchScoreValue(values[i])
+				continue
+			}
+			if col, ok := search.Table.Column(name); ok {
+				name = col.JSONFieldName
+			}
+			hit.Record[name] = values[i]
+		}
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+// searchSelectColumns returns the quoted columns of table returned in search hits
+func searchSelectColumns(db *gorm.DB, table *model.TableInfo) []string {
+	var columns []string
+	for _, col := range table.Columns {
+		if col.IsDBColumn() && !sensitiveColumn(col.Name) {
+			columns = append(columns, db.Dialect().Quote(col.Name))
+		}
+	}
+	return columns
+}
+
+// applySearch narrows db to the records matching search, using MATCH ... AGAINST when a mysql FULLTEXT index covers
+// the searchable columns and a LIKE on every column for every term otherwise
+func applySearch(db *gorm.DB, search *Search) *gorm.DB {
+	if search == nil {
+		return db
+	}
+
+	if columns, ok := fullTextColumns(db, search); ok {
+		return db.Where("MATCH("+columns+") AGAINST(? IN NATURAL LANGUAGE MODE)", search.Text)
+	}
+
+	var conditions []string
+	var args []interface{}
+	for _, term := range search.Terms {
+		for _, col := range search.Columns {
+			conditions = append(conditions, db.Dialect().Quote(col.Name)+" LIKE ? ESCAPE '!'")
+			args = append(args, likePattern(term))
+		}
+	}
+	return db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
+
+// searchScore returns the sql expression scoring the relevance of a record, the mysql full text relevance or the
+// number of columns matching a term
+func searchScore(db *gorm.DB, search *Search) (string, []interface{}) {
+	if columns, ok := fullTextColumns(db, search); ok {
+		return "MATCH(" + columns + ") AGAINST(? IN NATURAL LANGUAGE MODE)", []interface{}{search.Text}
+	}
+
+	var terms []string
+	var args []interface{}
+	for _, term := range search.Terms {
+		for _, col := range search.Columns {
+			terms = append(terms, "(CASE WHEN "+db.Dialect().Quote(col.Name)+" LIKE ? ESCAPE '!' THEN 1 ELSE 0 END)")
+			args = append(args, likePattern(term))
+		}
+	}
+	return "(" + strings.Join(terms, " + ") + ")", args
+}
+
+// fullTextColumns returns the quoted column list of a mysql FULLTEXT index covering exactly the searchable columns
+func fullTextColumns(db *gorm.DB, search *Search) (string, bool) {
+	if db.Dialect().GetName() != "mysql" {
+		return "", false
+	}
+
+	if indexed, ok := fullTextIndexes.Load(search.Table.Name); ok {
+		return fullTextColumnList(db, search), indexed.(bool)
+	}
+
+	rows, err := db.New().Raw(`SELECT INDEX_NAME, COLUMN_NAME FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_TYPE = 'FULLTEXT'`, search.Table.Name).Rows()
+	if err != nil {
+		return "", false
+	}
+	defer rows.Close()
+
+	indexes := map[string]map[string]bool{}
+	for rows.Next() {
+		var index, column string
+		if err = rows.Scan(&index, &column); err != nil {
+			return "", false
+		}
+		if indexes[index] == nil {
+			indexes[index] = map[string]bool{}
+		}
+		indexes[index][strings.ToLower(column)] = true
+	}
+
+	indexed := false
+	for _, columns := range indexes {
+		if len(columns) != len(search.Columns) {
+			continue
+		}
+		indexed = true
+		for _, col := range search.Columns {
+			indexed = indexed && columns[strings.ToLower(col.Name)]
+		}
+		if indexed {
+			break
+		}
+	}
+
+	fullTextIndexes.Store(search.Table.Name, indexed)
+	return fullTextColumnList(db, search), indexed
+}
+
+func fullTextColumnList(db *gorm.DB, search *Search) string {
+	columns := make([]string, len(search.Columns))
+	for i, col := range search.Columns {
+		columns[i] = db.Dialect().Quote(col.Name)
+	}
+	return strings.Join(columns, ", ")
+}
+
+// likePattern matches term anywhere in a column, % and _ in term match literally
+func likePattern(term string) string {
+	term = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(term)
+	return "%" + term + "%"
+}
+
+func searchScoreValue(v interface{}) float64 {
+	switch t := v.(type) {
+	case float64:
+		return t
+	case int64:
+		return float64(t)
+	case string:
+		var f float64
+		fmt.Sscan(t, &f)
+		return f
+	default:
+		return 0
+	}
+}
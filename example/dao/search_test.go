@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+func TestParseSearch(t *testing.T) {
+	users, _ := model.GetTableInfo("users")
+
+	search, err := ParseSearch(users, "  alice  smith ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(search.Terms) != "[alice smith]" {
+		t.Errorf("terms = %v, want [alice smith]", search.Terms)
+	}
+	for _, col := range search.Columns {
+		if sensitiveColumn(col.Name) {
+			t.Errorf("sensitive column %s is searched", col.Name)
+		}
+	}
+
+	if _, err := ParseSearch(users, " "); !errors.Is(err, ErrBadParams) {
+		t.Errorf("ParseSearch() of no word error = %v, want %v", err, ErrBadParams)
+	}
+
+	// a table whose only searchable column is sensitive can not be searched
+	saved := SensitiveColumns
+	t.Cleanup(func() { SensitiveColumns = saved })
+	SensitiveColumns = append(SensitiveColumns, "email")
+	if _, err := ParseSearch(users, "alice"); !errors.Is(err, ErrBadParams) {
+		t.Errorf("ParseSearch() of sensitive columns error = %v, want %v", err, ErrBadParams)
+	}
+}
+
+func TestSearchTables(t *testing.T) {
+	useTestTables(t, "users")
+	for _, user := range []*model.Users_{
+		{Email: "alice@example.com", EncryptedPassword: "hash-alice", ResetPasswordToken: null.StringFrom("token-alice")},
+		{Email: "bob@example.com", EncryptedPassword: "alice", ResetPasswordToken: null.StringFrom("alice")},
+		{Email: "carol@example.com", EncryptedPassword: "hash-carol"},
+	} {
+		if err := DB.Create(user).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	users, _ := model.GetTableInfo("users")
+
+	tests := []struct {
+		name string
+		text string
+		ids  []int64
+	}{
+		{"match", "alice", []int64{1}},
+		{"several terms", "alice carol", []int64{1, 3}},
+		{"sensitive columns are not searched", "hash", nil},
+		{"like wildcard matches literally", "%", nil},
+		{"no match", "dave", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := SearchTables(context.Background(), tt.text, []*model.TableInfo{users}, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int64
+			for _, group := range groups {
+				for _, hit := range group.Hits {
+					ids = append(ids, hit.Record["id"].(int64))
+
+					if hit.Record["email"] == nil {
+						t.Errorf("hit %v has no email", hit.Record)
+					}
+					for _, field := range []string{"encrypted_password", "reset_password_token"} {
+						if _, ok := hit.Record[field]; ok {
+							t.Errorf("hit %v holds the sensitive %s", hit.Record["id"], field)
+						}
+					}
+				}
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			if fmt.Sprint(ids) != fmt.Sprint(tt.ids) {
+				t.Errorf("SearchTables(%q) = ids %v, want %v", tt.text, ids, tt.ids)
+			}
+		})
+	}
+}
+
+func TestMigrationClearsFullTextIndexes(t *testing.T) {
+	useTestTables(t)
+	t.Cleanup(func() { fullTextIndexes.Delete("users") })
+
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = ioutil.WriteFile(filepath.Join(dir, "1_notes.up.sql"), []byte("CREATE TABLE notes (id integer);"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fullTextIndexes.Store("users", false)
+	if _, err = Migrate(context.Background(), dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fullTextIndexes.Load("users"); ok {
+		t.Error("the full text index of users is still cached after a migration")
+	}
+}
@@ -0,0 +1,142 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// DeletedRows selects the soft deleted records returned by a GetAll function
+type DeletedRows int
+
+const (
+	// ExcludeDeleted returns the records that are not deleted, the default
+	ExcludeDeleted DeletedRows = iota
+
+	// IncludeDeleted returns the deleted records along with the others
+	IncludeDeleted
+
+	// OnlyDeleted returns the deleted records only, the trash of a table
+	OnlyDeleted
+)
+
+// applyDeleted narrows a query on a model to the records selected by deleted. gorm leaves out the deleted records of
+// a model with a DeletedAt field on its own, queries on a table name use notDeleted instead.
+func applyDeleted(db *gorm.DB, deleted DeletedRows) *gorm.DB {
+	switch deleted {
+	case IncludeDeleted:
+		return db.Unscoped()
+	case OnlyDeleted:
+		return db.Unscoped().Where(db.Dialect().Quote(deletedAtColumn) + " IS NOT NULL")
+	default:
+		return db
+	}
+}
+
+// notDeleted leaves the deleted records of table out of a query on the table name, tables without soft delete are
+// left unchanged
+func notDeleted(db *gorm.DB, table *model.TableInfo) *gorm.DB {
+	if !table.SoftDelete() {
+		return db
+	}
+	return db.Where(db.Dialect().Quote(deletedAtColumn) + " IS NULL")
+}
+
+// Purge permanently deletes the records of table deleted before before in a single transaction, every purged record is
+// recorded in the audit log
+// error - ErrBadParams, table has no soft delete
+// error - ErrDeleteFailed, db delete failed, e.g. a purged record is still referenced
+func Purge(ctx context.Context, table *model.TableInfo, before time.Time) (rowsAffected int64, err error) {
+	if !table.SoftDelete() {
+		return 0, fmt.Errorf("%w: table %s has no soft delete", ErrBadParams, table.Name)
+	}
+
+	record, ok := model.NewModel(table.Name)
+	if !ok {
+		return 0, fmt.Errorf("no model for table %s", table.Name)
+	}
+
+	tx, done := begin(ctx)
+	defer done(&err)
+	if err = tx.Error; err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrDeleteFailed, table.Name, err)
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	results := reflect.New(reflect.SliceOf(reflect.TypeOf(record)))
+	deleted := tx.Unscoped().Where(tx.Dialect().Quote(deletedAtColumn)+" < ?", before)
+	if err = deleted.Set("gorm:query_option", forUpdate(tx)).Find(results.Interface()).Error; err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrDeleteFailed, table.Name, err)
+	}
+
+	rows := results.Elem()
+	for i := 0; i < rows.Len(); i++ {
+		purged := rows.Index(i).Interface().(model.Model)
+		db := tx.Unscoped().Delete(purged)
+		if err = db.Error; err != nil {
+			return 0, fmt.Errorf("%w: %s: %v", ErrDeleteFailed, table.Name, err)
+		}
+		if err = writeAudit(ctx, tx, AuditPurge, purged, nil); err != nil {
+			return 0, fmt.Errorf("%w: %s: %v", ErrDeleteFailed, table.Name, err)
+		}
+		rowsAffected += db.RowsAffected
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrDeleteFailed, table.Name, err)
+	}
+	return rowsAffected, nil
+}
+
+// PurgeOrder returns tables ordered so a table referencing another one along a foreign key comes first, purging them in
+// this order removes the deleted children of a deleted record before the record itself
+func PurgeOrder(tables []*model.TableInfo) []*model.TableInfo {
+	pending := map[string]bool{}
+	for _, table := range tables {
+		pending[table.Name] = true
+	}
+
+	var ordered []*model.TableInfo
+	for len(ordered) < len(tables) {
+		progress := false
+		for _, table := range tables {
+			if !pending[table.Name] || isReferenced(table, tables, pending) {
+				continue
+			}
+			ordered = append(ordered, table)
+			pending[table.Name] = false
+			progress = true
+		}
+
+		if !progress {
+			// a reference cycle, purge the rest in the given order
+			for _, table := range tables {
+				if pending[table.Name] {
+					ordered = append(ordered, table)
+					pending[table.Name] = false
+				}
+			}
+		}
+	}
+	return ordered
+}
+
+// isReferenced returns true when a pending table other than table has a foreign key to table
+func isReferenced(table *model.TableInfo, tables []*model.TableInfo, pending map[string]bool) bool {
+	for _, other := range tables {
+		if other.Name == table.Name || !pending[other.Name] {
+			continue
+		}
+		for _, fk := range other.ForeignKeys {
+			if fk.RefTable == table.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+// listElevators returns the ids of the elevators GetAllElevators_ returns with deleted
+func listElevators(t *testing.T, deleted DeletedRows) string {
+	t.Helper()
+
+	elevators, _, _, err := GetAllElevators_(context.Background(), 0, 20, &ListQuery{Deleted: deleted})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, elevator := range elevators {
+		ids = append(ids, elevator.ID)
+	}
+	return fmt.Sprint(ids)
+}
+
+func TestSoftDelete(t *testing.T) {
+	useTestTables(t, "elevators", "interventions")
+	for _, status := range []string{"Active", "Inactive"} {
+		if err := DB.Create(&model.Elevators_{Status: null.StringFrom(status)}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := context.Background()
+
+	if _, err := DeleteElevators_(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// the record is kept with deleted_at set and left out of reads
+	deleted := &model.Elevators_{}
+	if err := DB.Unscoped().First(deleted, 1).Error; err != nil {
+		t.Fatalf("deleted elevator is not kept: %v", err)
+	}
+	if !deleted.DeletedAt.Valid {
+		t.Error("deleted_at is not set on the deleted elevator")
+	}
+	if _, err := GetElevators_(ctx, 1, nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetElevators_() of the deleted elevator error = %v, want %v", err, ErrNotFound)
+	}
+	if _, err := DeleteElevators_(ctx, 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("DeleteElevators_() of the deleted elevator error = %v, want %v", err, ErrNotFound)
+	}
+
+	for deleted, want := range map[DeletedRows]string{ExcludeDeleted: "[2]", IncludeDeleted: "[1 2]", OnlyDeleted: "[1]"} {
+		if got := listElevators(t, deleted); got != want {
+			t.Errorf("GetAllElevators_() with deleted %d = %s, want %s", deleted, got, want)
+		}
+	}
+
+	// only a deleted record can be restored
+	if _, _, err := RestoreElevators_(ctx, 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RestoreElevators_() of an elevator not deleted error = %v, want %v", err, ErrNotFound)
+	}
+	restored, _, err := RestoreElevators_(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.DeletedAt.Valid || restored.Status.String != "Active" {
+		t.Errorf("restored elevator = %s, want it active without deleted_at", jsonOf(t, restored))
+	}
+	if got := listElevators(t, ExcludeDeleted); got != "[1 2]" {
+		t.Errorf("GetAllElevators_() after the restore = %s, want [1 2]", got)
+	}
+
+	if actions := fmt.Sprint(auditActions(t, "elevators")); actions != "[delete restore]" {
+		t.Errorf("audited %s, want [delete restore]", actions)
+	}
+}
+
+func TestNotDeleted(t *testing.T) {
+	useTestTables(t, "elevators", "users")
+	for _, elevator := range []*model.Elevators_{{}, {DeletedAt: null.TimeFrom(time.Now())}} {
+		if err := DB.Create(elevator).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := DB.Create(&model.Users_{Email: "alice@example.com"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		table string
+		count int
+	}{
+		{"elevators", 1},
+		{"users", 1},
+	} {
+		table, _ := model.GetTableInfo(tt.table)
+		var count int
+		if err := notDeleted(DB.Table(tt.table), table).Count(&count).Error; err != nil {
+			t.Fatal(err)
+		}
+		if count != tt.count {
+			t.Errorf("records of %s not deleted = %d, want %d", tt.table, count, tt.count)
+		}
+	}
+}
+
+func TestPurge(t *testing.T) {
+	useTestTables(t, "elevators", "users")
+	now := time.Now().UTC()
+	for _, deletedAt := range []null.Time{null.TimeFrom(now.AddDate(0, 0, -10)), null.TimeFrom(now), {}} {
+		if err := DB.Create(&model.Elevators_{DeletedAt: deletedAt}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	elevators, _ := model.GetTableInfo("elevators")
+	ctx := context.Background()
+
+	purged, err := Purge(ctx, elevators, now.AddDate(0, 0, -5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("Purge() = %d, want 1", purged)
+	}
+	if got := listElevators(t, IncludeDeleted); got != "[2 3]" {
+		t.Errorf("elevators after the purge = %s, want the recently deleted and the not deleted ones: [2 3]", got)
+	}
+	if actions := fmt.Sprint(auditActions(t, "elevators")); actions != "[purge]" {
+		t.Errorf("audited %s, want [purge]", actions)
+	}
+
+	users, _ := model.GetTableInfo("users")
+	if _, err = Purge(ctx, users, now); !errors.Is(err, ErrBadParams) {
+		t.Errorf("Purge() of a table without soft delete error = %v, want %v", err, ErrBadParams)
+	}
+}
+
+func TestPurgeOrder(t *testing.T) {
+	var tables []*model.TableInfo
+	for _, name := range []string{"batteries", "elevators", "columns", "interventions"} {
+		table, _ := model.GetTableInfo(name)
+		tables = append(tables, table)
+	}
+
+	var names []string
+	for _, table := range PurgeOrder(tables) {
+		names = append(names, table.Name)
+	}
+	if got := fmt.Sprint(names); got != "[interventions elevators columns batteries]" {
+		t.Errorf("PurgeOrder() = %s, want the referencing tables first: [interventions elevators columns batteries]", got)
+	}
+}
+
+func TestPurgeOrderCycle(t *testing.T) {
+	a := &model.TableInfo{Name: "a", ForeignKeys: []*model.ForeignKey{{Column: "b_id", RefTable: "b", RefColumn: "id"}}}
+	b := &model.TableInfo{Name: "b", ForeignKeys: []*model.ForeignKey{{Column: "a_id", RefTable: "a", RefColumn: "id"}}}
+	c := &model.TableInfo{Name: "c", ForeignKeys: []*model.ForeignKey{{Column: "a_id", RefTable: "a", RefColumn: "id"}}}
+
+	var names []string
+	for _, table := range PurgeOrder([]*model.TableInfo{a, b, c}) {
+		names = append(names, table.Name)
+	}
+	if got := fmt.Sprint(names); got != "[c a b]" {
+		t.Errorf("PurgeOrder() = %s, want the tables outside the cycle first and the cycle in the given order: [c a b]", got)
+	}
+}
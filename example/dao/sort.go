@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SortKey is a validated column to order the records returned by the GetAll functions
+type SortKey struct {
+	// Column to order by
+	Column *model.ColumnInfo
+
+	// Desc orders from the largest to the smallest value
+	Desc bool
+}
+
+// ParseSort validates a comma separated list of column names (db column or json field) of table, a - prefix sorts a
+// column descending, e.g. -updated_at,status. The primary key is appended as a tiebreaker so the order is always
+// deterministic, an empty value sorts by primary key.
+// error - ErrBadParams, unknown or repeated column
+func ParseSort(table *model.TableInfo, value string) (keys []*SortKey, err error) {
+	seen := map[string]bool{}
+	if value != "" {
+		for _, name := range strings.Split(value, ",") {
+			key := &SortKey{}
+			name = strings.TrimSpace(name)
+			if strings.HasPrefix(name, "-") {
+				key.Desc = true
+				name = name[1:]
+			} else {
+				name = strings.TrimPrefix(name, "+")
+			}
+
+			col, ok := table.Column(name)
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown sort column %q", ErrBadParams, name)
+			}
+			if seen[col.Name] {
+				return nil, fmt.Errorf("%w: sort column %q is repeated", ErrBadParams, name)
+			}
+
+			seen[col.Name] = true
+			key.Column = col
+			keys = append(keys, key)
+		}
+	}
+
+	if pk := table.PrimaryKey(); pk != nil && !seen[pk.Name] {
+		keys = append(keys, &SortKey{Column: pk})
+	}
+	return keys, nil
+}
+
+// applySort adds an order by clause for each key, column names come from the TableInfo so they are safe to quote into
+// the sql. Nulls sort before every other value on every dialect, as cursorCondition expects.
+func applySort(db *gorm.DB, keys []*SortKey) *gorm.DB {
+	nulls := db.Dialect().GetName() == "postgres"
+	for _, key := range keys {
+		column := db.Dialect().Quote(key.Column.Name)
+		switch {
+		case key.Desc && nulls:
+			db = db.Order(column + " DESC NULLS LAST")
+		case key.Desc:
+			db = db.Order(column + " DESC")
+		case nulls:
+			db = db.Order(column + " ASC NULLS FIRST")
+		default:
+			db = db.Order(column + " ASC")
+		}
+	}
+	return db
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		keys  string
+		err   error
+	}{
+		{"empty sorts by primary key", "", "id", nil},
+		{"primary key appended", "name", "name,id", nil},
+		{"descending", "-score,name", "-score,name,id", nil},
+		{"plus prefix", "+name", "name,id", nil},
+		{"json field name", "parent", "parent_id,id", nil},
+		{"spaces", " -name , score ", "-name,score,id", nil},
+		{"primary key not repeated", "-id", "-id", nil},
+		{"primary key in the middle", "id,name", "id,name", nil},
+
+		{"unknown column", "missing", "", ErrBadParams},
+		{"relation is not a column", "children", "", ErrBadParams},
+		{"repeated column", "name,-name", "", ErrBadParams},
+		{"repeated by json field", "parent,parent_id", "", ErrBadParams},
+		{"sql injection", "name; DROP TABLE items", "", ErrBadParams},
+		{"order expression", "name DESC", "", ErrBadParams},
+		{"double minus", "--name", "", ErrBadParams},
+		{"empty item", "name,", "", ErrBadParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := ParseSort(testItemsTable, tt.value)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseSort(%q) error = %v, want %v", tt.value, err, tt.err)
+			}
+			if err == nil && sortSignature(keys) != tt.keys {
+				t.Errorf("ParseSort(%q) = %s, want %s", tt.value, sortSignature(keys), tt.keys)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+)
+
+type sqlTokenKind int
+
+const (
+	sqlWord sqlTokenKind = iota
+	sqlQuotedIdentifier
+	sqlString
+	sqlNumber
+	sqlPunct
+	sqlExecutableComment
+)
+
+// sqlToken is a lexical token of a sql statement, comments and whitespace are not tokens
+type sqlToken struct {
+	kind  sqlTokenKind
+	text  string
+	start int
+	end   int
+}
+
+// upper returns the token text upper cased, used for keyword comparison
+func (t sqlToken) upper() string {
+	return strings.ToUpper(t.text)
+}
+
+var errUnterminatedSQL = fmt.Errorf("unterminated quoted string or comment in sql")
+
+// errExecutableComment is returned for mysql /*! ... */ comments, which the server executes as sql
+var errExecutableComment = fmt.Errorf("executable comments are not allowed in sql")
+
+// lexSQL splits a sql statement of dialect into tokens. It understands quoted strings and identifiers as well as --,
+// /* */ and the # comments of mysql so keywords inside of them are never mistaken for sql.
+func lexSQL(sql string, dialect string) (tokens []sqlToken, err error) {
+	return lexSQLTokens(sql, dialect, false)
+}
+
+// lexSQLTokens is lexSQL, with allowExecutable mysql /*! ... */ comments are returned as a single token instead of
+// failing
+func lexSQLTokens(sql string, dialect string, allowExecutable bool) (tokens []sqlToken, err error) {
+	i := 0
+	for i < len(sql) {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+
+		case c == '#' && dialect == "mysql" || isDashComment(sql, i, dialect):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 1
+			}
+
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			executable := strings.HasPrefix(sql[i:], "/*!")
+			if executable && !allowExecutable {
+				return nil, errExecutableComment
+			}
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return nil, errUnterminatedSQL
+			}
+			if executable {
+				tokens = append(tokens, sqlToken{kind: sqlExecutableComment, text: sql[i : i+end+4], start: i, end: i + end + 4})
+			}
+			i += end + 4
+
+		case c == '\'' || c == '"' || c == '`':
+			escapes := backslashEscapes(sql, i, dialect)
+			end, ok := quotedEnd(sql, i, escapes)
+			if !ok {
+				return nil, errUnterminatedSQL
+			}
+			kind := sqlString
+			if c == '`' {
+				kind = sqlQuotedIdentifier
+			}
+			start := i
+			if escapes && dialect == "postgres" {
+				// the E prefix lexed as a word is part of the string
+				tokens, start = tokens[:len(tokens)-1], i-1
+			}
+			tokens = append(tokens, sqlToken{kind: kind, text: sql[start:end], start: start, end: end})
+			i = end
+
+		case isWordChar(c):
+			start := i
+			for i < len(sql) && (isWordChar(sql[i]) || sql[i] == '$') {
+				i++
+			}
+			kind := sqlWord
+			if c >= '0' && c <= '9' {
+				kind = sqlNumber
+			}
+			tokens = append(tokens, sqlToken{kind: kind, text: sql[start:i], start: start, end: i})
+
+		default:
+			tokens = append(tokens, sqlToken{kind: sqlPunct, text: sql[i : i+1], start: i, end: i + 1})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// quotedEnd returns the index just past the closing quote of the quoted text starting at start. A doubled quote, or
+// with escapes a backslash escape, does not terminate the text.
+func quotedEnd(sql string, start int, escapes bool) (int, bool) {
+	quote := sql[start]
+	for i := start + 1; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			if escapes {
+				i++
+			}
+		case quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// backslashEscapes reports if a backslash escapes the next character of the quoted text starting at start, as it does
+// in the strings of mysql and the E'...' strings of postgres. The standard strings of postgres, sqlite and mssql keep
+// a backslash as a plain character, '\' is a complete string there.
+func backslashEscapes(sql string, start int, dialect string) bool {
+	switch dialect {
+	case "mysql":
+		return sql[start] != '`'
+	case "postgres":
+		return sql[start] == '\'' && start > 0 && (sql[start-1] == 'E' || sql[start-1] == 'e') &&
+			(start == 1 || !isWordChar(sql[start-2]))
+	default:
+		return false
+	}
+}
+
+// isDashComment reports if a -- comment starts at i, mysql requires the dashes to be followed by whitespace
+func isDashComment(sql string, i int, dialect string) bool {
+	if !strings.HasPrefix(sql[i:], "--") {
+		return false
+	}
+	return dialect != "mysql" || i+2 == len(sql) || sql[i+2] <= ' '
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c >= 0x80
+}
+
+// splitSQL splits sql text of dialect holding several statements separated by ; into the individual statements,
+// empty statements are dropped. Unlike sandboxed statements, mysql executable comments are kept as part of a statement.
+func splitSQL(sql string, dialect string) (statements []string, err error) {
+	tokens, err := lexSQLTokens(sql, dialect, true)
+	if err != nil {
+		return nil, err
+	}
+
+	start := -1
+	for _, t := range tokens {
+		if t.kind == sqlPunct && t.text == ";" {
+			if start >= 0 {
+				statements = append(statements, strings.TrimSpace(sql[start:t.start]))
+			}
+			start = -1
+			continue
+		}
+		if start < 0 {
+			start = t.start
+		}
+	}
+
+	if start >= 0 {
+		statements = append(statements, strings.TrimSpace(sql[start:]))
+	}
+	return statements, nil
+}
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// createdAtColumn is set when a record is inserted and never changed afterwards
+	createdAtColumn = "created_at"
+
+	// updatedAtColumn is set whenever a record is inserted or updated
+	updatedAtColumn = "updated_at"
+
+	// deletedAtColumn is set when a record of a soft delete table is deleted and cleared when it is restored
+	deletedAtColumn = "deleted_at"
+)
+
+// isTimestampColumn returns true for the columns managed by the server, their values are never taken from a request
+func isTimestampColumn(col *model.ColumnInfo) bool {
+	return col.IsTimestamp()
+}
+
+// timestampNow returns the current time as gorm sets it, gorm itself refreshes UpdatedAt fields on save
+func timestampNow() time.Time {
+	return gorm.NowFunc().UTC()
+}
+
+// setCreated sets the created_at and updated_at columns of a record about to be inserted to now and clears deleted_at
+// and an auto increment primary key, replacing any value sent by the client. Tables without the columns are left
+// unchanged.
+func setCreated(record model.Model, now time.Time) error {
+	if pk := record.TableInfo().PrimaryKey(); pk != nil && pk.IsAutoIncrement {
+		field := reflect.Indirect(reflect.ValueOf(record)).FieldByName(pk.GoFieldName)
+		if !field.IsValid() {
+			return fmt.Errorf("column %s has no field %s", pk.Name, pk.GoFieldName)
+		}
+		field.Set(reflect.Zero(field.Type()))
+	}
+	if err := setTimestamp(record, createdAtColumn, now); err != nil {
+		return err
+	}
+	if err := setTimestamp(record, deletedAtColumn, time.Time{}); err != nil {
+		return err
+	}
+	return setTimestamp(record, updatedAtColumn, now)
+}
+
+// setUpdated sets the updated_at column of a record about to be updated to now
+func setUpdated(record model.Model, now time.Time) error {
+	return setTimestamp(record, updatedAtColumn, now)
+}
+
+// setTimestamp sets the name column of record to now, a zero now sets a nullable column to null
+func setTimestamp(record model.Model, name string, now time.Time) error {
+	col, ok := record.TableInfo().Column(name)
+	if !ok {
+		return nil
+	}
+
+	field := reflect.Indirect(reflect.ValueOf(record)).FieldByName(col.GoFieldName)
+	switch {
+	case !field.IsValid():
+		return fmt.Errorf("column %s has no field %s", col.Name, col.GoFieldName)
+	case field.Type() == reflect.TypeOf(now):
+		field.Set(reflect.ValueOf(now))
+	case field.Type() == reflect.TypeOf(null.Time{}):
+		field.Set(reflect.ValueOf(null.NewTime(now, !now.IsZero())))
+	default:
+		return fmt.Errorf("column %s is a %s, not a time", col.Name, field.Type())
+	}
+	return nil
+}
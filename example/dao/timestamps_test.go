@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"restapi-golang-gin-gen/model"
+
+	"github.com/guregu/null"
+)
+
+func TestSetCreated(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	elevator := &model.Elevators_{ID: 7, CreatedAt: time.Now(), DeletedAt: null.TimeFrom(time.Now())}
+	if err := setCreated(elevator, now); err != nil {
+		t.Fatal(err)
+	}
+	if elevator.ID != 0 || !elevator.CreatedAt.Equal(now) || !elevator.UpdatedAt.Equal(now) || elevator.DeletedAt.Valid {
+		t.Errorf("setCreated() = id %d created %v updated %v deleted %v, want the auto increment id cleared and the timestamps set",
+			elevator.ID, elevator.CreatedAt, elevator.UpdatedAt, elevator.DeletedAt)
+	}
+
+	// a key chosen by the client is kept
+	metadata := &model.ArInternalMetadata_{Key: "environment"}
+	if err := setCreated(metadata, now); err != nil {
+		t.Fatal(err)
+	}
+	if metadata.Key != "environment" {
+		t.Errorf("setCreated() key = %q, want environment", metadata.Key)
+	}
+}
+
+func TestAddInserts(t *testing.T) {
+	useTestTables(t, "elevators", "ar_internal_metadata")
+	ctx := context.Background()
+
+	if _, _, err := AddElevators_(ctx, &model.Elevators_{Status: null.StringFrom("Active")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the id sent by the client is not used, the existing record is left as it is
+	added, _, err := AddElevators_(ctx, &model.Elevators_{ID: 1, Status: null.StringFrom("Inactive")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.ID != 2 {
+		t.Errorf("added id = %d, want 2", added.ID)
+	}
+	if stored := storedElevators(t); stored != "1:Active 2:Inactive" {
+		t.Errorf("stored elevators = %s, want 1:Active 2:Inactive", stored)
+	}
+
+	// an existing key is not overwritten
+	if _, _, err = AddArInternalMetadata_(ctx, &model.ArInternalMetadata_{Key: "environment", Value: null.StringFrom("test")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = AddArInternalMetadata_(ctx, &model.ArInternalMetadata_{Key: "environment", Value: null.StringFrom("production")}); !errors.Is(err, ErrConflict) {
+		t.Errorf("AddArInternalMetadata_() of an existing key error = %v, want %v", err, ErrConflict)
+	}
+	stored := &model.ArInternalMetadata_{}
+	if err = DB.First(stored, "key = ?", "environment").Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Value.String != "test" {
+		t.Errorf("environment = %q after adding it again, want test", stored.Value.String)
+	}
+	if actions := auditActions(t, "ar_internal_metadata"); len(actions) != 1 {
+		t.Errorf("audited %v, want the first insert only", actions)
+	}
+}
@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2023-01-05 23:49:58.185644 -0500 EST m=+0.088586835
+// 2026-10-19 11:26:26.000000 +0000 UTC m=+0.088586835
 
 package docs
 
@@ -35,7 +35,7 @@ var doc = `{
     "paths": {
         "/activeadmincomments": {
             "get": {
-                "description": "GetAllActiveAdminComments is a handler to get a slice of record(s) from active_admin_comments table in the rocket_development database",
+                "description": "GetAllActiveAdminComments is a handler to get a slice of record(s) from active_admin_comments table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -61,8 +61,98 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter namespace=value or namespace[op]=value",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter body=value or body[op]=value",
+                        "name": "body",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter resource_type=value or resource_type[op]=value",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter resource_id=value or resource_id[op]=value",
+                        "name": "resource_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter author_type=value or author_type[op]=value",
+                        "name": "author_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter author_id=value or author_id[op]=value",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
                         "in": "query"
                     }
                 ],
@@ -71,8 +161,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -128,6 +231,70 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/activeadmincomments/bulk": {
+            "post": {
+                "description": "BulkActiveAdminComments runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ActiveAdminComments"
+                ],
+                "summary": "Bulk create, update and delete records of table active_admin_comments",
+                "parameters": [
+                    {
+                        "description": "operations on ActiveAdminComments records, the record of create and update is a ActiveAdminComments",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
                     }
                 }
             }
@@ -153,6 +320,24 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -160,8 +345,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.ActiveAdminComments"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -177,7 +375,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from active_admin_comments table in the rocket_development database",
+                "description": "Update a single record from active_admin_comments table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -196,6 +394,12 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
                     {
                         "description": "Update ActiveAdminComments record",
                         "name": "ActiveAdminComments",
@@ -224,6 +428,42 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
@@ -246,33 +486,82 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveAdminComments"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
-            }
-        },
-        "/activestorageattachments": {
-            "get": {
-                "description": "GetAllActiveStorageAttachments is a handler to get a slice of record(s) from active_storage_attachments table in the rocket_development database",
+            },
+            "patch": {
+                "description": "Patch a single record from active_admin_comments table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -280,34 +569,38 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ActiveStorageAttachments"
+                    "ActiveAdminComments"
                 ],
-                "summary": "Get list of ActiveStorageAttachments",
+                "summary": "Patch an record in table active_admin_comments",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "fields of ActiveAdminComments to change, null clears a field",
+                        "name": "ActiveAdminComments",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ActiveAdminComments"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.ActiveAdminComments"
                         }
                     },
                     "400": {
@@ -321,47 +614,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to active_storage_attachments table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ActiveStorageAttachments"
-                ],
-                "summary": "Add an record to active_storage_attachments table",
-                "parameters": [
-                    {
-                        "description": "Add ActiveStorageAttachments",
-                        "name": "ActiveStorageAttachments",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageAttachments"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageAttachments"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -369,9 +654,9 @@ var doc = `{
                 }
             }
         },
-        "/activestorageattachments/{argID}": {
+        "/activestorageattachments": {
             "get": {
-                "description": "GetActiveStorageAttachments is a function to get a single record from the active_storage_attachments table in the rocket_development database",
+                "description": "GetAllActiveStorageAttachments is a handler to get a slice of record(s) from active_storage_attachments table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -381,24 +666,119 @@ var doc = `{
                 "tags": [
                     "ActiveStorageAttachments"
                 ],
-                "summary": "Get record from table ActiveStorageAttachments by  argID",
-                "operationId": "argID",
+                "summary": "Get list of ActiveStorageAttachments",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: blob",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter name=value or name[op]=value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter record_type=value or record_type[op]=value",
+                        "name": "record_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter record_id=value or record_id[op]=value",
+                        "name": "record_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter blob_id=value or blob_id[op]=value",
+                        "name": "blob_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageAttachments"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -406,15 +786,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from active_storage_attachments table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to active_storage_attachments table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -424,17 +804,10 @@ var doc = `{
                 "tags": [
                     "ActiveStorageAttachments"
                 ],
-                "summary": "Update an record in table active_storage_attachments",
+                "summary": "Add an record to active_storage_attachments table",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update ActiveStorageAttachments record",
+                        "description": "Add ActiveStorageAttachments",
                         "name": "ActiveStorageAttachments",
                         "in": "body",
                         "required": true,
@@ -461,108 +834,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from active_storage_attachments table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ActiveStorageAttachments"
-                ],
-                "summary": "Delete a record from active_storage_attachments",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageAttachments"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/activestorageblobs": {
-            "get": {
-                "description": "GetAllActiveStorageBlobs is a handler to get a slice of record(s) from active_storage_blobs table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ActiveStorageBlobs"
-                ],
-                "summary": "Get list of ActiveStorageBlobs",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/activestorageattachments/bulk": {
             "post": {
-                "description": "add to add a single record to active_storage_blobs table in the rocket_development database",
+                "description": "BulkActiveStorageAttachments runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -570,45 +872,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ActiveStorageBlobs"
+                    "ActiveStorageAttachments"
                 ],
-                "summary": "Add an record to active_storage_blobs table",
+                "summary": "Bulk create, update and delete records of table active_storage_attachments",
                 "parameters": [
                     {
-                        "description": "Add ActiveStorageBlobs",
-                        "name": "ActiveStorageBlobs",
+                        "description": "operations on ActiveStorageAttachments records, the record of create and update is a ActiveStorageAttachments",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/activestorageblobs/{argID}": {
+        "/activestorageattachments/{argID}": {
             "get": {
-                "description": "GetActiveStorageBlobs is a function to get a single record from the active_storage_blobs table in the rocket_development database",
+                "description": "GetActiveStorageAttachments is a function to get a single record from the active_storage_attachments table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -616,9 +912,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ActiveStorageBlobs"
+                    "ActiveStorageAttachments"
                 ],
-                "summary": "Get record from table ActiveStorageBlobs by  argID",
+                "summary": "Get record from table ActiveStorageAttachments by  argID",
                 "operationId": "argID",
                 "parameters": [
                     {
@@ -627,15 +923,52 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: blob",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                            "$ref": "#/definitions/model.ActiveStorageAttachments"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -651,7 +984,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from active_storage_blobs table in the rocket_development database",
+                "description": "Update a single record from active_storage_attachments table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -659,9 +992,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ActiveStorageBlobs"
+                    "ActiveStorageAttachments"
                 ],
-                "summary": "Update an record in table active_storage_blobs",
+                "summary": "Update an record in table active_storage_attachments",
                 "parameters": [
                     {
                         "type": "integer",
@@ -671,12 +1004,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update ActiveStorageBlobs record",
-                        "name": "ActiveStorageBlobs",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update ActiveStorageAttachments record",
+                        "name": "ActiveStorageAttachments",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                            "$ref": "#/definitions/model.ActiveStorageAttachments"
                         }
                     }
                 ],
@@ -684,7 +1023,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                            "$ref": "#/definitions/model.ActiveStorageAttachments"
                         }
                     },
                     "400": {
@@ -698,11 +1037,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a single record from active_storage_blobs table in the rocket_development database",
+                "description": "Delete a single record from active_storage_attachments table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -710,9 +1085,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ActiveStorageBlobs"
+                    "ActiveStorageAttachments"
                 ],
-                "summary": "Delete a record from active_storage_blobs",
+                "summary": "Delete a record from active_storage_attachments",
                 "parameters": [
                     {
                         "type": "integer",
@@ -720,70 +1095,36 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "$ref": "#/definitions/model.ActiveStorageBlobs"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    }
-                }
-            }
-        },
-        "/addresses": {
-            "get": {
-                "description": "GetAllAddresses is a handler to get a slice of record(s) from addresses table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Addresses"
-                ],
-                "summary": "Get list of Addresses",
-                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -795,100 +1136,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to addresses table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Addresses"
-                ],
-                "summary": "Add an record to addresses table",
-                "parameters": [
-                    {
-                        "description": "Add Addresses",
-                        "name": "Addresses",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.Addresses"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.Addresses"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/addresses/{argID}": {
-            "get": {
-                "description": "GetAddresses is a function to get a single record from the addresses table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Addresses"
-                ],
-                "summary": "Get record from table Addresses by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
-                            "$ref": "#/definitions/model.Addresses"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from addresses table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from active_storage_attachments table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -896,9 +1178,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Addresses"
+                    "ActiveStorageAttachments"
                 ],
-                "summary": "Update an record in table addresses",
+                "summary": "Patch an record in table active_storage_attachments",
                 "parameters": [
                     {
                         "type": "integer",
@@ -908,12 +1190,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Addresses record",
-                        "name": "Addresses",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of ActiveStorageAttachments to change, null clears a field",
+                        "name": "ActiveStorageAttachments",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Addresses"
+                            "$ref": "#/definitions/model.ActiveStorageAttachments"
                         }
                     }
                 ],
@@ -921,7 +1209,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Addresses"
+                            "$ref": "#/definitions/model.ActiveStorageAttachments"
                         }
                     },
                     "400": {
@@ -935,45 +1223,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from addresses table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Addresses"
-                ],
-                "summary": "Delete a record from addresses",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Addresses"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -981,9 +1263,9 @@ var doc = `{
                 }
             }
         },
-        "/adminusers": {
+        "/activestorageblobs": {
             "get": {
-                "description": "GetAllAdminUsers is a handler to get a slice of record(s) from admin_users table in the rocket_development database",
+                "description": "GetAllActiveStorageBlobs is a handler to get a slice of record(s) from active_storage_blobs table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -991,9 +1273,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AdminUsers"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Get list of AdminUsers",
+                "summary": "Get list of ActiveStorageBlobs",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1009,8 +1291,92 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: active_storage_attachments",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter key=value or key[op]=value",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter filename=value or filename[op]=value",
+                        "name": "filename",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter content_type=value or content_type[op]=value",
+                        "name": "content_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter metadata=value or metadata[op]=value",
+                        "name": "metadata",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter byte_size=value or byte_size[op]=value",
+                        "name": "byte_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter checksum=value or checksum[op]=value",
+                        "name": "checksum",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
                         "in": "query"
                     }
                 ],
@@ -1019,8 +1385,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1036,7 +1415,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "add to add a single record to admin_users table in the rocket_development database",
+                "description": "add to add a single record to active_storage_blobs table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1044,17 +1423,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AdminUsers"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Add an record to admin_users table",
+                "summary": "Add an record to active_storage_blobs table",
                 "parameters": [
                     {
-                        "description": "Add AdminUsers",
-                        "name": "AdminUsers",
+                        "description": "Add ActiveStorageBlobs",
+                        "name": "ActiveStorageBlobs",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
                         }
                     }
                 ],
@@ -1062,7 +1441,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
                         }
                     },
                     "400": {
@@ -1076,13 +1455,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
             }
         },
-        "/adminusers/{argID}": {
-            "get": {
-                "description": "GetAdminUsers is a function to get a single record from the admin_users table in the rocket_development database",
+        "/activestorageblobs/bulk": {
+            "post": {
+                "description": "BulkActiveStorageBlobs runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1090,42 +1493,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AdminUsers"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Get record from table AdminUsers by  argID",
-                "operationId": "argID",
+                "summary": "Bulk create, update and delete records of table active_storage_blobs",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "operations on ActiveStorageBlobs records, the record of create and update is a ActiveStorageBlobs",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from admin_users table in the rocket_development database",
+            }
+        },
+        "/activestorageblobs/{argID}": {
+            "get": {
+                "description": "GetActiveStorageBlobs is a function to get a single record from the active_storage_blobs table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1133,9 +1533,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AdminUsers"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Update an record in table admin_users",
+                "summary": "Get record from table ActiveStorageBlobs by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1145,22 +1546,50 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update AdminUsers record",
-                        "name": "AdminUsers",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
-                        }
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: active_storage_attachments",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1168,15 +1597,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a single record from admin_users table in the rocket_development database",
+            "put": {
+                "description": "Update a single record from active_storage_blobs table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -1184,9 +1613,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AdminUsers"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Delete a record from admin_users",
+                "summary": "Update an record in table active_storage_blobs",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1194,13 +1623,28 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update ActiveStorageBlobs record",
+                        "name": "ActiveStorageBlobs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AdminUsers"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
                         }
                     },
                     "400": {
@@ -1209,18 +1653,52 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/arinternalmetadata_": {
-            "get": {
-                "description": "GetAllArInternalMetadata_ is a handler to get a slice of record(s) from ar_internal_metadata table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from active_storage_blobs table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1228,36 +1706,46 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArInternalMetadata_"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Get list of ArInternalMetadata_",
+                "summary": "Delete a record from active_storage_blobs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1269,11 +1757,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to ar_internal_metadata table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from active_storage_blobs table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -1281,17 +1799,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArInternalMetadata_"
+                    "ActiveStorageBlobs"
                 ],
-                "summary": "Add an record to ar_internal_metadata table",
+                "summary": "Patch an record in table active_storage_blobs",
                 "parameters": [
                     {
-                        "description": "Add ArInternalMetadata_",
-                        "name": "ArInternalMetadata_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of ActiveStorageBlobs to change, null clears a field",
+                        "name": "ActiveStorageBlobs",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
                         }
                     }
                 ],
@@ -1299,7 +1830,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                            "$ref": "#/definitions/model.ActiveStorageBlobs"
                         }
                     },
                     "400": {
@@ -1313,13 +1844,49 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/arinternalmetadata_/{argKey}": {
+        "/addresses": {
             "get": {
-                "description": "GetArInternalMetadata_ is a function to get a single record from the ar_internal_metadata table in the rocket_development database",
+                "description": "GetAllAddresses is a handler to get a slice of record(s) from addresses table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1327,77 +1894,175 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArInternalMetadata_"
+                    "Addresses"
                 ],
-                "summary": "Get record from table ArInternalMetadata_ by  argKey",
-                "operationId": "argKey",
+                "summary": "Get list of Addresses",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "key",
-                        "name": "argKey",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
-                        }
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "description": "Update a single record from ar_internal_metadata table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ArInternalMetadata_"
-                ],
-                "summary": "Update an record in table ar_internal_metadata",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "key",
-                        "name": "argKey",
-                        "in": "path",
-                        "required": true
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
                     },
                     {
-                        "description": "Update ArInternalMetadata_ record",
-                        "name": "ArInternalMetadata_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
-                        }
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: buildings, customers",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter address_type=value or address_type[op]=value",
+                        "name": "address_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter entity=value or entity[op]=value",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_and_street=value or number_and_street[op]=value",
+                        "name": "number_and_street",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter suite_or_apartment=value or suite_or_apartment[op]=value",
+                        "name": "suite_or_apartment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter city=value or city[op]=value",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter postal_code=value or postal_code[op]=value",
+                        "name": "postal_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter country=value or country[op]=value",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "filter latitude=value or latitude[op]=value",
+                        "name": "latitude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "filter longitude=value or longitude[op]=value",
+                        "name": "longitude",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1412,8 +2077,8 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a single record from ar_internal_metadata table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to addresses table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1421,23 +2086,25 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArInternalMetadata_"
+                    "Addresses"
                 ],
-                "summary": "Delete a record from ar_internal_metadata",
+                "summary": "Add an record to addresses table",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "key",
-                        "name": "argKey",
-                        "in": "path",
-                        "required": true
+                        "description": "Add Addresses",
+                        "name": "Addresses",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Addresses"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                            "$ref": "#/definitions/model.Addresses"
                         }
                     },
                     "400": {
@@ -1446,71 +2113,42 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/batteries_": {
-            "get": {
-                "description": "GetAllBatteries_ is a handler to get a slice of record(s) from batteries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Batteries_"
-                ],
-                "summary": "Get list of Batteries_",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/addresses/bulk": {
             "post": {
-                "description": "add to add a single record to batteries table in the rocket_development database",
+                "description": "BulkAddresses runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1518,45 +2156,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Batteries_"
+                    "Addresses"
                 ],
-                "summary": "Add an record to batteries table",
+                "summary": "Bulk create, update and delete records of table addresses",
                 "parameters": [
                     {
-                        "description": "Add Batteries_",
-                        "name": "Batteries_",
+                        "description": "operations on Addresses records, the record of create and update is a Addresses",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/batteries_/{argID}": {
+        "/addresses/{argID}": {
             "get": {
-                "description": "GetBatteries_ is a function to get a single record from the batteries table in the rocket_development database",
+                "description": "GetAddresses is a function to get a single record from the addresses table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1564,9 +2196,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Batteries_"
+                    "Addresses"
                 ],
-                "summary": "Get record from table Batteries_ by  argID",
+                "summary": "Get record from table Addresses by  argID",
                 "operationId": "argID",
                 "parameters": [
                     {
@@ -1575,15 +2207,52 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: buildings, customers",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/model.Addresses"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1599,7 +2268,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from batteries table in the rocket_development database",
+                "description": "Update a single record from addresses table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -1607,9 +2276,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Batteries_"
+                    "Addresses"
                 ],
-                "summary": "Update an record in table batteries",
+                "summary": "Update an record in table addresses",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1619,12 +2288,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Batteries_ record",
-                        "name": "Batteries_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Addresses record",
+                        "name": "Addresses",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/model.Addresses"
                         }
                     }
                 ],
@@ -1632,7 +2307,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/model.Addresses"
                         }
                     },
                     "400": {
@@ -1646,55 +2321,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from batteries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Batteries_"
-                ],
-                "summary": "Delete a record from batteries",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Batteries_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/blazeraudits_": {
-            "get": {
-                "description": "GetAllBlazerAudits_ is a handler to get a slice of record(s) from blazer_audits table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from addresses table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1702,36 +2369,46 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerAudits_"
+                    "Addresses"
                 ],
-                "summary": "Get list of BlazerAudits_",
+                "summary": "Delete a record from addresses",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1743,11 +2420,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to blazer_audits table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from addresses table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -1755,17 +2462,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerAudits_"
+                    "Addresses"
                 ],
-                "summary": "Add an record to blazer_audits table",
+                "summary": "Patch an record in table addresses",
                 "parameters": [
                     {
-                        "description": "Add BlazerAudits_",
-                        "name": "BlazerAudits_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Addresses to change, null clears a field",
+                        "name": "Addresses",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
+                            "$ref": "#/definitions/model.Addresses"
                         }
                     }
                 ],
@@ -1773,7 +2493,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
+                            "$ref": "#/definitions/model.Addresses"
                         }
                     },
                     "400": {
@@ -1787,13 +2507,49 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/blazeraudits_/{argID}": {
+        "/adminusers": {
             "get": {
-                "description": "GetBlazerAudits_ is a function to get a single record from the blazer_audits table in the rocket_development database",
+                "description": "GetAllAdminUsers is a handler to get a slice of record(s) from admin_users table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1801,26 +2557,133 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerAudits_"
+                    "AdminUsers"
                 ],
-                "summary": "Get record from table BlazerAudits_ by  argID",
-                "operationId": "argID",
+                "summary": "Get list of AdminUsers",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email=value or email[op]=value",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter encrypted_password=value or encrypted_password[op]=value",
+                        "name": "encrypted_password",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter reset_password_token=value or reset_password_token[op]=value",
+                        "name": "reset_password_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter reset_password_sent_at=value or reset_password_sent_at[op]=value",
+                        "name": "reset_password_sent_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter remember_created_at=value or remember_created_at[op]=value",
+                        "name": "remember_created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1828,15 +2691,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from blazer_audits table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to admin_users table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -1844,24 +2707,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerAudits_"
+                    "AdminUsers"
                 ],
-                "summary": "Update an record in table blazer_audits",
+                "summary": "Add an record to admin_users table",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update BlazerAudits_ record",
-                        "name": "BlazerAudits_",
+                        "description": "Add AdminUsers",
+                        "name": "AdminUsers",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     }
                 ],
@@ -1869,7 +2725,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     },
                     "400": {
@@ -1883,108 +2739,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from blazer_audits table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerAudits_"
-                ],
-                "summary": "Delete a record from blazer_audits",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "$ref": "#/definitions/model.BlazerAudits_"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/blazerchecks_": {
-            "get": {
-                "description": "GetAllBlazerChecks_ is a handler to get a slice of record(s) from blazer_checks table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerChecks_"
-                ],
-                "summary": "Get list of BlazerChecks_",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/adminusers/bulk": {
             "post": {
-                "description": "add to add a single record to blazer_checks table in the rocket_development database",
+                "description": "BulkAdminUsers runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1992,45 +2777,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerChecks_"
+                    "AdminUsers"
                 ],
-                "summary": "Add an record to blazer_checks table",
+                "summary": "Bulk create, update and delete records of table admin_users",
                 "parameters": [
                     {
-                        "description": "Add BlazerChecks_",
-                        "name": "BlazerChecks_",
+                        "description": "operations on AdminUsers records, the record of create and update is a AdminUsers",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/blazerchecks_/{argID}": {
+        "/adminusers/{argID}": {
             "get": {
-                "description": "GetBlazerChecks_ is a function to get a single record from the blazer_checks table in the rocket_development database",
+                "description": "GetAdminUsers is a function to get a single record from the admin_users table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -2038,9 +2817,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerChecks_"
+                    "AdminUsers"
                 ],
-                "summary": "Get record from table BlazerChecks_ by  argID",
+                "summary": "Get record from table AdminUsers by  argID",
                 "operationId": "argID",
                 "parameters": [
                     {
@@ -2049,15 +2828,46 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/model.AdminUsers"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -2073,7 +2883,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from blazer_checks table in the rocket_development database",
+                "description": "Update a single record from admin_users table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -2081,9 +2891,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerChecks_"
+                    "AdminUsers"
                 ],
-                "summary": "Update an record in table blazer_checks",
+                "summary": "Update an record in table admin_users",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2093,12 +2903,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update BlazerChecks_ record",
-                        "name": "BlazerChecks_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update AdminUsers record",
+                        "name": "AdminUsers",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     }
                 ],
@@ -2106,7 +2922,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     },
                     "400": {
@@ -2120,55 +2936,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from blazer_checks table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerChecks_"
-                ],
-                "summary": "Delete a record from blazer_checks",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerChecks_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/blazerdashboardqueries_": {
-            "get": {
-                "description": "GetAllBlazerDashboardQueries_ is a handler to get a slice of record(s) from blazer_dashboard_queries table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from admin_users table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -2176,36 +2984,46 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboardQueries_"
+                    "AdminUsers"
                 ],
-                "summary": "Get list of BlazerDashboardQueries_",
+                "summary": "Delete a record from admin_users",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -2217,11 +3035,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to blazer_dashboard_queries table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from admin_users table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -2229,17 +3077,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboardQueries_"
+                    "AdminUsers"
                 ],
-                "summary": "Add an record to blazer_dashboard_queries table",
+                "summary": "Patch an record in table admin_users",
                 "parameters": [
                     {
-                        "description": "Add BlazerDashboardQueries_",
-                        "name": "BlazerDashboardQueries_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of AdminUsers to change, null clears a field",
+                        "name": "AdminUsers",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     }
                 ],
@@ -2247,7 +3108,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                            "$ref": "#/definitions/model.AdminUsers"
                         }
                     },
                     "400": {
@@ -2261,56 +3122,49 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/blazerdashboardqueries_/{argID}": {
-            "get": {
-                "description": "GetBlazerDashboardQueries_ is a function to get a single record from the blazer_dashboard_queries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerDashboardQueries_"
-                ],
-                "summary": "Get record from table BlazerDashboardQueries_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from blazer_dashboard_queries table in the rocket_development database",
+            }
+        },
+        "/arinternalmetadata_": {
+            "get": {
+                "description": "GetAllArInternalMetadata_ is a handler to get a slice of record(s) from ar_internal_metadata table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2318,34 +3172,103 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboardQueries_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Update an record in table blazer_dashboard_queries",
+                "summary": "Get list of ArInternalMetadata_",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "Update BlazerDashboardQueries_ record",
-                        "name": "BlazerDashboardQueries_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
-                        }
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter key=value or key[op]=value",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter value=value or value[op]=value",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -2360,8 +3283,8 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a single record from blazer_dashboard_queries table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to ar_internal_metadata table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -2369,23 +3292,25 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboardQueries_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Delete a record from blazer_dashboard_queries",
+                "summary": "Add an record to ar_internal_metadata table",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "Add ArInternalMetadata_",
+                        "name": "ArInternalMetadata_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
                         }
                     },
                     "400": {
@@ -2394,71 +3319,42 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/blazerdashboards_": {
-            "get": {
-                "description": "GetAllBlazerDashboards_ is a handler to get a slice of record(s) from blazer_dashboards table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerDashboards_"
-                ],
-                "summary": "Get list of BlazerDashboards_",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/arinternalmetadata_/bulk": {
             "post": {
-                "description": "add to add a single record to blazer_dashboards table in the rocket_development database",
+                "description": "BulkArInternalMetadata_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2466,45 +3362,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboards_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Add an record to blazer_dashboards table",
+                "summary": "Bulk create, update and delete records of table ar_internal_metadata",
                 "parameters": [
                     {
-                        "description": "Add BlazerDashboards_",
-                        "name": "BlazerDashboards_",
+                        "description": "operations on ArInternalMetadata_ records, the record of create and update is a ArInternalMetadata_",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/blazerdashboards_/{argID}": {
+        "/arinternalmetadata_/{argKey}": {
             "get": {
-                "description": "GetBlazerDashboards_ is a function to get a single record from the blazer_dashboards table in the rocket_development database",
+                "description": "GetArInternalMetadata_ is a function to get a single record from the ar_internal_metadata table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -2512,26 +3402,57 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboards_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Get record from table BlazerDashboards_ by  argID",
-                "operationId": "argID",
+                "summary": "Get record from table ArInternalMetadata_ by  argKey",
+                "operationId": "argKey",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
+                        "type": "string",
+                        "description": "key",
+                        "name": "argKey",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -2547,7 +3468,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from blazer_dashboards table in the rocket_development database",
+                "description": "Update a single record from ar_internal_metadata table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -2555,24 +3476,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerDashboards_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Update an record in table blazer_dashboards",
+                "summary": "Update an record in table ar_internal_metadata",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
+                        "type": "string",
+                        "description": "key",
+                        "name": "argKey",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update BlazerDashboards_ record",
-                        "name": "BlazerDashboards_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update ArInternalMetadata_ record",
+                        "name": "ArInternalMetadata_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
                         }
                     }
                 ],
@@ -2580,7 +3507,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
                         }
                     },
                     "400": {
@@ -2594,55 +3521,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from blazer_dashboards table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerDashboards_"
-                ],
-                "summary": "Delete a record from blazer_dashboards",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerDashboards_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/blazerqueries_": {
-            "get": {
-                "description": "GetAllBlazerQueries_ is a handler to get a slice of record(s) from blazer_queries table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from ar_internal_metadata table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -2650,36 +3569,46 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerQueries_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Get list of BlazerQueries_",
+                "summary": "Delete a record from ar_internal_metadata",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "key",
+                        "name": "argKey",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -2691,100 +3620,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to blazer_queries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerQueries_"
-                ],
-                "summary": "Add an record to blazer_queries table",
-                "parameters": [
-                    {
-                        "description": "Add BlazerQueries_",
-                        "name": "BlazerQueries_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/blazerqueries_/{argID}": {
-            "get": {
-                "description": "GetBlazerQueries_ is a function to get a single record from the blazer_queries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerQueries_"
-                ],
-                "summary": "Get record from table BlazerQueries_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from blazer_queries table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from ar_internal_metadata table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -2792,24 +3662,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BlazerQueries_"
+                    "ArInternalMetadata_"
                 ],
-                "summary": "Update an record in table blazer_queries",
+                "summary": "Patch an record in table ar_internal_metadata",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
+                        "type": "string",
+                        "description": "key",
+                        "name": "argKey",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update BlazerQueries_ record",
-                        "name": "BlazerQueries_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of ArInternalMetadata_ to change, null clears a field",
+                        "name": "ArInternalMetadata_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
                         }
                     }
                 ],
@@ -2817,7 +3693,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
+                            "$ref": "#/definitions/model.ArInternalMetadata_"
                         }
                     },
                     "400": {
@@ -2831,45 +3707,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from blazer_queries table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BlazerQueries_"
-                ],
-                "summary": "Delete a record from blazer_queries",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.BlazerQueries_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -2877,9 +3747,9 @@ var doc = `{
                 }
             }
         },
-        "/buildingdetails_": {
+        "/audit": {
             "get": {
-                "description": "GetAllBuildingDetails_ is a handler to get a slice of record(s) from building_details table in the rocket_development database",
+                "description": "GetAudit is a handler to get the audit log entries, oldest first. Every create, update, delete and restore of a record is recorded with the principal of the X-Api-User header, the X-Request-Id of the request and the fields changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2887,9 +3757,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BuildingDetails_"
+                    "Audit"
                 ],
-                "summary": "Get list of BuildingDetails_",
+                "summary": "Get the audit log",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2905,8 +3775,20 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "entries of the table (defaults to every table)",
+                        "name": "table",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "entries of the record of table with this primary key",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "entries made at or after a date or RFC 3339 time",
+                        "name": "since",
                         "in": "query"
                     }
                 ],
@@ -2922,17 +3804,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
                     }
                 }
-            },
-            "post": {
-                "description": "add to add a single record to building_details table in the rocket_development database",
+            }
+        },
+        "/batteries_": {
+            "get": {
+                "description": "GetAllBatteries_ is a handler to get a slice of record(s) from batteries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2940,72 +3818,175 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BuildingDetails_"
+                    "Batteries_"
                 ],
-                "summary": "Add an record to building_details table",
+                "summary": "Get list of Batteries_",
                 "parameters": [
                     {
-                        "description": "Add BuildingDetails_",
-                        "name": "BuildingDetails_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
-                        }
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: building, columns, employee, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    }
-                }
-            }
-        },
-        "/buildingdetails_/{argID}": {
-            "get": {
-                "description": "GetBuildingDetails_ is a function to get a single record from the building_details table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BuildingDetails_"
-                ],
-                "summary": "Get record from table BuildingDetails_ by  argID",
-                "operationId": "argID",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "filter employee_id=value or employee_id[op]=value",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter building_id=value or building_id[op]=value",
+                        "name": "building_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter commission_date=value or commission_date[op]=value",
+                        "name": "commission_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_inspection_date=value or last_inspection_date[op]=value",
+                        "name": "last_inspection_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter operations_cert=value or operations_cert[op]=value",
+                        "name": "operations_cert",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3013,15 +3994,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from building_details table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to batteries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3029,24 +4010,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BuildingDetails_"
+                    "Batteries_"
                 ],
-                "summary": "Update an record in table building_details",
+                "summary": "Add an record to batteries table",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update BuildingDetails_ record",
-                        "name": "BuildingDetails_",
+                        "description": "Add Batteries_",
+                        "name": "Batteries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     }
                 ],
@@ -3054,7 +4028,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     },
                     "400": {
@@ -3068,108 +4042,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from building_details table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "BuildingDetails_"
-                ],
-                "summary": "Delete a record from building_details",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "$ref": "#/definitions/model.BuildingDetails_"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/buildings_": {
-            "get": {
-                "description": "GetAllBuildings_ is a handler to get a slice of record(s) from buildings table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Buildings_"
-                ],
-                "summary": "Get list of Buildings_",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/batteries_/bulk": {
             "post": {
-                "description": "add to add a single record to buildings table in the rocket_development database",
+                "description": "BulkBatteries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3177,45 +4080,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Buildings_"
+                    "Batteries_"
                 ],
-                "summary": "Add an record to buildings table",
+                "summary": "Bulk create, update and delete records of table batteries",
                 "parameters": [
                     {
-                        "description": "Add Buildings_",
-                        "name": "Buildings_",
+                        "description": "operations on Batteries_ records, the record of create and update is a Batteries_",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/buildings_/{argID}": {
+        "/batteries_/trash": {
             "get": {
-                "description": "GetBuildings_ is a function to get a single record from the buildings table in the rocket_development database",
+                "description": "TrashBatteries_ is a handler to get a slice of the soft deleted record(s) from batteries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3223,26 +4120,169 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Buildings_"
+                    "Batteries_"
                 ],
-                "summary": "Get record from table Buildings_ by  argID",
-                "operationId": "argID",
+                "summary": "Get list of deleted Batteries_",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: building, columns, employee, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter employee_id=value or employee_id[op]=value",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter building_id=value or building_id[op]=value",
+                        "name": "building_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter commission_date=value or commission_date[op]=value",
+                        "name": "commission_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_inspection_date=value or last_inspection_date[op]=value",
+                        "name": "last_inspection_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter operations_cert=value or operations_cert[op]=value",
+                        "name": "operations_cert",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3250,15 +4290,17 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from buildings table in the rocket_development database",
+            }
+        },
+        "/batteries_/{argID}": {
+            "get": {
+                "description": "GetBatteries_ is a function to get a single record from the batteries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3266,9 +4308,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Buildings_"
+                    "Batteries_"
                 ],
-                "summary": "Update an record in table buildings",
+                "summary": "Get record from table Batteries_ by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3278,22 +4321,50 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Buildings_ record",
-                        "name": "Buildings_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
-                        }
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: building, columns, employee, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
+                            "$ref": "#/definitions/model.Batteries_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3301,15 +4372,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a single record from buildings table in the rocket_development database",
+            "put": {
+                "description": "Update a single record from batteries table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3317,9 +4388,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Buildings_"
+                    "Batteries_"
                 ],
-                "summary": "Delete a record from buildings",
+                "summary": "Update an record in table batteries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3327,13 +4398,28 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Batteries_ record",
+                        "name": "Batteries_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Batteries_"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Buildings_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     },
                     "400": {
@@ -3342,18 +4428,52 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/columns_": {
-            "get": {
-                "description": "GetAllColumns_ is a handler to get a slice of record(s) from columns table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from batteries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3361,36 +4481,46 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Columns_"
+                    "Batteries_"
                 ],
-                "summary": "Get list of Columns_",
+                "summary": "Delete a record from batteries",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3402,11 +4532,41 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to columns table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from batteries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3414,17 +4574,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Columns_"
+                    "Batteries_"
                 ],
-                "summary": "Add an record to columns table",
+                "summary": "Patch an record in table batteries",
                 "parameters": [
                     {
-                        "description": "Add Columns_",
-                        "name": "Columns_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Batteries_ to change, null clears a field",
+                        "name": "Batteries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Columns_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     }
                 ],
@@ -3432,7 +4605,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Columns_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     },
                     "400": {
@@ -3446,56 +4619,49 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/columns_/{argID}": {
-            "get": {
-                "description": "GetColumns_ is a function to get a single record from the columns table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Columns_"
-                ],
-                "summary": "Get record from table Columns_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Columns_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from columns table in the rocket_development database",
+            }
+        },
+        "/batteries_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the batteries table in the rocket_development database, deleted_at is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3503,9 +4669,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Columns_"
+                    "Batteries_"
                 ],
-                "summary": "Update an record in table columns",
+                "summary": "Restore a deleted record of batteries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3515,20 +4681,17 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Columns_ record",
-                        "name": "Columns_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.Columns_"
-                        }
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Columns_"
+                            "$ref": "#/definitions/model.Batteries_"
                         }
                     },
                     "400": {
@@ -3542,45 +4705,33 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from columns table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Columns_"
-                ],
-                "summary": "Delete a record from columns",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Columns_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -3588,9 +4739,9 @@ var doc = `{
                 }
             }
         },
-        "/customers_": {
+        "/blazeraudits_": {
             "get": {
-                "description": "GetAllCustomers_ is a handler to get a slice of record(s) from customers table in the rocket_development database",
+                "description": "GetAllBlazerAudits_ is a handler to get a slice of record(s) from blazer_audits table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3598,9 +4749,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers_"
+                    "BlazerAudits_"
                 ],
-                "summary": "Get list of Customers_",
+                "summary": "Get list of BlazerAudits_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3616,8 +4767,74 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter user_id=value or user_id[op]=value",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter query_id=value or query_id[op]=value",
+                        "name": "query_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter statement=value or statement[op]=value",
+                        "name": "statement",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter data_source=value or data_source[op]=value",
+                        "name": "data_source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
                         "in": "query"
                     }
                 ],
@@ -3626,8 +4843,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3643,7 +4873,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "add to add a single record to customers table in the rocket_development database",
+                "description": "add to add a single record to blazer_audits table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3651,17 +4881,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers_"
+                    "BlazerAudits_"
                 ],
-                "summary": "Add an record to customers table",
+                "summary": "Add an record to blazer_audits table",
                 "parameters": [
                     {
-                        "description": "Add Customers_",
-                        "name": "Customers_",
+                        "description": "Add BlazerAudits_",
+                        "name": "BlazerAudits_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Customers_"
+                            "$ref": "#/definitions/model.BlazerAudits_"
                         }
                     }
                 ],
@@ -3669,7 +4899,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Customers_"
+                            "$ref": "#/definitions/model.BlazerAudits_"
                         }
                     },
                     "400": {
@@ -3683,13 +4913,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/customers_/{argID}": {
-            "get": {
-                "description": "GetCustomers_ is a function to get a single record from the customers table in the rocket_development database",
+        "/blazeraudits_/bulk": {
+            "post": {
+                "description": "BulkBlazerAudits_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3697,42 +4951,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers_"
+                    "BlazerAudits_"
                 ],
-                "summary": "Get record from table Customers_ by  argID",
-                "operationId": "argID",
+                "summary": "Bulk create, update and delete records of table blazer_audits",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "operations on BlazerAudits_ records, the record of create and update is a BlazerAudits_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.Customers_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from customers table in the rocket_development database",
+            }
+        },
+        "/blazeraudits_/{argID}": {
+            "get": {
+                "description": "GetBlazerAudits_ is a function to get a single record from the blazer_audits table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3740,9 +4991,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers_"
+                    "BlazerAudits_"
                 ],
-                "summary": "Update an record in table customers",
+                "summary": "Get record from table BlazerAudits_ by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3752,22 +5004,44 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Customers_ record",
-                        "name": "Customers_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.Customers_"
-                        }
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Customers_"
+                            "$ref": "#/definitions/model.BlazerAudits_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3775,15 +5049,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a single record from customers table in the rocket_development database",
+            "put": {
+                "description": "Update a single record from blazer_audits table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3791,9 +5065,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers_"
+                    "BlazerAudits_"
                 ],
-                "summary": "Delete a record from customers",
+                "summary": "Update an record in table blazer_audits",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3801,13 +5075,28 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update BlazerAudits_ record",
+                        "name": "BlazerAudits_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerAudits_"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Customers_"
+                            "$ref": "#/definitions/model.BlazerAudits_"
                         }
                     },
                     "400": {
@@ -3816,18 +5105,52 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/ddl": {
-            "get": {
-                "description": "GetDdlEndpoints is a function to get a list of ddl endpoints available for tables in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from blazer_audits table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3835,22 +5158,92 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "TableInfo"
+                    "BlazerAudits_"
+                ],
+                "summary": "Delete a record from blazer_audits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Gets a list of ddl endpoints available for tables in the rocket_development database",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/api.CrudAPI"
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/ddl/{argID}": {
-            "get": {
-                "description": "GetDdl is a function to get table info for a table in the rocket_development database",
+            },
+            "patch": {
+                "description": "Patch a single record from blazer_audits table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3858,10 +5251,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "TableInfo"
+                    "BlazerAudits_"
                 ],
-                "summary": "Get table info for a table in the rocket_development database by argID",
-                "operationId": "argID",
+                "summary": "Patch an record in table blazer_audits",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3869,13 +5261,28 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of BlazerAudits_ to change, null clears a field",
+                        "name": "BlazerAudits_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerAudits_"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.CrudAPI"
+                            "$ref": "#/definitions/model.BlazerAudits_"
                         }
                     },
                     "400": {
@@ -3885,7 +5292,43 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -3893,9 +5336,9 @@ var doc = `{
                 }
             }
         },
-        "/elevators_": {
+        "/blazerchecks_": {
             "get": {
-                "description": "GetAllElevators_ is a handler to get a slice of record(s) from elevators table in the rocket_development database",
+                "description": "GetAllBlazerChecks_ is a handler to get a slice of record(s) from blazer_checks table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3903,9 +5346,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Elevators_"
+                    "BlazerChecks_"
                 ],
-                "summary": "Get list of Elevators_",
+                "summary": "Get list of BlazerChecks_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3921,8 +5364,110 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter creator_id=value or creator_id[op]=value",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter query_id=value or query_id[op]=value",
+                        "name": "query_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter state=value or state[op]=value",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter schedule=value or schedule[op]=value",
+                        "name": "schedule",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter emails=value or emails[op]=value",
+                        "name": "emails",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter slack_channels=value or slack_channels[op]=value",
+                        "name": "slack_channels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter check_type=value or check_type[op]=value",
+                        "name": "check_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter message=value or message[op]=value",
+                        "name": "message",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_run_at=value or last_run_at[op]=value",
+                        "name": "last_run_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
                         "in": "query"
                     }
                 ],
@@ -3931,8 +5476,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -3948,7 +5506,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "add to add a single record to elevators table in the rocket_development database",
+                "description": "add to add a single record to blazer_checks table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -3956,17 +5514,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Elevators_"
+                    "BlazerChecks_"
                 ],
-                "summary": "Add an record to elevators table",
+                "summary": "Add an record to blazer_checks table",
                 "parameters": [
                     {
-                        "description": "Add Elevators_",
-                        "name": "Elevators_",
+                        "description": "Add BlazerChecks_",
+                        "name": "BlazerChecks_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/model.BlazerChecks_"
                         }
                     }
                 ],
@@ -3974,7 +5532,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/model.BlazerChecks_"
                         }
                     },
                     "400": {
@@ -3988,56 +5546,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/elevators_/{argID}": {
-            "get": {
-                "description": "GetElevators_ is a function to get a single record from the elevators table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Elevators_"
-                ],
-                "summary": "Get record from table Elevators_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a single record from elevators table in the rocket_development database",
+            }
+        },
+        "/blazerchecks_/bulk": {
+            "post": {
+                "description": "BulkBlazerChecks_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4045,50 +5584,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Elevators_"
+                    "BlazerChecks_"
                 ],
-                "summary": "Update an record in table elevators",
+                "summary": "Bulk create, update and delete records of table blazer_checks",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Elevators_ record",
-                        "name": "Elevators_",
+                        "description": "operations on BlazerChecks_ records, the record of create and update is a BlazerChecks_",
+                        "name": "operations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/api.BulkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a single record from elevators table in the rocket_development database",
+            }
+        },
+        "/blazerchecks_/{argID}": {
+            "get": {
+                "description": "GetBlazerChecks_ is a function to get a single record from the blazer_checks table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4096,9 +5624,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Elevators_"
+                    "BlazerChecks_"
                 ],
-                "summary": "Delete a record from elevators",
+                "summary": "Get record from table BlazerChecks_ by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4106,33 +5635,62 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Elevators_"
+                            "$ref": "#/definitions/model.BlazerChecks_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/employees": {
-            "get": {
-                "description": "GetAllEmployees is a handler to get a slice of record(s) from employees table in the rocket_development database",
+            },
+            "put": {
+                "description": "Update a single record from blazer_checks table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -4140,34 +5698,38 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "BlazerChecks_"
                 ],
-                "summary": "Get list of Employees",
+                "summary": "Update an record in table blazer_checks",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "Update BlazerChecks_ record",
+                        "name": "BlazerChecks_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerChecks_"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.BlazerChecks_"
                         }
                     },
                     "400": {
@@ -4181,57 +5743,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to employees table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Employees"
-                ],
-                "summary": "Add an record to employees table",
-                "parameters": [
-                    {
-                        "description": "Add Employees",
-                        "name": "Employees",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            }
-        },
-        "/employees/{argID}": {
-            "get": {
-                "description": "GetEmployees is a function to get a single record from the employees table in the rocket_development database",
+            },
+            "delete": {
+                "description": "Delete a single record from blazer_checks table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4239,10 +5791,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "BlazerChecks_"
                 ],
-                "summary": "Get record from table Employees by  argID",
-                "operationId": "argID",
+                "summary": "Delete a record from blazer_checks",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4250,15 +5801,36 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -4266,15 +5838,45 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from employees table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from blazer_checks table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -4282,9 +5884,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "BlazerChecks_"
                 ],
-                "summary": "Update an record in table employees",
+                "summary": "Patch an record in table blazer_checks",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4294,12 +5896,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Employees record",
-                        "name": "Employees",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of BlazerChecks_ to change, null clears a field",
+                        "name": "BlazerChecks_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/model.BlazerChecks_"
                         }
                     }
                 ],
@@ -4307,7 +5915,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/model.BlazerChecks_"
                         }
                     },
                     "400": {
@@ -4321,45 +5929,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from employees table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Employees"
-                ],
-                "summary": "Delete a record from employees",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Employees"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -4367,9 +5969,9 @@ var doc = `{
                 }
             }
         },
-        "/interventions_": {
+        "/blazerdashboardqueries_": {
             "get": {
-                "description": "GetAllInterventions_ is a handler to get a slice of record(s) from interventions table in the rocket_development database",
+                "description": "GetAllBlazerDashboardQueries_ is a handler to get a slice of record(s) from blazer_dashboard_queries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4377,9 +5979,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Interventions_"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Get list of Interventions_",
+                "summary": "Get list of BlazerDashboardQueries_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4395,8 +5997,74 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter dashboard_id=value or dashboard_id[op]=value",
+                        "name": "dashboard_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter query_id=value or query_id[op]=value",
+                        "name": "query_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter position=value or position[op]=value",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
                         "in": "query"
                     }
                 ],
@@ -4405,8 +6073,21 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -4422,7 +6103,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "add to add a single record to interventions table in the rocket_development database",
+                "description": "add to add a single record to blazer_dashboard_queries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4430,17 +6111,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Interventions_"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Add an record to interventions table",
+                "summary": "Add an record to blazer_dashboard_queries table",
                 "parameters": [
                     {
-                        "description": "Add Interventions_",
-                        "name": "Interventions_",
+                        "description": "Add BlazerDashboardQueries_",
+                        "name": "BlazerDashboardQueries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
                         }
                     }
                 ],
@@ -4448,7 +6129,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
                         }
                     },
                     "400": {
@@ -4462,13 +6143,77 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/interventions_/{argID}": {
+        "/blazerdashboardqueries_/bulk": {
+            "post": {
+                "description": "BulkBlazerDashboardQueries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BlazerDashboardQueries_"
+                ],
+                "summary": "Bulk create, update and delete records of table blazer_dashboard_queries",
+                "parameters": [
+                    {
+                        "description": "operations on BlazerDashboardQueries_ records, the record of create and update is a BlazerDashboardQueries_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/blazerdashboardqueries_/{argID}": {
             "get": {
-                "description": "GetInterventions_ is a function to get a single record from the interventions table in the rocket_development database",
+                "description": "GetBlazerDashboardQueries_ is a function to get a single record from the blazer_dashboard_queries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4476,9 +6221,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Interventions_"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Get record from table Interventions_ by  argID",
+                "summary": "Get record from table BlazerDashboardQueries_ by  argID",
                 "operationId": "argID",
                 "parameters": [
                     {
@@ -4487,15 +6232,46 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -4511,7 +6287,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a single record from interventions table in the rocket_development database",
+                "description": "Update a single record from blazer_dashboard_queries table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -4519,9 +6295,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Interventions_"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Update an record in table interventions",
+                "summary": "Update an record in table blazer_dashboard_queries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4531,12 +6307,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Interventions_ record",
-                        "name": "Interventions_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update BlazerDashboardQueries_ record",
+                        "name": "BlazerDashboardQueries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
                         }
                     }
                 ],
@@ -4544,7 +6326,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
                         }
                     },
                     "400": {
@@ -4558,11 +6340,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a single record from interventions table in the rocket_development database",
+                "description": "Delete a single record from blazer_dashboard_queries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4570,9 +6388,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Interventions_"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Delete a record from interventions",
+                "summary": "Delete a record from blazer_dashboard_queries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -4580,33 +6398,82 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/model.Interventions_"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
-            }
-        },
-        "/leads": {
-            "get": {
-                "description": "GetAllLeads is a handler to get a slice of record(s) from leads table in the rocket_development database",
+            },
+            "patch": {
+                "description": "Patch a single record from blazer_dashboard_queries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -4614,34 +6481,38 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "BlazerDashboardQueries_"
                 ],
-                "summary": "Get list of Leads",
+                "summary": "Patch an record in table blazer_dashboard_queries",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "fields of BlazerDashboardQueries_ to change, null clears a field",
+                        "name": "BlazerDashboardQueries_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.BlazerDashboardQueries_"
                         }
                     },
                     "400": {
@@ -4655,47 +6526,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to leads table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Leads"
-                ],
-                "summary": "Add an record to leads table",
-                "parameters": [
-                    {
-                        "description": "Add Leads",
-                        "name": "Leads",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -4703,9 +6566,9 @@ var doc = `{
                 }
             }
         },
-        "/leads/{argID}": {
+        "/blazerdashboards_": {
             "get": {
-                "description": "GetLeads is a function to get a single record from the leads table in the rocket_development database",
+                "description": "GetAllBlazerDashboards_ is a handler to get a slice of record(s) from blazer_dashboards table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4713,26 +6576,115 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Get record from table Leads by  argID",
-                "operationId": "argID",
+                "summary": "Get list of BlazerDashboards_",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter creator_id=value or creator_id[op]=value",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter name=value or name[op]=value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -4740,15 +6692,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from leads table in the rocket_development database",
+            "post": {
+                "description": "add to add a single record to blazer_dashboards table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4756,24 +6708,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Update an record in table leads",
+                "summary": "Add an record to blazer_dashboards table",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Leads record",
-                        "name": "Leads",
+                        "description": "Add BlazerDashboards_",
+                        "name": "BlazerDashboards_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
                         }
                     }
                 ],
@@ -4781,7 +6726,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
                         }
                     },
                     "400": {
@@ -4795,11 +6740,37 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a single record from leads table in the rocket_development database",
+            }
+        },
+        "/blazerdashboards_/bulk": {
+            "post": {
+                "description": "BulkBlazerDashboards_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4807,43 +6778,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Delete a record from leads",
+                "summary": "Bulk create, update and delete records of table blazer_dashboards",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "operations on BlazerDashboards_ records, the record of create and update is a BlazerDashboards_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.Leads"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/maps_": {
+        "/blazerdashboards_/{argID}": {
             "get": {
-                "description": "GetAllMaps_ is a handler to get a slice of record(s) from maps table in the rocket_development database",
+                "description": "GetBlazerDashboards_ is a function to get a single record from the blazer_dashboards table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4851,36 +6818,57 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maps_"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Get list of Maps_",
+                "summary": "Get record from table BlazerDashboards_ by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -4888,15 +6876,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to maps table in the rocket_development database",
+            "put": {
+                "description": "Update a single record from blazer_dashboards table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -4904,17 +6892,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maps_"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Add an record to maps table",
+                "summary": "Update an record in table blazer_dashboards",
                 "parameters": [
                     {
-                        "description": "Add Maps_",
-                        "name": "Maps_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update BlazerDashboards_ record",
+                        "name": "BlazerDashboards_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Maps_"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
                         }
                     }
                 ],
@@ -4922,7 +6923,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Maps_"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
                         }
                     },
                     "400": {
@@ -4936,56 +6937,47 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/maps_/{argID}": {
-            "get": {
-                "description": "GetMaps_ is a function to get a single record from the maps table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Maps_"
-                ],
-                "summary": "Get record from table Maps_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Maps_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from maps table in the rocket_development database",
+            "delete": {
+                "description": "Delete a single record from blazer_dashboards table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -4993,9 +6985,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maps_"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Update an record in table maps",
+                "summary": "Delete a record from blazer_dashboards",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5005,22 +6997,34 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Maps_ record",
-                        "name": "Maps_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.Maps_"
-                        }
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/model.Maps_"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -5032,39 +7036,21 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a single record from maps table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Maps_"
-                ],
-                "summary": "Delete a record from maps",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Maps_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -5074,13 +7060,17 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
-            }
-        },
-        "/quotes": {
-            "get": {
-                "description": "GetAllQuotes is a handler to get a slice of record(s) from quotes table in the rocket_development database",
+            },
+            "patch": {
+                "description": "Patch a single record from blazer_dashboards table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -5088,34 +7078,38 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quotes"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Get list of Quotes",
+                "summary": "Patch an record in table blazer_dashboards",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
-                        "in": "query"
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "fields of BlazerDashboards_ to change, null clears a field",
+                        "name": "BlazerDashboards_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerDashboards_"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.BlazerDashboards_"
                         }
                     },
                     "400": {
@@ -5129,47 +7123,39 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "add to add a single record to quotes table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Quotes"
-                ],
-                "summary": "Add an record to quotes table",
-                "parameters": [
-                    {
-                        "description": "Add Quotes",
-                        "name": "Quotes",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -5177,9 +7163,9 @@ var doc = `{
                 }
             }
         },
-        "/quotes/{argID}": {
-            "get": {
-                "description": "GetQuotes is a function to get a single record from the quotes table in the rocket_development database",
+        "/blazerdashboards_/{argID}/queries": {
+            "put": {
+                "description": "ReplaceBlazerDashboardQueries reorders, adds and removes dashboard queries in one transaction, query_ids are stored in the given order",
                 "consumes": [
                     "application/json"
                 ],
@@ -5187,10 +7173,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quotes"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Get record from table Quotes by  argID",
-                "operationId": "argID",
+                "summary": "Replace the queries of a BlazerDashboards_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5198,13 +7183,22 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "ordered query ids",
+                        "name": "BlazerDashboardQueriesRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BlazerDashboardQueriesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/dao.BlazerDashboardRender"
                         }
                     },
                     "400": {
@@ -5214,15 +7208,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from quotes table in the rocket_development database",
+            "post": {
+                "description": "AddBlazerDashboardQuery inserts a query at position, shifting the following queries down",
                 "consumes": [
                     "application/json"
                 ],
@@ -5230,9 +7224,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quotes"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Update an record in table quotes",
+                "summary": "Add a query to a BlazerDashboards_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5242,12 +7236,12 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Quotes record",
-                        "name": "Quotes",
+                        "description": "query to add",
+                        "name": "BlazerDashboardQueryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/api.BlazerDashboardQueryRequest"
                         }
                     }
                 ],
@@ -5255,7 +7249,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/dao.BlazerDashboardRender"
                         }
                     },
                     "400": {
@@ -5271,9 +7265,11 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/blazerdashboards_/{argID}/queries/{queryID}": {
             "delete": {
-                "description": "Delete a single record from quotes table in the rocket_development database",
+                "description": "RemoveBlazerDashboardQuery removes a query from the dashboard and renumbers the remaining positions",
                 "consumes": [
                     "application/json"
                 ],
@@ -5281,9 +7277,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quotes"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Delete a record from quotes",
+                "summary": "Remove a query from a BlazerDashboards_",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5291,13 +7287,20 @@ var doc = `{
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "blazer query id",
+                        "name": "queryID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Quotes"
+                            "$ref": "#/definitions/dao.BlazerDashboardRender"
                         }
                     },
                     "400": {
@@ -5306,8 +7309,8 @@ var doc = `{
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
@@ -5315,9 +7318,9 @@ var doc = `{
                 }
             }
         },
-        "/schemamigrations_": {
+        "/blazerdashboards_/{argID}/render": {
             "get": {
-                "description": "GetAllSchemaMigrations_ is a handler to get a slice of record(s) from schema_migrations table in the rocket_development database",
+                "description": "RenderBlazerDashboard returns the dashboard and its queries sorted by position, optionally running every query concurrently",
                 "consumes": [
                     "application/json"
                 ],
@@ -5325,26 +7328,27 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SchemaMigrations_"
+                    "BlazerDashboards_"
                 ],
-                "summary": "Get list of SchemaMigrations_",
+                "summary": "Render a BlazerDashboards_ with its queries",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "boolean",
+                        "description": "run the queries in the sql sandbox and include their result sets (defaults to false)",
+                        "name": "run",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
+                        "type": "integer",
+                        "description": "seconds allowed for running all the queries (defaults to 30, capped at the sandbox max_time)",
+                        "name": "timeout",
                         "in": "query"
                     }
                 ],
@@ -5352,7 +7356,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/dao.BlazerDashboardRender"
                         }
                     },
                     "400": {
@@ -5362,15 +7366,17 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "add to add a single record to schema_migrations table in the rocket_development database",
+            }
+        },
+        "/blazerqueries_": {
+            "get": {
+                "description": "GetAllBlazerQueries_ is a handler to get a slice of record(s) from blazer_queries table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5378,27 +7384,139 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SchemaMigrations_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Add an record to schema_migrations table",
+                "summary": "Get list of BlazerQueries_",
                 "parameters": [
                     {
-                        "description": "Add SchemaMigrations_",
-                        "name": "SchemaMigrations_",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
-                        }
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter creator_id=value or creator_id[op]=value",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter name=value or name[op]=value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter description=value or description[op]=value",
+                        "name": "description",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter statement=value or statement[op]=value",
+                        "name": "statement",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter data_source=value or data_source[op]=value",
+                        "name": "data_source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -5412,11 +7530,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/schemamigrations_/{argVersion}": {
-            "get": {
-                "description": "GetSchemaMigrations_ is a function to get a single record from the schema_migrations table in the rocket_development database",
+            },
+            "post": {
+                "description": "add to add a single record to blazer_queries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -5424,24 +7540,25 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SchemaMigrations_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Get record from table SchemaMigrations_ by  argVersion",
-                "operationId": "argVersion",
+                "summary": "Add an record to blazer_queries table",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "version",
-                        "name": "argVersion",
-                        "in": "path",
-                        "required": true
+                        "description": "Add BlazerQueries_",
+                        "name": "BlazerQueries_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BlazerQueries_"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
+                            "$ref": "#/definitions/model.BlazerQueries_"
                         }
                     },
                     "400": {
@@ -5451,66 +7568,41 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            },
-            "put": {
-                "description": "Update a single record from schema_migrations table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "SchemaMigrations_"
-                ],
-                "summary": "Update an record in table schema_migrations",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "version",
-                        "name": "argVersion",
-                        "in": "path",
-                        "required": true
                     },
-                    {
-                        "description": "Update SchemaMigrations_ record",
-                        "name": "SchemaMigrations_",
-                        "in": "body",
-                        "required": true,
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
                         "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
+                            "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a single record from schema_migrations table in the rocket_development database",
+            }
+        },
+        "/blazerqueries_/bulk": {
+            "post": {
+                "description": "BulkBlazerQueries_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5518,43 +7610,39 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SchemaMigrations_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Delete a record from schema_migrations",
+                "summary": "Bulk create, update and delete records of table blazer_queries",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "version",
-                        "name": "argVersion",
-                        "in": "path",
-                        "required": true
+                        "description": "operations on BlazerQueries_ records, the record of create and update is a BlazerQueries_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "committed, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/model.SchemaMigrations_"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "rolled back, with the result of every operation",
                         "schema": {
-                            "$ref": "#/definitions/api.HTTPError"
+                            "$ref": "#/definitions/dao.BulkResults"
                         }
                     }
                 }
             }
         },
-        "/users_": {
+        "/blazerqueries_/{argID}": {
             "get": {
-                "description": "GetAllUsers_ is a handler to get a slice of record(s) from users table in the rocket_development database",
+                "description": "GetBlazerQueries_ is a function to get a single record from the blazer_queries table in the rocket_development database",
                 "consumes": [
                     "application/json"
                 ],
@@ -5562,36 +7650,57 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Get list of Users_",
+                "summary": "Get record from table BlazerQueries_ by  argID",
+                "operationId": "argID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page requested (defaults to 0)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "number of records in a page  (defaults to 20)",
-                        "name": "pagesize",
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "db sort order column",
-                        "name": "order",
-                        "in": "query"
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.PagedResults"
+                            "$ref": "#/definitions/model.BlazerQueries_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -5599,15 +7708,15 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "add to add a single record to users table in the rocket_development database",
+            "put": {
+                "description": "Update a single record from blazer_queries table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -5615,17 +7724,30 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Add an record to users table",
+                "summary": "Update an record in table blazer_queries",
                 "parameters": [
                     {
-                        "description": "Add Users_",
-                        "name": "Users_",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update BlazerQueries_ record",
+                        "name": "BlazerQueries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/model.BlazerQueries_"
                         }
                     }
                 ],
@@ -5633,7 +7755,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/model.BlazerQueries_"
                         }
                     },
                     "400": {
@@ -5647,40 +7769,94 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/users_/{argID}": {
-            "get": {
-                "description": "GetUsers_ is a function to get a single record from the users table in the rocket_development database",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users_"
-                ],
-                "summary": "Get record from table Users_ by  argID",
-                "operationId": "argID",
-                "parameters": [
-                    {
-                        "type": "integer",
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from blazer_queries table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BlazerQueries_"
+                ],
+                "summary": "Delete a record from blazer_queries",
+                "parameters": [
+                    {
+                        "type": "integer",
                         "description": "id",
                         "name": "argID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "dry_run=true",
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/dao.DeletePreview"
                         }
                     },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -5688,15 +7864,45 @@ var doc = `{
                         }
                     },
                     "404": {
-                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update a single record from users table in the rocket_development database",
+            "patch": {
+                "description": "Patch a single record from blazer_queries table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -5704,9 +7910,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users_"
+                    "BlazerQueries_"
                 ],
-                "summary": "Update an record in table users",
+                "summary": "Patch an record in table blazer_queries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5716,12 +7922,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Update Users_ record",
-                        "name": "Users_",
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of BlazerQueries_ to change, null clears a field",
+                        "name": "BlazerQueries_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/model.BlazerQueries_"
                         }
                     }
                 ],
@@ -5729,7 +7941,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/model.BlazerQueries_"
                         }
                     },
                     "400": {
@@ -5743,11 +7955,49 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a single record from users table in the rocket_development database",
+            }
+        },
+        "/buildingdetails_": {
+            "get": {
+                "description": "GetAllBuildingDetails_ is a handler to get a slice of record(s) from building_details table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5755,99 +8005,9848 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users_"
+                    "BuildingDetails_"
                 ],
-                "summary": "Delete a record from users",
+                "summary": "Get list of BuildingDetails_",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "argID",
-                        "in": "path",
-                        "required": true
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: building",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter building_id=value or building_id[op]=value",
+                        "name": "building_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information_key=value or information_key[op]=value",
+                        "name": "information_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter value=value or value[op]=value",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Users_"
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to building_details table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Add an record to building_details table",
+                "parameters": [
+                    {
+                        "description": "Add BuildingDetails_",
+                        "name": "BuildingDetails_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
                         "schema": {
                             "$ref": "#/definitions/api.HTTPError"
                         }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildingdetails_/bulk": {
+            "post": {
+                "description": "BulkBuildingDetails_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Bulk create, update and delete records of table building_details",
+                "parameters": [
+                    {
+                        "description": "operations on BuildingDetails_ records, the record of create and update is a BuildingDetails_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildingdetails_/{argID}": {
+            "get": {
+                "description": "GetBuildingDetails_ is a function to get a single record from the building_details table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Get record from table BuildingDetails_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: building",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from building_details table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Update an record in table building_details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update BuildingDetails_ record",
+                        "name": "BuildingDetails_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from building_details table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Delete a record from building_details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from building_details table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BuildingDetails_"
+                ],
+                "summary": "Patch an record in table building_details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of BuildingDetails_ to change, null clears a field",
+                        "name": "BuildingDetails_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BuildingDetails_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_": {
+            "get": {
+                "description": "GetAllBuildings_ is a handler to get a slice of record(s) from buildings table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Get list of Buildings_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, batteries, building_details, customer, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter customer_id=value or customer_id[op]=value",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter address_id=value or address_id[op]=value",
+                        "name": "address_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_building_admin=value or full_name_of_building_admin[op]=value",
+                        "name": "full_name_of_building_admin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email_of_admin_of_building=value or email_of_admin_of_building[op]=value",
+                        "name": "email_of_admin_of_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter phone_num_of_building_admin=value or phone_num_of_building_admin[op]=value",
+                        "name": "phone_num_of_building_admin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_tech_contact_for_building=value or full_name_of_tech_contact_for_building[op]=value",
+                        "name": "full_name_of_tech_contact_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_contact_email_for_building=value or tech_contact_email_for_building[op]=value",
+                        "name": "tech_contact_email_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter tech_contact_phone_for_building=value or tech_contact_phone_for_building[op]=value",
+                        "name": "tech_contact_phone_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to buildings table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Add an record to buildings table",
+                "parameters": [
+                    {
+                        "description": "Add Buildings_",
+                        "name": "Buildings_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_/bulk": {
+            "post": {
+                "description": "BulkBuildings_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Bulk create, update and delete records of table buildings",
+                "parameters": [
+                    {
+                        "description": "operations on Buildings_ records, the record of create and update is a Buildings_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_/trash": {
+            "get": {
+                "description": "TrashBuildings_ is a handler to get a slice of the soft deleted record(s) from buildings table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Get list of deleted Buildings_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, batteries, building_details, customer, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter customer_id=value or customer_id[op]=value",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter address_id=value or address_id[op]=value",
+                        "name": "address_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_building_admin=value or full_name_of_building_admin[op]=value",
+                        "name": "full_name_of_building_admin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email_of_admin_of_building=value or email_of_admin_of_building[op]=value",
+                        "name": "email_of_admin_of_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter phone_num_of_building_admin=value or phone_num_of_building_admin[op]=value",
+                        "name": "phone_num_of_building_admin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_tech_contact_for_building=value or full_name_of_tech_contact_for_building[op]=value",
+                        "name": "full_name_of_tech_contact_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_contact_email_for_building=value or tech_contact_email_for_building[op]=value",
+                        "name": "tech_contact_email_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter tech_contact_phone_for_building=value or tech_contact_phone_for_building[op]=value",
+                        "name": "tech_contact_phone_for_building",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_/{argID}": {
+            "get": {
+                "description": "GetBuildings_ is a function to get a single record from the buildings table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Get record from table Buildings_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, batteries, building_details, customer, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from buildings table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Update an record in table buildings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Buildings_ record",
+                        "name": "Buildings_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from buildings table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Delete a record from buildings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from buildings table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Patch an record in table buildings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Buildings_ to change, null clears a field",
+                        "name": "Buildings_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_/{argID}/details": {
+            "get": {
+                "description": "GetBuildingDetails returns the building_details rows of a building as a single json object of key to value",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Get the details of a Buildings_ as key/value pairs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildingDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "ReplaceBuildingDetails replaces every detail of the building with the key/value pairs of the body in one transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Replace the details of a Buildings_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "key/value pairs",
+                        "name": "BuildingDetails",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildingDetails"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildingDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "MergeBuildingDetails sets the keys of the body on the building in one transaction, a null value removes the key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Merge keys into the details of a Buildings_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "key/value pairs to merge",
+                        "name": "BuildingDetails",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildingDetails"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildingDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/buildings_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the buildings table in the rocket_development database, deleted_at is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Buildings_"
+                ],
+                "summary": "Restore a deleted record of buildings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Buildings_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns_": {
+            "get": {
+                "description": "GetAllColumns_ is a handler to get a slice of record(s) from columns table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Get list of Columns_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, elevators, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter battery_id=value or battery_id[op]=value",
+                        "name": "battery_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter num_of_floors_served=value or num_of_floors_served[op]=value",
+                        "name": "num_of_floors_served",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to columns table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Add an record to columns table",
+                "parameters": [
+                    {
+                        "description": "Add Columns_",
+                        "name": "Columns_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns_/bulk": {
+            "post": {
+                "description": "BulkColumns_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Bulk create, update and delete records of table columns",
+                "parameters": [
+                    {
+                        "description": "operations on Columns_ records, the record of create and update is a Columns_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns_/trash": {
+            "get": {
+                "description": "TrashColumns_ is a handler to get a slice of the soft deleted record(s) from columns table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Get list of deleted Columns_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, elevators, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter battery_id=value or battery_id[op]=value",
+                        "name": "battery_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter num_of_floors_served=value or num_of_floors_served[op]=value",
+                        "name": "num_of_floors_served",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns_/{argID}": {
+            "get": {
+                "description": "GetColumns_ is a function to get a single record from the columns table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Get record from table Columns_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, elevators, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from columns table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Update an record in table columns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Columns_ record",
+                        "name": "Columns_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from columns table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Delete a record from columns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from columns table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Patch an record in table columns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Columns_ to change, null clears a field",
+                        "name": "Columns_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the columns table in the rocket_development database, deleted_at is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns_"
+                ],
+                "summary": "Restore a deleted record of columns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Columns_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers_": {
+            "get": {
+                "description": "GetAllCustomers_ is a handler to get a slice of record(s) from customers table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Get list of Customers_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, buildings, interventions, user",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter address_id=value or address_id[op]=value",
+                        "name": "address_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter user_id=value or user_id[op]=value",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter customer_creation_date=value or customer_creation_date[op]=value",
+                        "name": "customer_creation_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter date=value or date[op]=value",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_name=value or company_name[op]=value",
+                        "name": "company_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_hq_adress=value or company_hq_adress[op]=value",
+                        "name": "company_hq_adress",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_company_contact=value or full_name_of_company_contact[op]=value",
+                        "name": "full_name_of_company_contact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_contact_phone=value or company_contact_phone[op]=value",
+                        "name": "company_contact_phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_contact_e_mail=value or company_contact_e_mail[op]=value",
+                        "name": "company_contact_e_mail",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_desc=value or company_desc[op]=value",
+                        "name": "company_desc",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_service_tech_auth=value or full_name_service_tech_auth[op]=value",
+                        "name": "full_name_service_tech_auth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_auth_phone_service=value or tech_auth_phone_service[op]=value",
+                        "name": "tech_auth_phone_service",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_manager_email_service=value or tech_manager_email_service[op]=value",
+                        "name": "tech_manager_email_service",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to customers table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Add an record to customers table",
+                "parameters": [
+                    {
+                        "description": "Add Customers_",
+                        "name": "Customers_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers_/bulk": {
+            "post": {
+                "description": "BulkCustomers_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Bulk create, update and delete records of table customers",
+                "parameters": [
+                    {
+                        "description": "operations on Customers_ records, the record of create and update is a Customers_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers_/trash": {
+            "get": {
+                "description": "TrashCustomers_ is a handler to get a slice of the soft deleted record(s) from customers table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Get list of deleted Customers_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, buildings, interventions, user",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter address_id=value or address_id[op]=value",
+                        "name": "address_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter user_id=value or user_id[op]=value",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter customer_creation_date=value or customer_creation_date[op]=value",
+                        "name": "customer_creation_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter date=value or date[op]=value",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_name=value or company_name[op]=value",
+                        "name": "company_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_hq_adress=value or company_hq_adress[op]=value",
+                        "name": "company_hq_adress",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_company_contact=value or full_name_of_company_contact[op]=value",
+                        "name": "full_name_of_company_contact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_contact_phone=value or company_contact_phone[op]=value",
+                        "name": "company_contact_phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_contact_e_mail=value or company_contact_e_mail[op]=value",
+                        "name": "company_contact_e_mail",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_desc=value or company_desc[op]=value",
+                        "name": "company_desc",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_service_tech_auth=value or full_name_service_tech_auth[op]=value",
+                        "name": "full_name_service_tech_auth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_auth_phone_service=value or tech_auth_phone_service[op]=value",
+                        "name": "tech_auth_phone_service",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter tech_manager_email_service=value or tech_manager_email_service[op]=value",
+                        "name": "tech_manager_email_service",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers_/{argID}": {
+            "get": {
+                "description": "GetCustomers_ is a function to get a single record from the customers table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Get record from table Customers_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: address, buildings, interventions, user",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from customers table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Update an record in table customers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Customers_ record",
+                        "name": "Customers_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from customers table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Delete a record from customers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from customers table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Patch an record in table customers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Customers_ to change, null clears a field",
+                        "name": "Customers_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the customers table in the rocket_development database, deleted_at is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers_"
+                ],
+                "summary": "Restore a deleted record of customers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Customers_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ddl": {
+            "get": {
+                "description": "GetDdlEndpoints is a function to get a list of ddl endpoints available for tables in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TableInfo"
+                ],
+                "summary": "Gets a list of ddl endpoints available for tables in the rocket_development database",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CrudAPI"
+                        }
+                    }
+                }
+            }
+        },
+        "/ddl/{argID}": {
+            "get": {
+                "description": "GetDdl is a function to get table info for a table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TableInfo"
+                ],
+                "summary": "Get table info for a table in the rocket_development database by argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CrudAPI"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/elevators_": {
+            "get": {
+                "description": "GetAllElevators_ is a handler to get a slice of record(s) from elevators table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Get list of Elevators_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: column, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter column_id=value or column_id[op]=value",
+                        "name": "column_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter serial_number=value or serial_number[op]=value",
+                        "name": "serial_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter model=value or model[op]=value",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter commision_date=value or commision_date[op]=value",
+                        "name": "commision_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_inspection_date=value or last_inspection_date[op]=value",
+                        "name": "last_inspection_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter inspection_cert=value or inspection_cert[op]=value",
+                        "name": "inspection_cert",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to elevators table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Add an record to elevators table",
+                "parameters": [
+                    {
+                        "description": "Add Elevators_",
+                        "name": "Elevators_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/elevators_/bulk": {
+            "post": {
+                "description": "BulkElevators_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Bulk create, update and delete records of table elevators",
+                "parameters": [
+                    {
+                        "description": "operations on Elevators_ records, the record of create and update is a Elevators_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/elevators_/trash": {
+            "get": {
+                "description": "TrashElevators_ is a handler to get a slice of the soft deleted record(s) from elevators table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Get list of deleted Elevators_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: column, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter column_id=value or column_id[op]=value",
+                        "name": "column_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter serial_number=value or serial_number[op]=value",
+                        "name": "serial_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter model=value or model[op]=value",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter type=value or type[op]=value",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter commision_date=value or commision_date[op]=value",
+                        "name": "commision_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_inspection_date=value or last_inspection_date[op]=value",
+                        "name": "last_inspection_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter inspection_cert=value or inspection_cert[op]=value",
+                        "name": "inspection_cert",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter information=value or information[op]=value",
+                        "name": "information",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter notes=value or notes[op]=value",
+                        "name": "notes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/elevators_/{argID}": {
+            "get": {
+                "description": "GetElevators_ is a function to get a single record from the elevators table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Get record from table Elevators_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: column, interventions",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from elevators table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Update an record in table elevators",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Elevators_ record",
+                        "name": "Elevators_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from elevators table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Delete a record from elevators",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from elevators table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Patch an record in table elevators",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Elevators_ to change, null clears a field",
+                        "name": "Elevators_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/elevators_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the elevators table in the rocket_development database, deleted_at is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Elevators_"
+                ],
+                "summary": "Restore a deleted record of elevators",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Elevators_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees": {
+            "get": {
+                "description": "GetAllEmployees is a handler to get a slice of record(s) from employees table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Get list of Employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: batteries, interventions, user",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter user_id=value or user_id[op]=value",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter first_name=value or first_name[op]=value",
+                        "name": "first_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter last_name=value or last_name[op]=value",
+                        "name": "last_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter title=value or title[op]=value",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email=value or email[op]=value",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to employees table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Add an record to employees table",
+                "parameters": [
+                    {
+                        "description": "Add Employees",
+                        "name": "Employees",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/bulk": {
+            "post": {
+                "description": "BulkEmployees runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Bulk create, update and delete records of table employees",
+                "parameters": [
+                    {
+                        "description": "operations on Employees records, the record of create and update is a Employees",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{argID}": {
+            "get": {
+                "description": "GetEmployees is a function to get a single record from the employees table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Get record from table Employees by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: batteries, interventions, user",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from employees table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Update an record in table employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Employees record",
+                        "name": "Employees",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from employees table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Delete a record from employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from employees table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Patch an record in table employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Employees to change, null clears a field",
+                        "name": "Employees",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Employees"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "GetHealth is a handler answering as long as the process serves requests, it checks no dependency",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.HealthStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/interventions_": {
+            "get": {
+                "description": "GetAllInterventions_ is a handler to get a slice of record(s) from interventions table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Get list of Interventions_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, building, column, customer, elevator, employee",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the soft deleted records along with the others (defaults to false)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter author=value or author[op]=value",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter customer_id=value or customer_id[op]=value",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter building_id=value or building_id[op]=value",
+                        "name": "building_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter battery_id=value or battery_id[op]=value",
+                        "name": "battery_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter column_id=value or column_id[op]=value",
+                        "name": "column_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter elevator_id=value or elevator_id[op]=value",
+                        "name": "elevator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter employee_id=value or employee_id[op]=value",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter start_datetime=value or start_datetime[op]=value",
+                        "name": "start_datetime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter end_datetime=value or end_datetime[op]=value",
+                        "name": "end_datetime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter result=value or result[op]=value",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter report=value or report[op]=value",
+                        "name": "report",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to interventions table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Add an record to interventions table",
+                "parameters": [
+                    {
+                        "description": "Add Interventions_",
+                        "name": "Interventions_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/interventions_/bulk": {
+            "post": {
+                "description": "BulkInterventions_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Bulk create, update and delete records of table interventions",
+                "parameters": [
+                    {
+                        "description": "operations on Interventions_ records, the record of create and update is a Interventions_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/interventions_/trash": {
+            "get": {
+                "description": "TrashInterventions_ is a handler to get a slice of the soft deleted record(s) from interventions table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Get list of deleted Interventions_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, building, column, customer, elevator, employee",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter author=value or author[op]=value",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter customer_id=value or customer_id[op]=value",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter building_id=value or building_id[op]=value",
+                        "name": "building_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter battery_id=value or battery_id[op]=value",
+                        "name": "battery_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter column_id=value or column_id[op]=value",
+                        "name": "column_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter elevator_id=value or elevator_id[op]=value",
+                        "name": "elevator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter employee_id=value or employee_id[op]=value",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter start_datetime=value or start_datetime[op]=value",
+                        "name": "start_datetime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter end_datetime=value or end_datetime[op]=value",
+                        "name": "end_datetime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter result=value or result[op]=value",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter report=value or report[op]=value",
+                        "name": "report",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter status=value or status[op]=value",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter deleted_at=value or deleted_at[op]=value",
+                        "name": "deleted_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/interventions_/{argID}": {
+            "get": {
+                "description": "GetInterventions_ is a function to get a single record from the interventions table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Get record from table Interventions_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: battery, building, column, customer, elevator, employee",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from interventions table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Update an record in table interventions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Interventions_ record",
+                        "name": "Interventions_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from interventions table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Delete a record from interventions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from interventions table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Patch an record in table interventions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Interventions_ to change, null clears a field",
+                        "name": "Interventions_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/interventions_/{argID}/restore": {
+            "post": {
+                "description": "Restore a soft deleted record of the interventions table in the rocket_development database, deleted_at is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interventions_"
+                ],
+                "summary": "Restore a deleted record of interventions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the deleted record, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Interventions_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/leads": {
+            "get": {
+                "description": "GetAllLeads is a handler to get a slice of record(s) from leads table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Get list of Leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter full_name_of_the_contact=value or full_name_of_the_contact[op]=value",
+                        "name": "full_name_of_the_contact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter bussiness_name=value or bussiness_name[op]=value",
+                        "name": "bussiness_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email=value or email[op]=value",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter phone=value or phone[op]=value",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter project_name=value or project_name[op]=value",
+                        "name": "project_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter project_description=value or project_description[op]=value",
+                        "name": "project_description",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter department_incharge=value or department_incharge[op]=value",
+                        "name": "department_incharge",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter message=value or message[op]=value",
+                        "name": "message",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter attached_file=value or attached_file[op]=value",
+                        "name": "attached_file",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter creation_date=value or creation_date[op]=value",
+                        "name": "creation_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to leads table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Add an record to leads table",
+                "parameters": [
+                    {
+                        "description": "Add Leads",
+                        "name": "Leads",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/leads/bulk": {
+            "post": {
+                "description": "BulkLeads runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Bulk create, update and delete records of table leads",
+                "parameters": [
+                    {
+                        "description": "operations on Leads records, the record of create and update is a Leads",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/leads/{argID}": {
+            "get": {
+                "description": "GetLeads is a function to get a single record from the leads table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Get record from table Leads by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from leads table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Update an record in table leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Leads record",
+                        "name": "Leads",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from leads table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Delete a record from leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from leads table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Patch an record in table leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Leads to change, null clears a field",
+                        "name": "Leads",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Leads"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/maps_": {
+            "get": {
+                "description": "GetAllMaps_ is a handler to get a slice of record(s) from maps table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Get list of Maps_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to maps table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Add an record to maps table",
+                "parameters": [
+                    {
+                        "description": "Add Maps_",
+                        "name": "Maps_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/maps_/bulk": {
+            "post": {
+                "description": "BulkMaps_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Bulk create, update and delete records of table maps",
+                "parameters": [
+                    {
+                        "description": "operations on Maps_ records, the record of create and update is a Maps_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/maps_/{argID}": {
+            "get": {
+                "description": "GetMaps_ is a function to get a single record from the maps table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Get record from table Maps_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from maps table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Update an record in table maps",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Maps_ record",
+                        "name": "Maps_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from maps table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Delete a record from maps",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from maps table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maps_"
+                ],
+                "summary": "Patch an record in table maps",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Maps_ to change, null clears a field",
+                        "name": "Maps_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Maps_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/quotes": {
+            "get": {
+                "description": "GetAllQuotes is a handler to get a slice of record(s) from quotes table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Get list of Quotes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter building_type=value or building_type[op]=value",
+                        "name": "building_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter service_quality=value or service_quality[op]=value",
+                        "name": "service_quality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_apartments=value or number_of_apartments[op]=value",
+                        "name": "number_of_apartments",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_floors=value or number_of_floors[op]=value",
+                        "name": "number_of_floors",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_businesses=value or number_of_businesses[op]=value",
+                        "name": "number_of_businesses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_basements=value or number_of_basements[op]=value",
+                        "name": "number_of_basements",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_parking=value or number_of_parking[op]=value",
+                        "name": "number_of_parking",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_cages=value or number_of_cages[op]=value",
+                        "name": "number_of_cages",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_occupants=value or number_of_occupants[op]=value",
+                        "name": "number_of_occupants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_hours=value or number_of_hours[op]=value",
+                        "name": "number_of_hours",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter number_of_elevators_needed=value or number_of_elevators_needed[op]=value",
+                        "name": "number_of_elevators_needed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter price_per_unit=value or price_per_unit[op]=value",
+                        "name": "price_per_unit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter elevator_price=value or elevator_price[op]=value",
+                        "name": "elevator_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter installation_fee=value or installation_fee[op]=value",
+                        "name": "installation_fee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter final_price=value or final_price[op]=value",
+                        "name": "final_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter name=value or name[op]=value",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter company_name=value or company_name[op]=value",
+                        "name": "company_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email=value or email[op]=value",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter phone=value or phone[op]=value",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter department=value or department[op]=value",
+                        "name": "department",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter project_name=value or project_name[op]=value",
+                        "name": "project_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter project_description=value or project_description[op]=value",
+                        "name": "project_description",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to quotes table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Add an record to quotes table",
+                "parameters": [
+                    {
+                        "description": "Add Quotes",
+                        "name": "Quotes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/quotes/bulk": {
+            "post": {
+                "description": "BulkQuotes runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Bulk create, update and delete records of table quotes",
+                "parameters": [
+                    {
+                        "description": "operations on Quotes records, the record of create and update is a Quotes",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/quotes/{argID}": {
+            "get": {
+                "description": "GetQuotes is a function to get a single record from the quotes table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Get record from table Quotes by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from quotes table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Update an record in table quotes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Quotes record",
+                        "name": "Quotes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from quotes table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Delete a record from quotes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from quotes table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quotes"
+                ],
+                "summary": "Patch an record in table quotes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Quotes to change, null clears a field",
+                        "name": "Quotes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quotes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "GetReadiness is a handler pinging the database and running the readiness checks, e.g. migrations applied and background workers alive, within a timeout. The outcome of every check is listed in checks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.HealthStatus"
+                        }
+                    },
+                    "503": {
+                        "description": "a check failed or timed out",
+                        "schema": {
+                            "$ref": "#/definitions/api.HealthStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemamigrations_": {
+            "get": {
+                "description": "GetAllSchemaMigrations_ is a handler to get a slice of record(s) from schema_migrations table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Get list of SchemaMigrations_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter version=value or version[op]=value",
+                        "name": "version",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to schema_migrations table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Add an record to schema_migrations table",
+                "parameters": [
+                    {
+                        "description": "Add SchemaMigrations_",
+                        "name": "SchemaMigrations_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemamigrations_/bulk": {
+            "post": {
+                "description": "BulkSchemaMigrations_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Bulk create, update and delete records of table schema_migrations",
+                "parameters": [
+                    {
+                        "description": "operations on SchemaMigrations_ records, the record of create and update is a SchemaMigrations_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemamigrations_/{argVersion}": {
+            "get": {
+                "description": "GetSchemaMigrations_ is a function to get a single record from the schema_migrations table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Get record from table SchemaMigrations_ by  argVersion",
+                "operationId": "argVersion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "version",
+                        "name": "argVersion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from schema_migrations table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Update an record in table schema_migrations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "version",
+                        "name": "argVersion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update SchemaMigrations_ record",
+                        "name": "SchemaMigrations_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from schema_migrations table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Delete a record from schema_migrations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "version",
+                        "name": "argVersion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from schema_migrations table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchemaMigrations_"
+                ],
+                "summary": "Patch an record in table schema_migrations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "version",
+                        "name": "argVersion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of SchemaMigrations_ to change, null clears a field",
+                        "name": "SchemaMigrations_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SchemaMigrations_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Search matches q against the columns flagged as searchable, using mysql FULLTEXT indexes when available, and returns the matching records grouped by table with a relevance score",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search records across tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "words to search for",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated tables to search (defaults to every table with searchable columns)",
+                        "name": "tables",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "records returned per table (defaults to 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users_": {
+            "get": {
+                "description": "GetAllUsers_ is a handler to get a slice of record(s) from users table in the rocket_development database, narrowed by column filters where op is one of eq, ne, lt, gt, in (comma separated, double quote a value holding a comma), like, is_null (true or false) or between (two comma separated values)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Get list of Users_",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated sort columns, - prefix for descending e.g. -updated_at,status (defaults to primary key)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous page, replaces page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the matching records in total_records, -1 when false (defaults to true)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full text search of the searchable columns",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: customers, employees",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter id=value or id[op]=value",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter email=value or email[op]=value",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter encrypted_password=value or encrypted_password[op]=value",
+                        "name": "encrypted_password",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter reset_password_token=value or reset_password_token[op]=value",
+                        "name": "reset_password_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter reset_password_sent_at=value or reset_password_sent_at[op]=value",
+                        "name": "reset_password_sent_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter remember_created_at=value or remember_created_at[op]=value",
+                        "name": "remember_created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter created_at=value or created_at[op]=value",
+                        "name": "created_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter updated_at=value or updated_at[op]=value",
+                        "name": "updated_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "weak entity tag of the latest updated_at, the number of matching records and the query"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "latest updated_at of the matching records"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add to add a single record to users table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Add an record to users table",
+                "parameters": [
+                    {
+                        "description": "Add Users_",
+                        "name": "Users_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users_/bulk": {
+            "post": {
+                "description": "BulkUsers_ runs the operations in order in a single transaction, the first failed operation rolls back every operation unless continue_on_error is set, failed operations are then rolled back on their own and the others committed. update replaces the whole record like PUT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Bulk create, update and delete records of table users",
+                "parameters": [
+                    {
+                        "description": "operations on Users_ records, the record of create and update is a Users_",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "committed, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    },
+                    "400": {
+                        "description": "rolled back, with the result of every operation",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BulkResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/users_/{argID}": {
+            "get": {
+                "description": "GetUsers_ is a function to get a single record from the users table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Get record from table Users_ by  argID",
+                "operationId": "argID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, e.g. id,status (defaults to all)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated related records to embed: customers, employees",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, 304 Not Modified when unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "entity tag of the record, send it in If-Match to update or delete the record only when unchanged"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the record"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a single record from users table in the rocket_development database, every field is replaced and a field missing from the body is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Update an record in table users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update Users_ record",
+                        "name": "Users_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a single record from users table in the rocket_development database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Delete a record from users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the records referencing the record along restrict foreign keys instead of failing with 409",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return the changes the delete would make without deleting",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run=true",
+                        "schema": {
+                            "$ref": "#/definitions/dao.DeletePreview"
+                        }
+                    },
+                    "204": {
+                        "description": "the record was deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patch a single record from users table in the rocket_development database with a json merge patch (RFC 7396), only the fields in the body are changed and a field set to null is cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users_"
+                ],
+                "summary": "Patch an record in table users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the record from GET, the request fails with 412 when the record changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "fields of Users_ to change, null clears a field",
+                        "name": "Users_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Users_"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "ErrConflict, a duplicate value of a unique index or a broken foreign key",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "ErrPreconditionFailed, the record does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "the record has invalid fields, listed in errors",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "ErrPreconditionRequired, If-Match is required by --require-if-match",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "unexpected db error, logged with the request id",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    },
+                    "504": {
+                        "description": "the db calls of the request were cancelled by the request or operation timeout",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "GetVersion is a handler returning the build date, commit and runtime the server was built with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Build information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dao.BuildInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/{resource}/{argID}/history": {
+            "get": {
+                "description": "GetRecordHistory is a handler to get the audit log entries of a single record of a table, oldest first, served at the history path of every table e.g. /elevators_/12/history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Get the change history of a record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "resource path of the table, e.g. elevators_",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "primary key of the record",
+                        "name": "argID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page requested (defaults to 0)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of records in a page  (defaults to 20)",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "entries made at or after a date or RFC 3339 time",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PagedResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.BlazerDashboardQueriesRequest": {
+            "type": "object",
+            "properties": {
+                "query_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api.BlazerDashboardQueryRequest": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer"
+                },
+                "query_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BulkRequest": {
+            "type": "object",
+            "properties": {
+                "continue_on_error": {
+                    "description": "ContinueOnError commits the operations that succeeded instead of rolling back every operation on the first failure",
+                    "type": "boolean"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.BulkOperation"
+                    }
+                }
+            }
+        },
+        "api.CrudAPI": {
+            "type": "object",
+            "properties": {
+                "create_url": {
+                    "type": "string"
+                },
+                "delete_url": {
+                    "type": "string"
+                },
+                "fetch_ddl_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "retrieve_many_url": {
+                    "type": "string"
+                },
+                "retrieve_one_url": {
+                    "type": "string"
+                },
+                "table_info": {
+                    "type": "object",
+                    "$ref": "#/definitions/model.TableInfo"
+                },
+                "update_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.HTTPError": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "record Not Found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/elevators_/12"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "api.HealthStatus": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "api.PagedResults": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "total_records": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.SearchResults": {
+            "type": "object",
+            "properties": {
+                "q": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.SearchGroup"
+                    }
+                }
+            }
+        },
+        "dao.BlazerDashboardRender": {
+            "type": "object",
+            "properties": {
+                "dashboard": {
+                    "type": "object",
+                    "$ref": "#/definitions/model.BlazerDashboards_"
+                },
+                "queries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.BlazerDashboardRenderItem"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "api.CrudAPI": {
+        },
+        "dao.BlazerDashboardRenderItem": {
             "type": "object",
             "properties": {
-                "create_url": {
-                    "type": "string"
+                "dashboard_query_id": {
+                    "type": "integer"
                 },
-                "delete_url": {
+                "position": {
                     "type": "string"
                 },
-                "fetch_ddl_url": {
+                "query": {
+                    "type": "object",
+                    "$ref": "#/definitions/model.BlazerQueries_"
+                },
+                "result": {
+                    "type": "object",
+                    "$ref": "#/definitions/dao.StatementResult"
+                }
+            }
+        },
+        "dao.BuildInfo": {
+            "type": "object",
+            "properties": {
+                "build_date": {
+                    "description": "BuildDate date string of when build was performed filled in by -X compile flag",
                     "type": "string"
                 },
-                "name": {
+                "build_number": {
+                    "description": "BuildNumber date string of when build was performed filled in by -X compile flag",
                     "type": "string"
                 },
-                "retrieve_many_url": {
+                "built_on_ip": {
+                    "description": "BuiltOnIP date string of when build was performed filled in by -X compile flag",
                     "type": "string"
                 },
-                "retrieve_one_url": {
+                "built_on_os": {
+                    "description": "BuiltOnOs date string of when build was performed filled in by -X compile flag",
                     "type": "string"
                 },
-                "table_info": {
-                    "type": "object",
-                    "$ref": "#/definitions/model.TableInfo"
+                "latest_commit": {
+                    "description": "LatestCommit date string of when build was performed filled in by -X compile flag",
+                    "type": "string"
                 },
-                "update_url": {
+                "runtime_ver": {
+                    "description": "RuntimeVer date string of when build was performed filled in by -X compile flag",
                     "type": "string"
                 }
             }
         },
-        "api.HTTPError": {
+        "dao.BuildingDetails": {
+            "type": "object",
+            "additionalProperties": true
+        },
+        "dao.BulkOperation": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
+                "id": {
+                    "description": "ID primary key of the record to update or delete",
+                    "type": "string",
+                    "example": "12"
                 },
-                "message": {
+                "op": {
+                    "description": "Op one of create, update or delete",
                     "type": "string",
-                    "example": "status bad request"
+                    "example": "create"
+                },
+                "record": {
+                    "description": "Record the record to create, or the full replacement of the record to update",
+                    "type": "object"
                 }
             }
         },
-        "api.PagedResults": {
+        "dao.BulkResult": {
             "type": "object",
             "properties": {
-                "data": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "ok": {
+                    "type": "boolean"
+                },
+                "op": {
+                    "type": "string"
+                },
+                "record": {
+                    "description": "Record the created or updated record",
                     "type": "object"
+                }
+            }
+        },
+        "dao.BulkResults": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
                 },
-                "page": {
+                "failed": {
                     "type": "integer"
                 },
-                "page_size": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.BulkResult"
+                    }
+                },
+                "succeeded": {
                     "type": "integer"
+                }
+            }
+        },
+        "dao.DeleteEffect": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action delete, nullify or restrict, a restrict effect fails the delete",
+                    "type": "string",
+                    "example": "delete"
                 },
-                "total_records": {
+                "column": {
+                    "type": "string",
+                    "example": "elevator_id"
+                },
+                "rows": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "table": {
+                    "type": "string",
+                    "example": "interventions"
+                }
+            }
+        },
+        "dao.DeletePreview": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "description": "Allowed is false when a referencing record restricts the delete",
+                    "type": "boolean"
+                },
+                "effects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.DeleteEffect"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "12"
+                },
+                "table": {
+                    "type": "string",
+                    "example": "elevators"
+                }
+            }
+        },
+        "dao.SearchGroup": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dao.SearchHit"
+                    }
+                },
+                "table": {
+                    "type": "string"
+                }
+            }
+        },
+        "dao.SearchHit": {
+            "type": "object",
+            "properties": {
+                "record": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "dao.StatementResult": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "elapsed_ms": {
                     "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "object"
+                        }
+                    }
+                },
+                "truncated": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6084,10 +18083,6 @@ var doc = `{
                     "description": "[ 1] building_id                                    bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
                     "type": "string"
                 },
-                "columns": {
-                    "type": "object",
-                    "$ref": "#/definitions/model.Columns_"
-                },
                 "commission_date": {
                     "description": "[ 5] CommissionDate                                 date                 null: true   primary: false  isArray: false  auto: false  col: date            len: -1      default: []",
                     "type": "string"
@@ -6096,6 +18091,10 @@ var doc = `{
                     "description": "[10] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "[12] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
                 "employee_id": {
                     "description": "[ 0] employee_id                                    bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
                     "type": "string"
@@ -6355,6 +18354,10 @@ var doc = `{
                     "description": "[ 0] customer_id                                    bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "[11] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
                 "email_of_admin_of_building": {
                     "description": "[ 4] EmailOfAdminOfBuilding                         varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
                     "type": "string"
@@ -6448,6 +18451,9 @@ var doc = `{
                 },
                 "protobuf_field_type": {
                     "type": "string"
+                },
+                "searchable": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6462,6 +18468,10 @@ var doc = `{
                     "description": "[ 7] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "[ 9] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
                 "id": {
                     "description": "[ 1] id                                             bigint               null: false  primary: true   isArray: false  auto: true   col: bigint          len: -1      default: []",
                     "type": "integer"
@@ -6493,80 +18503,81 @@ var doc = `{
             }
         },
         "model.Customers_": {
-            "type": "array",
-            "items": {
-                "type": "object",
-                "properties": {
-                    "address_id": {
-                        "description": "[ 0] address_id                                     bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
-                        "type": "string"
-                    },
-                    "company_contact_e_mail": {
-                        "description": "[ 9] CompanyContactEMail                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "company_contact_phone": {
-                        "description": "[ 8] CompanyContactPhone                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "company_desc": {
-                        "description": "[10] CompanyDesc                                    text(65535)          null: true   primary: false  isArray: false  auto: false  col: text            len: 65535   default: []",
-                        "type": "string"
-                    },
-                    "company_hq_adress": {
-                        "description": "[ 6] CompanyHQAdress                                varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "company_name": {
-                        "description": "[ 5] CompanyName                                    varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "created_at": {
-                        "description": "[14] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
-                        "type": "string"
-                    },
-                    "customer_creation_date": {
-                        "description": "[ 3] CustomerCreationDate                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "date": {
-                        "description": "[ 4] date                                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "full_name_of_company_contact": {
-                        "description": "[ 7] FullNameOfCompanyContact                       varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "full_name_service_tech_auth": {
-                        "description": "[11] FullNameServiceTechAuth                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "id": {
-                        "description": "[ 2] id                                             bigint               null: false  primary: true   isArray: false  auto: true   col: bigint          len: -1      default: []",
-                        "type": "integer"
-                    },
-                    "interventions_": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/model.Interventions_"
-                        }
-                    },
-                    "tech_auth_phone_service": {
-                        "description": "[12] TechAuthPhoneService                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "tech_manager_email_service": {
-                        "description": "[13] TechManagerEmailService                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
-                        "type": "string"
-                    },
-                    "updated_at": {
-                        "description": "[15] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
-                        "type": "string"
-                    },
-                    "user_id": {
-                        "description": "[ 1] user_id                                        bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
-                        "type": "string"
+            "type": "object",
+            "properties": {
+                "address_id": {
+                    "description": "[ 0] address_id                                     bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
+                    "type": "string"
+                },
+                "company_contact_e_mail": {
+                    "description": "[ 9] CompanyContactEMail                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "company_contact_phone": {
+                    "description": "[ 8] CompanyContactPhone                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "company_desc": {
+                    "description": "[10] CompanyDesc                                    text(65535)          null: true   primary: false  isArray: false  auto: false  col: text            len: 65535   default: []",
+                    "type": "string"
+                },
+                "company_hq_adress": {
+                    "description": "[ 6] CompanyHQAdress                                varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "company_name": {
+                    "description": "[ 5] CompanyName                                    varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "[14] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
+                    "type": "string"
+                },
+                "customer_creation_date": {
+                    "description": "[ 3] CustomerCreationDate                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "[ 4] date                                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "[16] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
+                "full_name_of_company_contact": {
+                    "description": "[ 7] FullNameOfCompanyContact                       varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "full_name_service_tech_auth": {
+                    "description": "[11] FullNameServiceTechAuth                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "[ 2] id                                             bigint               null: false  primary: true   isArray: false  auto: true   col: bigint          len: -1      default: []",
+                    "type": "integer"
+                },
+                "interventions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Interventions_"
                     }
+                },
+                "tech_auth_phone_service": {
+                    "description": "[12] TechAuthPhoneService                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "tech_manager_email_service": {
+                    "description": "[13] TechManagerEmailService                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "[15] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "[ 1] user_id                                        bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []",
+                    "type": "string"
                 }
             }
         },
@@ -6585,6 +18596,10 @@ var doc = `{
                     "description": "[11] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "[13] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
                 "id": {
                     "description": "[ 1] id                                             bigint               null: false  primary: true   isArray: false  auto: true   col: bigint          len: -1      default: []",
                     "type": "integer"
@@ -6664,6 +18679,42 @@ var doc = `{
                 }
             }
         },
+        "model.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field json field name of the invalid field, empty when the failure is not tied to a single field",
+                    "type": "string",
+                    "example": "email"
+                },
+                "message": {
+                    "description": "Message reason the value of the field is invalid",
+                    "type": "string",
+                    "example": "must be a valid email address"
+                }
+            }
+        },
+        "model.ForeignKey": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "on_delete": {
+                    "description": "OnDelete policy applied to the records of the table when the record they reference is deleted, DeleteRestrict\nwhen empty",
+                    "type": "string"
+                },
+                "ref_column": {
+                    "type": "string"
+                },
+                "ref_table": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Interventions_": {
             "type": "object",
             "properties": {
@@ -6691,6 +18742,10 @@ var doc = `{
                     "description": "[ 2] customer_id                                    int                  null: true   primary: false  isArray: false  auto: false  col: int             len: -1      default: []",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "[15] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]",
+                    "type": "string"
+                },
                 "elevator_id": {
                     "description": "[ 6] elevator_id                                    int                  null: true   primary: false  isArray: false  auto: false  col: int             len: -1      default: []",
                     "type": "string"
@@ -6929,6 +18984,12 @@ var doc = `{
                         "$ref": "#/definitions/model.ColumnInfo"
                     }
                 },
+                "foreign_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ForeignKey"
+                    }
+                },
                 "name": {
                     "type": "string"
                 }
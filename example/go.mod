@@ -22,4 +22,5 @@ require (
 	github.com/swaggo/gin-swagger v1.2.0
 	github.com/swaggo/swag v1.6.5
 	golang.org/x/tools v0.0.0-20210106214847-113979e3529a // indirect
+	gopkg.in/yaml.v2 v2.2.8
 )
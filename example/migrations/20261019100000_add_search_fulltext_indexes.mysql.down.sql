@@ -0,0 +1,16 @@
+ALTER TABLE `active_admin_comments` DROP INDEX `index_active_admin_comments_on_search`;
+ALTER TABLE `addresses` DROP INDEX `index_addresses_on_search`;
+ALTER TABLE `admin_users` DROP INDEX `index_admin_users_on_search`;
+ALTER TABLE `batteries` DROP INDEX `index_batteries_on_search`;
+ALTER TABLE `blazer_dashboards` DROP INDEX `index_blazer_dashboards_on_search`;
+ALTER TABLE `blazer_queries` DROP INDEX `index_blazer_queries_on_search`;
+ALTER TABLE `building_details` DROP INDEX `index_building_details_on_search`;
+ALTER TABLE `buildings` DROP INDEX `index_buildings_on_search`;
+ALTER TABLE `columns` DROP INDEX `index_columns_on_search`;
+ALTER TABLE `customers` DROP INDEX `index_customers_on_search`;
+ALTER TABLE `elevators` DROP INDEX `index_elevators_on_search`;
+ALTER TABLE `employees` DROP INDEX `index_employees_on_search`;
+ALTER TABLE `interventions` DROP INDEX `index_interventions_on_search`;
+ALTER TABLE `leads` DROP INDEX `index_leads_on_search`;
+ALTER TABLE `quotes` DROP INDEX `index_quotes_on_search`;
+ALTER TABLE `users` DROP INDEX `index_users_on_search`;
@@ -0,0 +1,18 @@
+-- FULLTEXT indexes over the columns flagged as searchable in the model TableInfo, search falls back to LIKE for
+-- tables without one. Keep the column list of an index equal to the searchable columns of its table.
+ALTER TABLE `active_admin_comments` ADD FULLTEXT INDEX `index_active_admin_comments_on_search` (`body`);
+ALTER TABLE `addresses` ADD FULLTEXT INDEX `index_addresses_on_search` (`number_and_street`, `city`, `postal_code`, `country`, `notes`);
+ALTER TABLE `admin_users` ADD FULLTEXT INDEX `index_admin_users_on_search` (`email`);
+ALTER TABLE `batteries` ADD FULLTEXT INDEX `index_batteries_on_search` (`Information`, `Notes`);
+ALTER TABLE `blazer_dashboards` ADD FULLTEXT INDEX `index_blazer_dashboards_on_search` (`name`);
+ALTER TABLE `blazer_queries` ADD FULLTEXT INDEX `index_blazer_queries_on_search` (`name`, `description`, `statement`);
+ALTER TABLE `building_details` ADD FULLTEXT INDEX `index_building_details_on_search` (`Value`);
+ALTER TABLE `buildings` ADD FULLTEXT INDEX `index_buildings_on_search` (`FullNameOfBuildingAdmin`, `EmailOfAdminOfBuilding`, `FullNameOfTechContactForBuilding`, `TechContactEmailForBuilding`);
+ALTER TABLE `columns` ADD FULLTEXT INDEX `index_columns_on_search` (`Information`, `Notes`);
+ALTER TABLE `customers` ADD FULLTEXT INDEX `index_customers_on_search` (`CompanyName`, `CompanyHQAdress`, `FullNameOfCompanyContact`, `CompanyContactEMail`, `CompanyDesc`, `FullNameServiceTechAuth`, `TechManagerEmailService`);
+ALTER TABLE `elevators` ADD FULLTEXT INDEX `index_elevators_on_search` (`Model`, `InspectionCert`, `Information`, `Notes`);
+ALTER TABLE `employees` ADD FULLTEXT INDEX `index_employees_on_search` (`first_name`, `last_name`, `title`, `email`);
+ALTER TABLE `interventions` ADD FULLTEXT INDEX `index_interventions_on_search` (`result`, `report`);
+ALTER TABLE `leads` ADD FULLTEXT INDEX `index_leads_on_search` (`Full_name_of_the_contact`, `Bussiness_name`, `Email`, `Project_name`, `Project_description`, `Message`);
+ALTER TABLE `quotes` ADD FULLTEXT INDEX `index_quotes_on_search` (`name`, `company_name`, `email`, `project_name`, `project_description`);
+ALTER TABLE `users` ADD FULLTEXT INDEX `index_users_on_search` (`email`);
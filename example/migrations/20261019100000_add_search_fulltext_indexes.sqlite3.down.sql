@@ -0,0 +1 @@
+-- sqlite has no FULLTEXT indexes, nothing to drop
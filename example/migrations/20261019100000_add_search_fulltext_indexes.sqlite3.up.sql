@@ -0,0 +1 @@
+-- sqlite has no FULLTEXT indexes, search uses LIKE on the searchable columns
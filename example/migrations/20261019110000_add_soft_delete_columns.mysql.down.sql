@@ -0,0 +1,6 @@
+ALTER TABLE `customers` DROP INDEX `index_customers_on_deleted_at`, DROP COLUMN `deleted_at`;
+ALTER TABLE `buildings` DROP INDEX `index_buildings_on_deleted_at`, DROP COLUMN `deleted_at`;
+ALTER TABLE `batteries` DROP INDEX `index_batteries_on_deleted_at`, DROP COLUMN `deleted_at`;
+ALTER TABLE `columns` DROP INDEX `index_columns_on_deleted_at`, DROP COLUMN `deleted_at`;
+ALTER TABLE `elevators` DROP INDEX `index_elevators_on_deleted_at`, DROP COLUMN `deleted_at`;
+ALTER TABLE `interventions` DROP INDEX `index_interventions_on_deleted_at`, DROP COLUMN `deleted_at`;
@@ -0,0 +1,8 @@
+-- deleted_at opts a table into soft delete, deletes then only set it and the rows are removed by the purge command.
+-- The model of a table gets a DeletedAt field along with the column.
+ALTER TABLE `customers` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_customers_on_deleted_at` (`deleted_at`);
+ALTER TABLE `buildings` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_buildings_on_deleted_at` (`deleted_at`);
+ALTER TABLE `batteries` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_batteries_on_deleted_at` (`deleted_at`);
+ALTER TABLE `columns` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_columns_on_deleted_at` (`deleted_at`);
+ALTER TABLE `elevators` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_elevators_on_deleted_at` (`deleted_at`);
+ALTER TABLE `interventions` ADD COLUMN `deleted_at` datetime DEFAULT NULL, ADD INDEX `index_interventions_on_deleted_at` (`deleted_at`);
@@ -0,0 +1,14 @@
+-- deleted_at opts a table into soft delete, deletes then only set it and the rows are removed by the purge command.
+-- sqlite before 3.35 can not drop a column, so this migration has no down file on sqlite.
+ALTER TABLE "customers" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_customers_on_deleted_at" ON "customers" ("deleted_at");
+ALTER TABLE "buildings" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_buildings_on_deleted_at" ON "buildings" ("deleted_at");
+ALTER TABLE "batteries" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_batteries_on_deleted_at" ON "batteries" ("deleted_at");
+ALTER TABLE "columns" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_columns_on_deleted_at" ON "columns" ("deleted_at");
+ALTER TABLE "elevators" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_elevators_on_deleted_at" ON "elevators" ("deleted_at");
+ALTER TABLE "interventions" ADD COLUMN "deleted_at" datetime DEFAULT NULL;
+CREATE INDEX "index_interventions_on_deleted_at" ON "interventions" ("deleted_at");
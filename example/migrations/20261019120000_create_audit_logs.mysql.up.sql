@@ -0,0 +1,15 @@
+-- audit_logs records every create, update, delete and restore of a record made through the api, changes holds the
+-- json of the fields changed with their value before and after
+CREATE TABLE `audit_logs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `table_name` varchar(64) NOT NULL,
+  `record_id` varchar(64) NOT NULL,
+  `action` varchar(16) NOT NULL,
+  `principal` varchar(255) DEFAULT NULL,
+  `request_id` varchar(64) DEFAULT NULL,
+  `changes` mediumtext NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `index_audit_logs_on_table_name_and_record_id` (`table_name`, `record_id`),
+  KEY `index_audit_logs_on_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3;
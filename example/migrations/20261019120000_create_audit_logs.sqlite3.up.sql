@@ -0,0 +1,14 @@
+-- audit_logs records every create, update, delete and restore of a record made through the api, changes holds the
+-- json of the fields changed with their value before and after
+CREATE TABLE "audit_logs" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "table_name" varchar(64) NOT NULL,
+  "record_id" varchar(64) NOT NULL,
+  "action" varchar(16) NOT NULL,
+  "principal" varchar(255) DEFAULT NULL,
+  "request_id" varchar(64) DEFAULT NULL,
+  "changes" text NOT NULL,
+  "created_at" datetime NOT NULL
+);
+CREATE INDEX "index_audit_logs_on_table_name_and_record_id" ON "audit_logs" ("table_name", "record_id");
+CREATE INDEX "index_audit_logs_on_created_at" ON "audit_logs" ("created_at");
@@ -131,6 +131,7 @@ var active_admin_commentsTableInfo = &TableInfo{
 			ProtobufFieldName:  "body",
 			ProtobufType:       "string",
 			ProtobufPos:        3,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -277,7 +278,7 @@ func (a *ActiveAdminComments) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (a *ActiveAdminComments) Validate(action Action) error {
-	return nil
+	return ValidateRecord(a, action)
 }
 
 // TableInfo return table meta data
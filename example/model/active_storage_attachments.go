@@ -187,6 +187,9 @@ var active_storage_attachmentsTableInfo = &TableInfo{
 			ProtobufPos:        6,
 		},
 	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_c3b3935057", Column: "blob_id", RefTable: "active_storage_blobs", RefColumn: "id"},
+	},
 }
 
 // TableName sets the insert table name for this struct type
@@ -205,7 +208,7 @@ func (a *ActiveStorageAttachments) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (a *ActiveStorageAttachments) Validate(action Action) error {
-	return nil
+	return ValidateRecord(a, action)
 }
 
 // TableInfo return table meta data
@@ -251,7 +251,7 @@ func (a *ActiveStorageBlobs) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (a *ActiveStorageBlobs) Validate(action Action) error {
-	return nil
+	return ValidateRecord(a, action)
 }
 
 // TableInfo return table meta data
@@ -185,6 +185,7 @@ var addressesTableInfo = &TableInfo{
 			ProtobufFieldName:  "number_and_street",
 			ProtobufType:       "string",
 			ProtobufPos:        5,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -227,6 +228,7 @@ var addressesTableInfo = &TableInfo{
 			ProtobufFieldName:  "city",
 			ProtobufType:       "string",
 			ProtobufPos:        7,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -248,6 +250,7 @@ var addressesTableInfo = &TableInfo{
 			ProtobufFieldName:  "postal_code",
 			ProtobufType:       "string",
 			ProtobufPos:        8,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -269,6 +272,7 @@ var addressesTableInfo = &TableInfo{
 			ProtobufFieldName:  "country",
 			ProtobufType:       "string",
 			ProtobufPos:        9,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -290,6 +294,7 @@ var addressesTableInfo = &TableInfo{
 			ProtobufFieldName:  "notes",
 			ProtobufType:       "string",
 			ProtobufPos:        10,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -394,7 +399,7 @@ func (a *Addresses) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (a *Addresses) Validate(action Action) error {
-	return nil
+	return ValidateRecord(a, action)
 }
 
 // TableInfo return table meta data
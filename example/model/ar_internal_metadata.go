@@ -154,7 +154,7 @@ func (a *ArInternalMetadata_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (a *ArInternalMetadata_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(a, action)
 }
 
 // TableInfo return table meta data
@@ -33,7 +33,9 @@ CREATE TABLE `batteries` (
   `Notes` text,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
+  KEY `index_batteries_on_deleted_at` (`deleted_at`),
   KEY `index_batteries_on_building_id` (`building_id`),
   KEY `index_batteries_on_employee_id` (`employee_id`),
   CONSTRAINT `fk_rails_ceeeaf55f7` FOREIGN KEY (`employee_id`) REFERENCES `employees` (`id`),
@@ -74,7 +76,8 @@ type Batteries_ struct {
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[11] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
-	columns   Columns_  `gorm:"column:columns;type:Columns_;" json:"columns"`
+	//[12] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt null.Time `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
 }
 
 var batteriesTableInfo = &TableInfo{
@@ -268,6 +271,7 @@ var batteriesTableInfo = &TableInfo{
 			ProtobufFieldName:  "information",
 			ProtobufType:       "string",
 			ProtobufPos:        9,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -289,6 +293,7 @@ var batteriesTableInfo = &TableInfo{
 			ProtobufFieldName:  "notes",
 			ProtobufType:       "string",
 			ProtobufPos:        10,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -332,8 +337,29 @@ var batteriesTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        12,
 		},
+
 		&ColumnInfo{
-			Index:    12,
+			Index:              12,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        13,
+		},
+		&ColumnInfo{
+			Index:    13,
 			Name:     "columns",
 			Comment:  ``,
 			Notes:    ``,
@@ -348,9 +374,13 @@ var batteriesTableInfo = &TableInfo{
 			GoFieldType:     "Columns_",
 			JSONFieldName:   "columns",
 
-			ProtobufPos: 13,
+			ProtobufPos: 14,
 		},
 	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_ceeeaf55f7", Column: "employee_id", RefTable: "employees", RefColumn: "id"},
+		&ForeignKey{Name: "fk_rails_fc40470545", Column: "building_id", RefTable: "buildings", RefColumn: "id"},
+	},
 }
 
 // TableName sets the insert table name for this struct type
@@ -369,7 +399,7 @@ func (b *Batteries_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *Batteries_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -204,7 +204,7 @@ func (b *BlazerAudits_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BlazerAudits_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -348,7 +348,7 @@ func (b *BlazerChecks_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BlazerChecks_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
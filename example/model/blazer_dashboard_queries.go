@@ -204,7 +204,7 @@ func (b *BlazerDashboardQueries_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BlazerDashboardQueries_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -117,6 +117,7 @@ var blazer_dashboardsTableInfo = &TableInfo{
 			ProtobufFieldName:  "name",
 			ProtobufType:       "string",
 			ProtobufPos:        3,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -179,7 +180,7 @@ func (b *BlazerDashboards_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BlazerDashboards_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -129,6 +129,7 @@ var blazer_queriesTableInfo = &TableInfo{
 			ProtobufFieldName:  "name",
 			ProtobufType:       "string",
 			ProtobufPos:        3,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -150,6 +151,7 @@ var blazer_queriesTableInfo = &TableInfo{
 			ProtobufFieldName:  "description",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -171,6 +173,7 @@ var blazer_queriesTableInfo = &TableInfo{
 			ProtobufFieldName:  "statement",
 			ProtobufType:       "string",
 			ProtobufPos:        5,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -275,7 +278,7 @@ func (b *BlazerQueries_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BlazerQueries_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -142,6 +142,7 @@ var building_detailsTableInfo = &TableInfo{
 			ProtobufFieldName:  "value",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -186,6 +187,9 @@ var building_detailsTableInfo = &TableInfo{
 			ProtobufPos:        6,
 		},
 	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_51749f8eac", Column: "building_id", RefTable: "buildings", RefColumn: "id"},
+	},
 }
 
 // TableName sets the insert table name for this struct type
@@ -204,7 +208,7 @@ func (b *BuildingDetails_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *BuildingDetails_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
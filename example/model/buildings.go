@@ -32,7 +32,9 @@ CREATE TABLE `buildings` (
   `TechContactPhoneForBuilding` int DEFAULT NULL,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
+  KEY `index_buildings_on_deleted_at` (`deleted_at`),
   KEY `index_buildings_on_address_id` (`address_id`),
   KEY `index_buildings_on_customer_id` (`customer_id`),
   CONSTRAINT `fk_rails_6dc7a885ab` FOREIGN KEY (`address_id`) REFERENCES `addresses` (`id`),
@@ -58,19 +60,21 @@ type Buildings_ struct {
 	//[ 3] FullNameOfBuildingAdmin                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	FullNameOfBuildingAdmin null.String `gorm:"column:FullNameOfBuildingAdmin;type:varchar;size:255;" json:"full_name_of_building_admin"`
 	//[ 4] EmailOfAdminOfBuilding                         varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	EmailOfAdminOfBuilding null.String `gorm:"column:EmailOfAdminOfBuilding;type:varchar;size:255;" json:"email_of_admin_of_building"`
+	EmailOfAdminOfBuilding null.String `gorm:"column:EmailOfAdminOfBuilding;type:varchar;size:255;" json:"email_of_admin_of_building" validate:"email"`
 	//[ 5] PhoneNumOfBuildingAdmin                        int                  null: true   primary: false  isArray: false  auto: false  col: int             len: -1      default: []
-	PhoneNumOfBuildingAdmin null.Int `gorm:"column:PhoneNumOfBuildingAdmin;type:int;" json:"phone_num_of_building_admin"`
+	PhoneNumOfBuildingAdmin null.Int `gorm:"column:PhoneNumOfBuildingAdmin;type:int;" json:"phone_num_of_building_admin" validate:"phone"`
 	//[ 6] FullNameOfTechContactForBuilding               varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	FullNameOfTechContactForBuilding null.String `gorm:"column:FullNameOfTechContactForBuilding;type:varchar;size:255;" json:"full_name_of_tech_contact_for_building"`
 	//[ 7] TechContactEmailForBuilding                    varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	TechContactEmailForBuilding null.String `gorm:"column:TechContactEmailForBuilding;type:varchar;size:255;" json:"tech_contact_email_for_building"`
+	TechContactEmailForBuilding null.String `gorm:"column:TechContactEmailForBuilding;type:varchar;size:255;" json:"tech_contact_email_for_building" validate:"email"`
 	//[ 8] TechContactPhoneForBuilding                    int                  null: true   primary: false  isArray: false  auto: false  col: int             len: -1      default: []
-	TechContactPhoneForBuilding null.Int `gorm:"column:TechContactPhoneForBuilding;type:int;" json:"tech_contact_phone_for_building"`
+	TechContactPhoneForBuilding null.Int `gorm:"column:TechContactPhoneForBuilding;type:int;" json:"tech_contact_phone_for_building" validate:"phone"`
 	//[ 9] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[10] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
+	//[11] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt null.Time `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
 }
 
 var buildingsTableInfo = &TableInfo{
@@ -159,6 +163,7 @@ var buildingsTableInfo = &TableInfo{
 			ProtobufFieldName:  "full_name_of_building_admin",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -180,6 +185,7 @@ var buildingsTableInfo = &TableInfo{
 			ProtobufFieldName:  "email_of_admin_of_building",
 			ProtobufType:       "string",
 			ProtobufPos:        5,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -222,6 +228,7 @@ var buildingsTableInfo = &TableInfo{
 			ProtobufFieldName:  "full_name_of_tech_contact_for_building",
 			ProtobufType:       "string",
 			ProtobufPos:        7,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -243,6 +250,7 @@ var buildingsTableInfo = &TableInfo{
 			ProtobufFieldName:  "tech_contact_email_for_building",
 			ProtobufType:       "string",
 			ProtobufPos:        8,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -307,6 +315,31 @@ var buildingsTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        11,
 		},
+
+		&ColumnInfo{
+			Index:              11,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        12,
+		},
+	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_6dc7a885ab", Column: "address_id", RefTable: "addresses", RefColumn: "id"},
+		&ForeignKey{Name: "fk_rails_c29cbe7fb8", Column: "customer_id", RefTable: "customers", RefColumn: "id"},
 	},
 }
 
@@ -326,7 +359,7 @@ func (b *Buildings_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (b *Buildings_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(b, action)
 }
 
 // TableInfo return table meta data
@@ -30,7 +30,9 @@ CREATE TABLE `columns` (
   `Notes` text,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
+  KEY `index_columns_on_deleted_at` (`deleted_at`),
   KEY `index_columns_on_battery_id` (`battery_id`),
   CONSTRAINT `fk_rails_021eb14ac4` FOREIGN KEY (`battery_id`) REFERENCES `batteries` (`id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=197 DEFAULT CHARSET=utf8mb3
@@ -63,6 +65,8 @@ type Columns_ struct {
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[ 8] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
+	//[ 9] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt null.Time `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
 }
 
 var columnsTableInfo = &TableInfo{
@@ -193,6 +197,7 @@ var columnsTableInfo = &TableInfo{
 			ProtobufFieldName:  "information",
 			ProtobufType:       "string",
 			ProtobufPos:        6,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -214,6 +219,7 @@ var columnsTableInfo = &TableInfo{
 			ProtobufFieldName:  "notes",
 			ProtobufType:       "string",
 			ProtobufPos:        7,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -257,6 +263,30 @@ var columnsTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        9,
 		},
+
+		&ColumnInfo{
+			Index:              9,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        10,
+		},
+	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_021eb14ac4", Column: "battery_id", RefTable: "batteries", RefColumn: "id"},
 	},
 }
 
@@ -276,7 +306,7 @@ func (c *Columns_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (c *Columns_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(c, action)
 }
 
 // TableInfo return table meta data
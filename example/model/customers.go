@@ -37,7 +37,9 @@ CREATE TABLE `customers` (
   `TechManagerEmailService` varchar(255) DEFAULT NULL,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
+  KEY `index_customers_on_deleted_at` (`deleted_at`),
   KEY `index_customers_on_user_id` (`user_id`),
   KEY `index_customers_on_address_id` (`address_id`),
   CONSTRAINT `fk_rails_3f9404ba26` FOREIGN KEY (`address_id`) REFERENCES `addresses` (`id`),
@@ -53,7 +55,7 @@ JSON Sample
 */
 
 // Customers_ struct is a row record of the customers table in the rocket_development database
-type Customers_ []struct {
+type Customers_ struct {
 	//[ 0] address_id                                     bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []
 	AddressID null.Int `gorm:"column:address_id;type:bigint;" json:"address_id"`
 	//[ 1] user_id                                        bigint               null: true   primary: false  isArray: false  auto: false  col: bigint          len: -1      default: []
@@ -71,7 +73,7 @@ type Customers_ []struct {
 	//[ 7] FullNameOfCompanyContact                       varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	FullNameOfCompanyContact null.String `gorm:"column:FullNameOfCompanyContact;type:varchar;size:255;" json:"full_name_of_company_contact"`
 	//[ 8] CompanyContactPhone                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	CompanyContactPhone null.String `gorm:"column:CompanyContactPhone;type:varchar;size:255;" json:"company_contact_phone"`
+	CompanyContactPhone null.String `gorm:"column:CompanyContactPhone;type:varchar;size:255;" json:"company_contact_phone" validate:"phone"`
 	//[ 9] CompanyContactEMail                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	CompanyContactEMail null.String `gorm:"column:CompanyContactEMail;type:varchar;size:255;" json:"company_contact_e_mail"`
 	//[10] CompanyDesc                                    text(65535)          null: true   primary: false  isArray: false  auto: false  col: text            len: 65535   default: []
@@ -79,14 +81,16 @@ type Customers_ []struct {
 	//[11] FullNameServiceTechAuth                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	FullNameServiceTechAuth null.String `gorm:"column:FullNameServiceTechAuth;type:varchar;size:255;" json:"full_name_service_tech_auth"`
 	//[12] TechAuthPhoneService                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	TechAuthPhoneService null.String `gorm:"column:TechAuthPhoneService;type:varchar;size:255;" json:"tech_auth_phone_service"`
+	TechAuthPhoneService null.String `gorm:"column:TechAuthPhoneService;type:varchar;size:255;" json:"tech_auth_phone_service" validate:"phone"`
 	//[13] TechManagerEmailService                        varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	TechManagerEmailService null.String `gorm:"column:TechManagerEmailService;type:varchar;size:255;" json:"tech_manager_email_service"`
+	TechManagerEmailService null.String `gorm:"column:TechManagerEmailService;type:varchar;size:255;" json:"tech_manager_email_service" validate:"email"`
 	//[14] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[15] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
-	UpdatedAt      time.Time        `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
-	Interventions_ []Interventions_ `gorm:"foreignKey:CustomerID";column:interventions;type:[]Interventions_;" json:"interventions"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
+	//[16] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt      null.Time        `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
+	Interventions_ []Interventions_ `gorm:"foreignKey:CustomerID;column:interventions;type:[]Interventions_;" json:"interventions"`
 }
 
 var customersTableInfo = &TableInfo{
@@ -217,6 +221,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "company_name",
 			ProtobufType:       "string",
 			ProtobufPos:        6,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -238,6 +243,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "company_hq_adress",
 			ProtobufType:       "string",
 			ProtobufPos:        7,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -259,6 +265,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "full_name_of_company_contact",
 			ProtobufType:       "string",
 			ProtobufPos:        8,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -301,6 +308,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "company_contact_e_mail",
 			ProtobufType:       "string",
 			ProtobufPos:        10,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -322,6 +330,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "company_desc",
 			ProtobufType:       "string",
 			ProtobufPos:        11,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -343,6 +352,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "full_name_service_tech_auth",
 			ProtobufType:       "string",
 			ProtobufPos:        12,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -385,6 +395,7 @@ var customersTableInfo = &TableInfo{
 			ProtobufFieldName:  "tech_manager_email_service",
 			ProtobufType:       "string",
 			ProtobufPos:        14,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -428,13 +439,34 @@ var customersTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        16,
 		},
+
+		&ColumnInfo{
+			Index:              16,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        17,
+		},
 		&ColumnInfo{
-			Index:             16,
+			Index:             17,
 			GoFieldName:       "Interventions",
 			GoFieldType:       "Interventions_",
 			JSONFieldName:     "Interventions_",
 			ProtobufFieldName: "Interventions",
-			ProtobufPos:       17,
+			ProtobufPos:       18,
 			Comment:           ``,
 			Notes:             ``,
 			Name:              "Interventions",
@@ -448,6 +480,10 @@ var customersTableInfo = &TableInfo{
 			DefaultValue: "",
 		},
 	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_3f9404ba26", Column: "address_id", RefTable: "addresses", RefColumn: "id"},
+		&ForeignKey{Name: "fk_rails_9917eeaf5d", Column: "user_id", RefTable: "users", RefColumn: "id"},
+	},
 }
 
 // TableName sets the insert table name for this struct type
@@ -466,7 +502,7 @@ func (c *Customers_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (c *Customers_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(c, action)
 }
 
 // TableInfo return table meta data
@@ -34,7 +34,9 @@ CREATE TABLE `elevators` (
   `Notes` text,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
+  KEY `index_elevators_on_deleted_at` (`deleted_at`),
   KEY `index_elevators_on_column_id` (`column_id`),
   CONSTRAINT `fk_rails_69442d7bc2` FOREIGN KEY (`column_id`) REFERENCES `columns` (`id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=592 DEFAULT CHARSET=utf8mb3
@@ -75,6 +77,8 @@ type Elevators_ struct {
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[12] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
+	//[13] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt null.Time `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
 }
 
 var elevatorsTableInfo = &TableInfo{
@@ -163,6 +167,7 @@ var elevatorsTableInfo = &TableInfo{
 			ProtobufFieldName:  "model",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -268,6 +273,7 @@ var elevatorsTableInfo = &TableInfo{
 			ProtobufFieldName:  "inspection_cert",
 			ProtobufType:       "string",
 			ProtobufPos:        9,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -289,6 +295,7 @@ var elevatorsTableInfo = &TableInfo{
 			ProtobufFieldName:  "information",
 			ProtobufType:       "string",
 			ProtobufPos:        10,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -310,6 +317,7 @@ var elevatorsTableInfo = &TableInfo{
 			ProtobufFieldName:  "notes",
 			ProtobufType:       "string",
 			ProtobufPos:        11,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -353,6 +361,30 @@ var elevatorsTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        13,
 		},
+
+		&ColumnInfo{
+			Index:              13,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        14,
+		},
+	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_69442d7bc2", Column: "column_id", RefTable: "columns", RefColumn: "id"},
 	},
 }
 
@@ -372,7 +404,7 @@ func (e *Elevators_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (e *Elevators_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(e, action)
 }
 
 // TableInfo return table meta data
@@ -55,7 +55,7 @@ type Employees struct {
 	//[ 4] title                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	Title null.String `gorm:"column:title;type:varchar;size:255;" json:"title"`
 	//[ 5] email                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Email null.String `gorm:"column:email;type:varchar;size:255;" json:"email"`
+	Email null.String `gorm:"column:email;type:varchar;size:255;" json:"email" validate:"email"`
 	//[ 6] created_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[ 7] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
@@ -127,6 +127,7 @@ var employeesTableInfo = &TableInfo{
 			ProtobufFieldName:  "first_name",
 			ProtobufType:       "string",
 			ProtobufPos:        3,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -148,6 +149,7 @@ var employeesTableInfo = &TableInfo{
 			ProtobufFieldName:  "last_name",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -169,6 +171,7 @@ var employeesTableInfo = &TableInfo{
 			ProtobufFieldName:  "title",
 			ProtobufType:       "string",
 			ProtobufPos:        5,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -190,6 +193,7 @@ var employeesTableInfo = &TableInfo{
 			ProtobufFieldName:  "email",
 			ProtobufType:       "string",
 			ProtobufPos:        6,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -234,6 +238,9 @@ var employeesTableInfo = &TableInfo{
 			ProtobufPos:        8,
 		},
 	},
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "fk_rails_dcfd3d4fc3", Column: "user_id", RefTable: "users", RefColumn: "id"},
+	},
 }
 
 // TableName sets the insert table name for this struct type
@@ -252,7 +259,7 @@ func (e *Employees) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (e *Employees) Validate(action Action) error {
-	return nil
+	return ValidateRecord(e, action)
 }
 
 // TableInfo return table meta data
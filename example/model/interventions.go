@@ -36,7 +36,9 @@ CREATE TABLE `interventions` (
   `status` varchar(255) DEFAULT NULL,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
-  PRIMARY KEY (`id`)
+  `deleted_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `index_interventions_on_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=84 DEFAULT CHARSET=utf8mb3
 
 JSON Sample
@@ -79,6 +81,8 @@ type Interventions_ struct {
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;" json:"created_at"`
 	//[14] updated_at                                     datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;" json:"updated_at"`
+	//[15] deleted_at                                     datetime             null: true   primary: false  isArray: false  auto: false  col: datetime        len: -1      default: [NULL]
+	DeletedAt null.Time `gorm:"column:deleted_at;type:datetime;" json:"deleted_at"`
 }
 
 var interventionsTableInfo = &TableInfo{
@@ -314,6 +318,7 @@ var interventionsTableInfo = &TableInfo{
 			ProtobufFieldName:  "result",
 			ProtobufType:       "string",
 			ProtobufPos:        11,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -335,6 +340,7 @@ var interventionsTableInfo = &TableInfo{
 			ProtobufFieldName:  "report",
 			ProtobufType:       "string",
 			ProtobufPos:        12,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -399,6 +405,36 @@ var interventionsTableInfo = &TableInfo{
 			ProtobufType:       "google.protobuf.Timestamp",
 			ProtobufPos:        15,
 		},
+
+		&ColumnInfo{
+			Index:              15,
+			Name:               "deleted_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "DeletedAt",
+			GoFieldType:        "null.Time",
+			JSONFieldName:      "deleted_at",
+			ProtobufFieldName:  "deleted_at",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        16,
+		},
+	},
+	// the schema declares no foreign keys for interventions, these are enforced by the dao only
+	ForeignKeys: []*ForeignKey{
+		&ForeignKey{Name: "interventions_customer_id", Column: "customer_id", RefTable: "customers", RefColumn: "id"},
+		&ForeignKey{Name: "interventions_building_id", Column: "building_id", RefTable: "buildings", RefColumn: "id"},
+		&ForeignKey{Name: "interventions_battery_id", Column: "battery_id", RefTable: "batteries", RefColumn: "id"},
+		&ForeignKey{Name: "interventions_column_id", Column: "column_id", RefTable: "columns", RefColumn: "id"},
+		&ForeignKey{Name: "interventions_elevator_id", Column: "elevator_id", RefTable: "elevators", RefColumn: "id"},
+		&ForeignKey{Name: "interventions_employee_id", Column: "employee_id", RefTable: "employees", RefColumn: "id"},
 	},
 }
 
@@ -418,7 +454,7 @@ func (i *Interventions_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (i *Interventions_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(i, action)
 }
 
 // TableInfo return table meta data
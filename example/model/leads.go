@@ -54,9 +54,9 @@ type Leads struct {
 	//[ 2] Bussiness_name                                 varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	BussinessName null.String `gorm:"column:Bussiness_name;type:varchar;size:255;" json:"bussiness_name"`
 	//[ 3] Email                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Email null.String `gorm:"column:Email;type:varchar;size:255;" json:"email"`
+	Email null.String `gorm:"column:Email;type:varchar;size:255;" json:"email" validate:"email"`
 	//[ 4] Phone                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Phone null.String `gorm:"column:Phone;type:varchar;size:255;" json:"phone"`
+	Phone null.String `gorm:"column:Phone;type:varchar;size:255;" json:"phone" validate:"phone"`
 	//[ 5] Project_name                                   varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	ProjectName null.String `gorm:"column:Project_name;type:varchar;size:255;" json:"project_name"`
 	//[ 6] Project_description                            varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
@@ -119,6 +119,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "full_name_of_the_contact",
 			ProtobufType:       "string",
 			ProtobufPos:        2,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -140,6 +141,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "bussiness_name",
 			ProtobufType:       "string",
 			ProtobufPos:        3,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -161,6 +163,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "email",
 			ProtobufType:       "string",
 			ProtobufPos:        4,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -203,6 +206,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "project_name",
 			ProtobufType:       "string",
 			ProtobufPos:        6,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -224,6 +228,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "project_description",
 			ProtobufType:       "string",
 			ProtobufPos:        7,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -266,6 +271,7 @@ var leadsTableInfo = &TableInfo{
 			ProtobufFieldName:  "message",
 			ProtobufType:       "string",
 			ProtobufPos:        9,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -370,7 +376,7 @@ func (l *Leads) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (l *Leads) Validate(action Action) error {
-	return nil
+	return ValidateRecord(l, action)
 }
 
 // TableInfo return table meta data
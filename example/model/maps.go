@@ -130,7 +130,7 @@ func (m *Maps_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (m *Maps_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(m, action)
 }
 
 // TableInfo return table meta data
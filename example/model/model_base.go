@@ -1,6 +1,10 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // Action CRUD actions
 type Action int32
@@ -25,6 +29,8 @@ var (
 	FetchDDL = Action(5)
 
 	tables map[string]*TableInfo
+
+	models map[string]func() Model
 )
 
 func init() {
@@ -54,6 +60,33 @@ func init() {
 	tables["quotes"] = quotesTableInfo
 	tables["schema_migrations"] = schema_migrationsTableInfo
 	tables["users"] = usersTableInfo
+
+	models = make(map[string]func() Model)
+
+	models["active_admin_comments"] = func() Model { return &ActiveAdminComments{} }
+	models["active_storage_attachments"] = func() Model { return &ActiveStorageAttachments{} }
+	models["active_storage_blobs"] = func() Model { return &ActiveStorageBlobs{} }
+	models["addresses"] = func() Model { return &Addresses{} }
+	models["admin_users"] = func() Model { return &AdminUsers{} }
+	models["ar_internal_metadata"] = func() Model { return &ArInternalMetadata_{} }
+	models["batteries"] = func() Model { return &Batteries_{} }
+	models["blazer_audits"] = func() Model { return &BlazerAudits_{} }
+	models["blazer_checks"] = func() Model { return &BlazerChecks_{} }
+	models["blazer_dashboard_queries"] = func() Model { return &BlazerDashboardQueries_{} }
+	models["blazer_dashboards"] = func() Model { return &BlazerDashboards_{} }
+	models["blazer_queries"] = func() Model { return &BlazerQueries_{} }
+	models["building_details"] = func() Model { return &BuildingDetails_{} }
+	models["buildings"] = func() Model { return &Buildings_{} }
+	models["columns"] = func() Model { return &Columns_{} }
+	models["customers"] = func() Model { return &Customers_{} }
+	models["elevators"] = func() Model { return &Elevators_{} }
+	models["employees"] = func() Model { return &Employees{} }
+	models["interventions"] = func() Model { return &Interventions_{} }
+	models["leads"] = func() Model { return &Leads{} }
+	models["maps"] = func() Model { return &Maps_{} }
+	models["quotes"] = func() Model { return &Quotes{} }
+	models["schema_migrations"] = func() Model { return &SchemaMigrations_{} }
+	models["users"] = func() Model { return &Users_{} }
 }
 
 // String describe the action
@@ -87,8 +120,106 @@ type Model interface {
 
 // TableInfo describes a table in the database
 type TableInfo struct {
-	Name    string        `json:"name"`
-	Columns []*ColumnInfo `json:"columns"`
+	Name        string        `json:"name"`
+	Columns     []*ColumnInfo `json:"columns"`
+	ForeignKeys []*ForeignKey `json:"foreign_keys"`
+}
+
+// ForeignKey describes a foreign key constraint of a table
+type ForeignKey struct {
+	Name      string `json:"name"`
+	Column    string `json:"column"`
+	RefTable  string `json:"ref_table"`
+	RefColumn string `json:"ref_column"`
+
+	// OnDelete policy applied to the records of the table when the record they reference is deleted, DeleteRestrict
+	// when empty
+	OnDelete string `json:"on_delete,omitempty"`
+}
+
+const (
+	// DeleteRestrict fails the delete of a record that is still referenced, unless the delete cascades
+	DeleteRestrict = "restrict"
+
+	// DeleteCascade deletes the referencing records along with the record
+	DeleteCascade = "cascade"
+
+	// DeleteNullify sets the foreign key column of the referencing records to null
+	DeleteNullify = "nullify"
+)
+
+// DeletePolicy returns the OnDelete policy of the foreign key
+func (fk *ForeignKey) DeletePolicy() string {
+	if fk.OnDelete == "" {
+		return DeleteRestrict
+	}
+	return fk.OnDelete
+}
+
+// SetDeletePolicy sets the OnDelete policy of the foreign key of the column of table, e.g. from the configuration at
+// startup before serving
+// error - unknown table or foreign key column, unknown policy or nullify of a NOT NULL column
+func SetDeletePolicy(table, column, policy string) error {
+	fk, err := deletePolicyForeignKey(table, column, policy)
+	if err != nil {
+		return err
+	}
+	fk.OnDelete = policy
+	return nil
+}
+
+// CheckDeletePolicy returns the error SetDeletePolicy would return without changing the foreign key
+func CheckDeletePolicy(table, column, policy string) error {
+	_, err := deletePolicyForeignKey(table, column, policy)
+	return err
+}
+
+// deletePolicyForeignKey returns the foreign key of the column of table policy can be set on
+func deletePolicyForeignKey(table, column, policy string) (*ForeignKey, error) {
+	tableInfo, ok := GetTableInfo(table)
+	if !ok {
+		return nil, fmt.Errorf("unknown table %q", table)
+	}
+
+	for _, fk := range tableInfo.ForeignKeys {
+		if fk.Column != column {
+			continue
+		}
+
+		switch policy {
+		case DeleteRestrict, DeleteCascade:
+		case DeleteNullify:
+			if col, ok := tableInfo.Column(column); !ok || !col.Nullable {
+				return nil, fmt.Errorf("%s.%s is not nullable, it can not be nullified", table, column)
+			}
+		default:
+			return nil, fmt.Errorf("unknown delete policy %q of %s.%s, must be restrict, cascade or nullify", policy, table, column)
+		}
+		return fk, nil
+	}
+	return nil, fmt.Errorf("%s.%s is not a foreign key", table, column)
+}
+
+// Relation is a table related to another along a foreign key, seen from one of the two tables
+type Relation struct {
+	// Name the relation is included by, the foreign key column without its _id suffix for the referenced record, the
+	// referencing table for its records
+	Name string
+
+	// Column of the table holding the key, the foreign key column or the referenced column
+	Column *ColumnInfo
+
+	// Table the related table
+	Table *TableInfo
+
+	// RefColumn of the related table matching Column
+	RefColumn *ColumnInfo
+
+	// Many is true when every record of the related table referencing the record is included
+	Many bool
+
+	// ForeignKey the relation follows
+	ForeignKey *ForeignKey
 }
 
 // ColumnInfo describes a column in the database table
@@ -112,6 +243,7 @@ type ColumnInfo struct {
 	ColumnType         string `json:"column_type"`
 	ColumnLength       int64  `json:"column_length"`
 	DefaultValue       string `json:"default_value"`
+	Searchable         bool   `json:"searchable"`
 }
 
 // GetTableInfo retrieve TableInfo for a table
@@ -119,3 +251,116 @@ func GetTableInfo(name string) (*TableInfo, bool) {
 	val, ok := tables[name]
 	return val, ok
 }
+
+// SoftDeleteTables returns the tables with soft delete sorted by name
+func SoftDeleteTables() (result []*TableInfo) {
+	for _, table := range tables {
+		if table.SoftDelete() {
+			result = append(result, table)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// NewModel returns a new empty record of a table
+func NewModel(name string) (Model, bool) {
+	newModel, ok := models[name]
+	if !ok {
+		return nil, false
+	}
+	return newModel(), true
+}
+
+// Column returns the column matching name, either the db column name or the json field name. Fields holding related
+// records are not db columns and are never returned.
+func (t *TableInfo) Column(name string) (*ColumnInfo, bool) {
+	for _, col := range t.Columns {
+		if col.IsDBColumn() && (col.Name == name || col.JSONFieldName == name) {
+			return col, true
+		}
+	}
+	return nil, false
+}
+
+// IsDBColumn returns true when the column is stored in the table, false for fields holding related records
+func (c *ColumnInfo) IsDBColumn() bool {
+	return c.DatabaseTypeName != ""
+}
+
+// PrimaryKey returns the primary key column of the table
+func (t *TableInfo) PrimaryKey() *ColumnInfo {
+	for _, col := range t.Columns {
+		if col.IsPrimaryKey {
+			return col
+		}
+	}
+	return nil
+}
+
+// SoftDelete returns true when the table has a deleted_at column, deleting a record then only sets deleted_at and
+// the record stays in the table until purged
+func (t *TableInfo) SoftDelete() bool {
+	_, ok := t.Column("deleted_at")
+	return ok
+}
+
+// SearchableColumns returns the text columns flagged as searchable
+func (t *TableInfo) SearchableColumns() (columns []*ColumnInfo) {
+	for _, col := range t.Columns {
+		if col.Searchable && col.IsDBColumn() {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// Relations returns the relations of the table along the foreign keys of the table and the foreign keys referencing it,
+// sorted by name
+func (t *TableInfo) Relations() (relations []*Relation) {
+	for _, fk := range t.ForeignKeys {
+		column, ok := t.Column(fk.Column)
+		if !ok {
+			continue
+		}
+		refTable, ok := GetTableInfo(fk.RefTable)
+		if !ok {
+			continue
+		}
+		refColumn, ok := refTable.Column(fk.RefColumn)
+		if !ok {
+			continue
+		}
+		relations = append(relations, &Relation{Name: strings.TrimSuffix(fk.Column, "_id"), Column: column, Table: refTable, RefColumn: refColumn, ForeignKey: fk})
+	}
+
+	for _, table := range tables {
+		for _, fk := range table.ForeignKeys {
+			if fk.RefTable != t.Name {
+				continue
+			}
+			column, ok := t.Column(fk.RefColumn)
+			if !ok {
+				continue
+			}
+			refColumn, ok := table.Column(fk.Column)
+			if !ok {
+				continue
+			}
+			relations = append(relations, &Relation{Name: table.Name, Column: column, Table: table, RefColumn: refColumn, Many: true, ForeignKey: fk})
+		}
+	}
+
+	sort.Slice(relations, func(i, j int) bool { return relations[i].Name < relations[j].Name })
+	return relations
+}
+
+// Relation returns the relation of the table named name
+func (t *TableInfo) Relation(name string) (*Relation, bool) {
+	for _, relation := range t.Relations() {
+		if relation.Name == name {
+			return relation, true
+		}
+	}
+	return nil, false
+}
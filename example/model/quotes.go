@@ -100,9 +100,9 @@ type Quotes struct {
 	//[19] company_name                                   varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	CompanyName null.String `gorm:"column:company_name;type:varchar;size:255;" json:"company_name"`
 	//[20] email                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Email null.String `gorm:"column:email;type:varchar;size:255;" json:"email"`
+	Email null.String `gorm:"column:email;type:varchar;size:255;" json:"email" validate:"email"`
 	//[21] phone                                          varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Phone null.String `gorm:"column:phone;type:varchar;size:255;" json:"phone"`
+	Phone null.String `gorm:"column:phone;type:varchar;size:255;" json:"phone" validate:"phone"`
 	//[22] department                                     varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	Department null.String `gorm:"column:department;type:varchar;size:255;" json:"department"`
 	//[23] project_name                                   varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
@@ -512,6 +512,7 @@ var quotesTableInfo = &TableInfo{
 			ProtobufFieldName:  "name",
 			ProtobufType:       "string",
 			ProtobufPos:        19,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -533,6 +534,7 @@ var quotesTableInfo = &TableInfo{
 			ProtobufFieldName:  "company_name",
 			ProtobufType:       "string",
 			ProtobufPos:        20,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -554,6 +556,7 @@ var quotesTableInfo = &TableInfo{
 			ProtobufFieldName:  "email",
 			ProtobufType:       "string",
 			ProtobufPos:        21,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -617,6 +620,7 @@ var quotesTableInfo = &TableInfo{
 			ProtobufFieldName:  "project_name",
 			ProtobufType:       "string",
 			ProtobufPos:        24,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -638,6 +642,7 @@ var quotesTableInfo = &TableInfo{
 			ProtobufFieldName:  "project_description",
 			ProtobufType:       "string",
 			ProtobufPos:        25,
+			Searchable:         true,
 		},
 	},
 }
@@ -658,7 +663,7 @@ func (q *Quotes) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (q *Quotes) Validate(action Action) error {
-	return nil
+	return ValidateRecord(q, action)
 }
 
 // TableInfo return table meta data
@@ -82,7 +82,7 @@ func (s *SchemaMigrations_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (s *SchemaMigrations_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(s, action)
 }
 
 // TableInfo return table meta data
@@ -47,7 +47,7 @@ type Users_ struct {
 	//[ 0] id                                             bigint               null: false  primary: true   isArray: false  auto: true   col: bigint          len: -1      default: []
 	ID int64 `gorm:"primary_key;AUTO_INCREMENT;column:id;type:bigint;" json:"id"`
 	//[ 1] email                                          varchar(255)         null: false  primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
-	Email string `gorm:"column:email;type:varchar;size:255;" json:"email"`
+	Email string `gorm:"column:email;type:varchar;size:255;" json:"email" validate:"email"`
 	//[ 2] encrypted_password                             varchar(255)         null: false  primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
 	EncryptedPassword string `gorm:"column:encrypted_password;type:varchar;size:255;" json:"encrypted_password"`
 	//[ 3] reset_password_token                           varchar(255)         null: true   primary: false  isArray: false  auto: false  col: varchar         len: 255     default: []
@@ -106,6 +106,7 @@ var usersTableInfo = &TableInfo{
 			ProtobufFieldName:  "email",
 			ProtobufType:       "string",
 			ProtobufPos:        2,
+			Searchable:         true,
 		},
 
 		&ColumnInfo{
@@ -252,7 +253,7 @@ func (u *Users_) Prepare() {
 
 // Validate invoked before performing action, return an error if field is not populated.
 func (u *Users_) Validate(action Action) error {
-	return nil
+	return ValidateRecord(u, action)
 }
 
 // TableInfo return table meta data
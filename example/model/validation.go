@@ -0,0 +1,406 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxTextLength bytes held by a text column
+	maxTextLength = 65535
+)
+
+var (
+	emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s.]+$`)
+
+	phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ().-]*$`)
+
+	// rules declared by the validate struct tags of the models, keyed by table and column name
+	rules map[string]map[string][]*Rule
+)
+
+func init() {
+	rules = make(map[string]map[string][]*Rule)
+
+	for name, newModel := range models {
+		record := reflect.Indirect(reflect.ValueOf(newModel())).Type()
+		table := tables[name]
+
+		rules[name] = make(map[string][]*Rule)
+		for _, col := range table.Columns {
+			field, ok := record.FieldByName(col.GoFieldName)
+			if !ok || !col.IsDBColumn() {
+				continue
+			}
+
+			tag, ok := field.Tag.Lookup("validate")
+			if !ok {
+				continue
+			}
+
+			columnRules, err := ParseRules(tag)
+			if err != nil {
+				panic(fmt.Sprintf("%s.%s: %v", name, col.Name, err))
+			}
+			rules[name][col.Name] = columnRules
+		}
+	}
+}
+
+// FieldError is a failed validation of a single field of a record
+type FieldError struct {
+	// Field json field name of the invalid field, empty when the failure is not tied to a single field
+	Field string `json:"field,omitempty" example:"email"`
+
+	// Message reason the value of the field is invalid
+	Message string `json:"message" example:"must be a valid email address"`
+}
+
+// ValidationError is the error returned by Validate and BeforeSave when fields of a record are invalid, it holds every
+// invalid field rather than only the first one found
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+// Add records an invalid field
+func (e *ValidationError) Add(field, message string) {
+	e.Errors = append(e.Errors, &FieldError{Field: field, Message: message})
+}
+
+// Err returns e when a field is invalid, nil otherwise
+func (e *ValidationError) Err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldError := range e.Errors {
+		if fieldError.Field == "" {
+			messages[i] = fieldError.Message
+		} else {
+			messages[i] = fieldError.Field + ": " + fieldError.Message
+		}
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// Rule is a declarative check of the value of a column, attached to the field of a model with a validate struct tag
+// holding comma separated rules:
+//
+//	email            - an email address
+//	phone            - a phone number, digits with an optional leading + and spaces, dots, dashes or parentheses
+//	enum=A|B|C       - one of the values
+//	range=MIN:MAX    - a number between MIN and MAX, either bound may be left out
+//	regex=PATTERN    - matches the regular expression, must be the last rule since the pattern may hold commas
+//
+// Rules are not checked against null values.
+type Rule struct {
+	// Name of the rule, e.g. email
+	Name string
+
+	// Arg argument of the rule following =, empty for rules without one
+	Arg string
+
+	check func(value interface{}) string
+}
+
+// ParseRules parses the rules of a validate struct tag
+func ParseRules(tag string) (result []*Rule, err error) {
+	for tag != "" {
+		var item string
+		if strings.HasPrefix(tag, "regex=") {
+			item, tag = tag, ""
+		} else if i := strings.Index(tag, ","); i >= 0 {
+			item, tag = tag[:i], tag[i+1:]
+		} else {
+			item, tag = tag, ""
+		}
+
+		rule, err := parseRule(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rule)
+	}
+	return result, nil
+}
+
+func parseRule(item string) (*Rule, error) {
+	name, arg := item, ""
+	if i := strings.Index(item, "="); i >= 0 {
+		name, arg = item[:i], item[i+1:]
+	}
+	rule := &Rule{Name: name, Arg: arg}
+
+	switch name {
+	case "email":
+		rule.check = func(value interface{}) string {
+			if !emailPattern.MatchString(fmt.Sprint(value)) {
+				return "must be a valid email address"
+			}
+			return ""
+		}
+
+	case "phone":
+		rule.check = func(value interface{}) string {
+			phone := fmt.Sprint(value)
+			digits := 0
+			for _, r := range phone {
+				if r >= '0' && r <= '9' {
+					digits++
+				}
+			}
+			if !phonePattern.MatchString(phone) || digits < 7 || digits > 15 {
+				return "must be a valid phone number"
+			}
+			return ""
+		}
+
+	case "enum":
+		values := strings.Split(arg, "|")
+		if arg == "" {
+			return nil, fmt.Errorf("enum requires values, e.g. enum=A|B")
+		}
+		rule.check = func(value interface{}) string {
+			s := fmt.Sprint(value)
+			for _, allowed := range values {
+				if s == allowed {
+					return ""
+				}
+			}
+			return "must be one of " + strings.Join(values, ", ")
+		}
+
+	case "range":
+		bounds := strings.SplitN(arg, ":", 2)
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("range requires bounds, e.g. range=1:100")
+		}
+		min, max := math.Inf(-1), math.Inf(1)
+		var err error
+		if bounds[0] != "" {
+			if min, err = strconv.ParseFloat(bounds[0], 64); err != nil {
+				return nil, fmt.Errorf("range minimum %q is not a number", bounds[0])
+			}
+		}
+		if bounds[1] != "" {
+			if max, err = strconv.ParseFloat(bounds[1], 64); err != nil {
+				return nil, fmt.Errorf("range maximum %q is not a number", bounds[1])
+			}
+		}
+		rule.check = func(value interface{}) string {
+			n, ok := number(value)
+			switch {
+			case !ok:
+				return "must be a number"
+			case n < min || n > max:
+				return rangeMessage(bounds[0], bounds[1])
+			}
+			return ""
+		}
+
+	case "regex":
+		pattern, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("regex: %v", err)
+		}
+		rule.check = func(value interface{}) string {
+			if !pattern.MatchString(fmt.Sprint(value)) {
+				return "must match " + arg
+			}
+			return ""
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown validate rule %q", name)
+	}
+
+	return rule, nil
+}
+
+// Rules returns the rules declared for the column of the table
+func (t *TableInfo) Rules(col *ColumnInfo) []*Rule {
+	return rules[t.Name][col.Name]
+}
+
+// IsTimestamp returns true for the created_at, updated_at and deleted_at columns, managed by the server rather than
+// taken from a request
+func (c *ColumnInfo) IsTimestamp() bool {
+	return c.Name == "created_at" || c.Name == "updated_at" || c.Name == "deleted_at"
+}
+
+// ValidateRecord checks the columns of a record about to be created or updated against the column metadata of its
+// table, a value is required for NOT NULL columns, must fit the length of varchar and text columns and the range of
+// integer columns, along with the rules declared by the validate tags of the model. Only null, or a zero time, counts
+// as a missing value, an empty string is a value. Every invalid field is reported in the
+// *ValidationError returned. The primary key and the timestamp columns are filled in by the server and not checked.
+func ValidateRecord(record Model, action Action) error {
+	if action != Create && action != Update {
+		return nil
+	}
+
+	table := record.TableInfo()
+	recordV := reflect.Indirect(reflect.ValueOf(record))
+	result := &ValidationError{}
+
+	for _, col := range table.Columns {
+		if !col.IsDBColumn() || col.IsPrimaryKey || col.IsTimestamp() {
+			continue
+		}
+
+		field := recordV.FieldByName(col.GoFieldName)
+		if !field.IsValid() {
+			continue
+		}
+
+		value, err := columnValue(field)
+		if err != nil {
+			result.Add(col.JSONFieldName, err.Error())
+			continue
+		}
+
+		if message := checkColumn(col, value); message != "" {
+			result.Add(col.JSONFieldName, message)
+			continue
+		}
+
+		if value == nil {
+			continue
+		}
+
+		for _, rule := range table.Rules(col) {
+			if message := rule.check(value); message != "" {
+				result.Add(col.JSONFieldName, message)
+			}
+		}
+	}
+
+	return result.Err()
+}
+
+// columnValue returns the value of field as written to the db, nil for null
+func columnValue(field reflect.Value) (interface{}, error) {
+	if valuer, ok := field.Interface().(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return field.Interface(), nil
+}
+
+// checkColumn checks value against the metadata of col and returns the reason it is invalid, empty when it is valid
+func checkColumn(col *ColumnInfo, value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		if !col.Nullable {
+			return "is required"
+		}
+
+	case string:
+		switch {
+		case col.DatabaseTypeName == "text" && len(v) > maxTextLength:
+			return fmt.Sprintf("must be at most %d bytes", maxTextLength)
+		case col.DatabaseTypeName != "text" && col.ColumnLength > 0 && int64(utf8.RuneCountInString(v)) > col.ColumnLength:
+			return fmt.Sprintf("must be at most %d characters", col.ColumnLength)
+		}
+
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		if min, max, ok := integerRange(col); ok && !inRange(reflect.ValueOf(v), min, max) {
+			return fmt.Sprintf("must be between %d and %d", min, max)
+		}
+
+	case time.Time:
+		if v.IsZero() && !col.Nullable {
+			return "is required"
+		}
+	}
+
+	return ""
+}
+
+// integerRange returns the range of the values held by an integer column, signed or unsigned, ok is false when col is
+// not an integer column
+func integerRange(col *ColumnInfo) (min int64, max uint64, ok bool) {
+	bits := 0
+	for _, word := range strings.Fields(strings.ToLower(col.DatabaseTypeName)) {
+		switch word {
+		case "tinyint":
+			bits = 8
+		case "smallint":
+			bits = 16
+		case "mediumint":
+			bits = 24
+		case "int", "integer":
+			bits = 32
+		case "bigint":
+			bits = 64
+		}
+	}
+	if bits == 0 {
+		return 0, 0, false
+	}
+
+	unsigned := strings.Contains(strings.ToLower(col.DatabaseTypeName+" "+col.ColumnType), "unsigned")
+	switch {
+	case unsigned && bits == 64:
+		return 0, math.MaxUint64, true
+	case unsigned:
+		return 0, 1<<uint(bits) - 1, true
+	default:
+		return -1 << uint(bits-1), 1<<uint(bits-1) - 1, true
+	}
+}
+
+// inRange returns true when the signed or unsigned integer v is between min and max
+func inRange(v reflect.Value, min int64, max uint64) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() <= max
+	default:
+		n := v.Int()
+		return n >= min && (n < 0 || uint64(n) <= max)
+	}
+}
+
+// number returns value as a float64 when it is a number
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func rangeMessage(min, max string) string {
+	switch {
+	case min == "":
+		return "must be at most " + max
+	case max == "":
+		return "must be at least " + min
+	default:
+		return "must be between " + min + " and " + max
+	}
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// validationItem is a record of every kind of column ValidateRecord checks
+type validationItem struct {
+	ID       int64       `gorm:"column:id;primary_key"`
+	Name     string      `gorm:"column:name"`
+	Code     string      `gorm:"column:code"`
+	Note     null.String `gorm:"column:note"`
+	Body     string      `gorm:"column:body"`
+	Email    null.String `gorm:"column:email" validate:"email"`
+	Status   string      `gorm:"column:status" validate:"enum=open|closed"`
+	Count    int32       `gorm:"column:count"`
+	Floor    int         `gorm:"column:floor" validate:"range=-5:100"`
+	Level    int64       `gorm:"column:level"`
+	Units    null.Int    `gorm:"column:units"`
+	Total    uint64      `gorm:"column:total"`
+	Score    null.Float  `gorm:"column:score" validate:"range=0:10"`
+	StartsAt time.Time   `gorm:"column:starts_at"`
+	EndsAt   null.Time   `gorm:"column:ends_at"`
+	OwnerID  null.Int    `gorm:"column:owner_id"`
+}
+
+var validationItemsTable = &TableInfo{
+	Name: "validation_items",
+	Columns: []*ColumnInfo{
+		{Name: "id", DatabaseTypeName: "bigint", IsPrimaryKey: true, GoFieldName: "ID", JSONFieldName: "id"},
+		{Name: "name", DatabaseTypeName: "varchar", ColumnLength: 5, GoFieldName: "Name", JSONFieldName: "name"},
+		{Name: "code", DatabaseTypeName: "varchar", ColumnLength: 8, DefaultValue: "none", GoFieldName: "Code", JSONFieldName: "code"},
+		{Name: "note", DatabaseTypeName: "varchar", ColumnLength: 3, Nullable: true, GoFieldName: "Note", JSONFieldName: "note"},
+		{Name: "body", DatabaseTypeName: "text", GoFieldName: "Body", JSONFieldName: "body"},
+		{Name: "email", DatabaseTypeName: "varchar", Nullable: true, GoFieldName: "Email", JSONFieldName: "email"},
+		{Name: "status", DatabaseTypeName: "varchar", GoFieldName: "Status", JSONFieldName: "status"},
+		{Name: "count", DatabaseTypeName: "int", GoFieldName: "Count", JSONFieldName: "count"},
+		{Name: "floor", DatabaseTypeName: "smallint", GoFieldName: "Floor", JSONFieldName: "floor"},
+		{Name: "level", DatabaseTypeName: "tinyint", ColumnType: "tinyint unsigned", GoFieldName: "Level", JSONFieldName: "level"},
+		{Name: "units", DatabaseTypeName: "int unsigned", Nullable: true, GoFieldName: "Units", JSONFieldName: "units"},
+		{Name: "total", DatabaseTypeName: "int", GoFieldName: "Total", JSONFieldName: "total"},
+		{Name: "score", DatabaseTypeName: "float", Nullable: true, GoFieldName: "Score", JSONFieldName: "score"},
+		{Name: "starts_at", DatabaseTypeName: "datetime", GoFieldName: "StartsAt", JSONFieldName: "starts_at"},
+		{Name: "ends_at", DatabaseTypeName: "datetime", Nullable: true, GoFieldName: "EndsAt", JSONFieldName: "ends_at"},
+		{Name: "owner_id", DatabaseTypeName: "bigint", GoFieldName: "OwnerID", JSONFieldName: "owner_id"},
+		{Name: "created_at", DatabaseTypeName: "datetime", GoFieldName: "CreatedAt", JSONFieldName: "created_at"},
+	},
+}
+
+func (v *validationItem) TableName() string            { return "validation_items" }
+func (v *validationItem) BeforeSave() error            { return nil }
+func (v *validationItem) Prepare()                     {}
+func (v *validationItem) Validate(action Action) error { return ValidateRecord(v, action) }
+func (v *validationItem) TableInfo() *TableInfo        { return validationItemsTable }
+
+func init() {
+	record := reflect.TypeOf(validationItem{})
+	rules[validationItemsTable.Name] = map[string][]*Rule{}
+	for _, col := range validationItemsTable.Columns {
+		field, ok := record.FieldByName(col.GoFieldName)
+		if !ok {
+			continue
+		}
+		if tag, ok := field.Tag.Lookup("validate"); ok {
+			columnRules, err := ParseRules(tag)
+			if err != nil {
+				panic(err)
+			}
+			rules[validationItemsTable.Name][col.Name] = columnRules
+		}
+	}
+}
+
+// validItem returns a record passing every check, changed by the tests one field at a time
+func validItem() *validationItem {
+	return &validationItem{
+		Name:     "a",
+		Status:   "open",
+		StartsAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		OwnerID:  null.IntFrom(1),
+	}
+}
+
+func TestValidateRecord(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(v *validationItem)
+		errors []string
+	}{
+		{"valid", func(v *validationItem) {}, nil},
+		{"empty string is a value", func(v *validationItem) { v.Name, v.Body = "", "" }, nil},
+		{"empty string with a default", func(v *validationItem) { v.Code = "" }, nil},
+		{"null nullable column", func(v *validationItem) { v.Note, v.Units, v.EndsAt = null.String{}, null.Int{}, null.Time{} }, nil},
+		{"null not null column", func(v *validationItem) { v.OwnerID = null.Int{} }, []string{"owner_id: is required"}},
+		{"zero time not null", func(v *validationItem) { v.StartsAt = time.Time{} }, []string{"starts_at: is required"}},
+
+		{"varchar length in characters", func(v *validationItem) { v.Name = "ééééé" }, nil},
+		{"varchar too long", func(v *validationItem) { v.Name = "abcdef" }, []string{"name: must be at most 5 characters"}},
+		{"nullable varchar too long", func(v *validationItem) { v.Note = null.StringFrom("abcd") }, []string{"note: must be at most 3 characters"}},
+		{"text too long", func(v *validationItem) { v.Body = strings.Repeat("x", maxTextLength+1) }, []string{"body: must be at most 65535 bytes"}},
+
+		{"int32 bounds", func(v *validationItem) { v.Count = -1 << 31 }, nil},
+		{"int in smallint range", func(v *validationItem) { v.Floor = -5 }, nil},
+		// the rules are not checked once the column check fails
+		{"smallint overflow", func(v *validationItem) { v.Floor = 1 << 15 }, []string{"floor: must be between -32768 and 32767"}},
+		{"unsigned tinyint max", func(v *validationItem) { v.Level = 255 }, nil},
+		{"unsigned tinyint overflow", func(v *validationItem) { v.Level = 256 }, []string{"level: must be between 0 and 255"}},
+		{"unsigned tinyint negative", func(v *validationItem) { v.Level = -1 }, []string{"level: must be between 0 and 255"}},
+		{"null.Int unsigned int max", func(v *validationItem) { v.Units = null.IntFrom(1<<32 - 1) }, nil},
+		{"null.Int unsigned int overflow", func(v *validationItem) { v.Units = null.IntFrom(1 << 32) }, []string{"units: must be between 0 and 4294967295"}},
+		{"null.Int unsigned int negative", func(v *validationItem) { v.Units = null.IntFrom(-1) }, []string{"units: must be between 0 and 4294967295"}},
+		{"uint64 int overflow", func(v *validationItem) { v.Total = 1 << 31 }, []string{"total: must be between -2147483648 and 2147483647"}},
+
+		{"email rule", func(v *validationItem) { v.Email = null.StringFrom("nope") }, []string{"email: must be a valid email address"}},
+		{"rules skip null", func(v *validationItem) { v.Email, v.Score = null.String{}, null.Float{} }, nil},
+		{"enum rule on empty string", func(v *validationItem) { v.Status = "" }, []string{"status: must be one of open, closed"}},
+		{"range rule on float", func(v *validationItem) { v.Score = null.FloatFrom(10.5) }, []string{"score: must be between 0 and 10"}},
+		{"range rule on int", func(v *validationItem) { v.Floor = 101 }, []string{"floor: must be between -5 and 100"}},
+		{"every invalid field", func(v *validationItem) { v.Name, v.Status, v.StartsAt = "abcdef", "x", time.Time{} },
+			[]string{"name: must be at most 5 characters", "status: must be one of open, closed", "starts_at: is required"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := validItem()
+			tt.change(record)
+
+			for _, action := range []Action{Create, Update} {
+				err := ValidateRecord(record, action)
+				var got []string
+				var validationErr *ValidationError
+				if errors.As(err, &validationErr) {
+					for _, fieldError := range validationErr.Errors {
+						got = append(got, fieldError.Field+": "+fieldError.Message)
+					}
+				} else if err != nil {
+					t.Fatalf("ValidateRecord(%s) error = %v, want a *ValidationError", action, err)
+				}
+
+				if fmt.Sprint(got) != fmt.Sprint(tt.errors) {
+					t.Errorf("ValidateRecord(%s) = %q, want %q", action, got, tt.errors)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRecordSkipsReads(t *testing.T) {
+	record := validItem()
+	record.Name = "abcdef"
+
+	for _, action := range []Action{RetrieveOne, RetrieveMany, Delete} {
+		if err := ValidateRecord(record, action); err != nil {
+			t.Errorf("ValidateRecord(%s) = %v, want nil", action, err)
+		}
+	}
+}